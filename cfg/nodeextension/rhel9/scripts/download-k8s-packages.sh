@@ -0,0 +1,93 @@
+#!/bin/bash
+# SPDX-FileCopyrightText: © 2026 Siemens Healthineers AG
+# SPDX-License-Identifier: MIT
+#
+# RHEL 9 compatible (RHEL, Rocky Linux, AlmaLinux, CentOS Stream) variant
+
+set -euo pipefail
+
+TARGET_PATH="${1:?Argument missing: TargetPath}"
+K8S_VERSION="${2:?Argument missing: K8sVersion}"
+PROXY="${3:-}"
+
+log_info() {
+    echo "[K8sPackages] $1"
+}
+
+log_warning() {
+    echo "[K8sPackages] WARNING: $1"
+}
+
+# Normalize K8S_VERSION to vX.Y for repo URLs (repos use minor version only)
+K8S_VERSION_REPO=$(echo "$K8S_VERSION" | sed 's/^\(v[0-9]*\.[0-9]*\).*/\1/')
+SHORT_K8S_VERSION="${K8S_VERSION#v}"
+
+log_info "Starting Kubernetes package download"
+log_info "Target path: $TARGET_PATH"
+log_info "K8s version: $K8S_VERSION (repo version: $K8S_VERSION_REPO)"
+
+rm -rf "$TARGET_PATH"
+mkdir -p "$TARGET_PATH"
+
+DNF_OPTIONS=(--assumeyes --setopt=retries=2 --setopt=timeout=30)
+if [ -n "$PROXY" ]; then
+    log_info "Using dnf proxy: $PROXY"
+    DNF_OPTIONS+=("--setopt=proxy=$PROXY")
+fi
+
+# ---------------------------------------------------------------------------
+# Configure Kubernetes and CRI-O repositories; packages are only installed
+# from the downloaded set, so both repositories stay disabled by default.
+# ---------------------------------------------------------------------------
+log_info "Configuring Kubernetes and CRI-O repositories"
+sudo tee /etc/yum.repos.d/kubernetes.repo > /dev/null <<REPO
+[kubernetes]
+name=Kubernetes
+baseurl=https://pkgs.k8s.io/core:/stable:/$K8S_VERSION_REPO/rpm/
+enabled=0
+gpgcheck=1
+gpgkey=https://pkgs.k8s.io/core:/stable:/$K8S_VERSION_REPO/rpm/repodata/repomd.xml.key
+REPO
+
+sudo tee /etc/yum.repos.d/cri-o.repo > /dev/null <<REPO
+[cri-o]
+name=CRI-O
+baseurl=https://download.opensuse.org/repositories/isv:/cri-o:/stable:/$K8S_VERSION_REPO/rpm/
+enabled=0
+gpgcheck=1
+gpgkey=https://download.opensuse.org/repositories/isv:/cri-o:/stable:/$K8S_VERSION_REPO/rpm/repodata/repomd.xml.key
+REPO
+
+if ! sudo dnf "${DNF_OPTIONS[@]}" --enablerepo=kubernetes,cri-o makecache; then
+    log_warning "dnf metadata refresh failed"
+    exit 1
+fi
+
+# ---------------------------------------------------------------------------
+# Download packages incl. all dependencies so that the set can be installed
+# without repository access.
+# ---------------------------------------------------------------------------
+log_info "=== Downloading CRI-O, cri-tools and Kubernetes tools ==="
+if ! sudo dnf "${DNF_OPTIONS[@]}" --enablerepo=kubernetes,cri-o \
+    download --resolve --alldeps --destdir "$TARGET_PATH" \
+    cri-o cri-tools \
+    "kubectl-$SHORT_K8S_VERSION" "kubelet-$SHORT_K8S_VERSION" "kubeadm-$SHORT_K8S_VERSION"; then
+    log_warning "Package download failed"
+    exit 1
+fi
+
+for package_name in kubectl kubelet kubeadm; do
+    if ! ls "$TARGET_PATH"/${package_name}-${SHORT_K8S_VERSION}-*.rpm >/dev/null 2>&1; then
+        log_warning "Required Kubernetes package file is missing after download: $package_name-$SHORT_K8S_VERSION"
+        exit 1
+    fi
+done
+
+if ! ls "$TARGET_PATH"/cri-tools-*.rpm >/dev/null 2>&1; then
+    log_warning "Required cri-tools package was not downloaded"
+    exit 1
+fi
+
+log_info "Download verification:"
+log_info "Total packages: $(ls "$TARGET_PATH"/*.rpm 2>/dev/null | wc -l)"
+ls -lh "$TARGET_PATH"/*.rpm 2>/dev/null || true
@@ -0,0 +1,27 @@
+#!/bin/bash
+# SPDX-FileCopyrightText: © 2026 Siemens Healthineers AG
+#
+# SPDX-License-Identifier: MIT
+#
+# install-buildah-packages.sh (RHEL 9 compatible variant)
+
+set -euo pipefail
+
+BUILDAH_RPM_PACKAGES_PATH="${1:?Argument missing: BuildahRpmPackagesPath}"
+
+echo "[BuildahInstall] Installing buildah from $BUILDAH_RPM_PACKAGES_PATH"
+
+if ! ls "$BUILDAH_RPM_PACKAGES_PATH"/*.rpm > /dev/null 2>&1; then
+    echo "[BuildahInstall] ERROR: The directory '$BUILDAH_RPM_PACKAGES_PATH' does not contain any RPM packages" >&2
+    exit 1
+fi
+
+sudo dnf install --assumeyes --disablerepo='*' --disableexcludes=main --setopt=install_weak_deps=False "$BUILDAH_RPM_PACKAGES_PATH"/*.rpm
+
+# Verify buildah is actually usable - this is the definitive success check.
+if ! sudo buildah --version > /dev/null 2>&1; then
+    echo "[BuildahInstall] ERROR: buildah is not functional after installation"
+    exit 1
+fi
+
+echo "[BuildahInstall] Finished installing buildah"
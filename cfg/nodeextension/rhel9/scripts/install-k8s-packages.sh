@@ -0,0 +1,151 @@
+#!/bin/bash
+# SPDX-FileCopyrightText: © 2026 Siemens Healthineers AG
+#
+# SPDX-License-Identifier: MIT
+#
+# install-k8s-packages.sh (RHEL 9 compatible variant)
+
+set -euo pipefail
+
+K8S_RPM_PACKAGES_PATH="${1:?Argument missing: K8sRpmPackagesPath}"
+PROXY="${2:-}"
+REGISTRY_TOKEN="${3:?Argument missing: RegistryToken}"
+IS_WSL="${4:-false}"
+NO_PROXY="${5:-localhost,127.0.0.1,::1,172.20.0.0/16,172.21.0.0/16,.cluster.local,.svc}"
+
+echo "[InstallK8s] Starting Kubernetes artifacts installation"
+echo "[InstallK8s] Packages path: $K8S_RPM_PACKAGES_PATH"
+
+# ---------------------------------------------------------------------------
+# Validate packages directory
+# ---------------------------------------------------------------------------
+if ! ls "$K8S_RPM_PACKAGES_PATH"/*.rpm > /dev/null 2>&1; then
+    echo "[InstallK8s] ERROR: The directory '$K8S_RPM_PACKAGES_PATH' does not contain any RPM packages. Cannot install Kubernetes artifacts." >&2
+    exit 1
+fi
+
+# ---------------------------------------------------------------------------
+# Install .rpm packages from the package set only; dependencies already
+# installed on the host are resolved from the local RPM database.
+# ---------------------------------------------------------------------------
+echo "[InstallK8s] Installing rpm packages from $K8S_RPM_PACKAGES_PATH"
+sudo dnf install --assumeyes --disablerepo='*' --disableexcludes=main --setopt=install_weak_deps=False "$K8S_RPM_PACKAGES_PATH"/*.rpm
+
+if ! command -v crictl >/dev/null 2>&1; then
+    echo "[InstallK8s] ERROR: crictl is not available after package installation. Ensure cri-tools is included in the Kubernetes artifact set." >&2
+    exit 1
+fi
+
+# ---------------------------------------------------------------------------
+# Configure bridged traffic (kernel modules + sysctl)
+# ---------------------------------------------------------------------------
+echo "[InstallK8s] Configuring bridged traffic"
+printf 'overlay\nbr_netfilter\n' | sudo tee /etc/modules-load.d/k8s.conf
+sudo modprobe overlay
+sudo modprobe br_netfilter
+
+{
+    echo 'net.bridge.bridge-nf-call-ip6tables = 1'
+    echo 'net.bridge.bridge-nf-call-iptables = 1'
+    echo 'net.ipv4.ip_forward = 1'
+} | sudo tee /etc/sysctl.d/k8s.conf
+sudo sysctl --system
+
+# ---------------------------------------------------------------------------
+# Ensure shared mount on reboot
+# ---------------------------------------------------------------------------
+echo '@reboot root mount --make-rshared /' | sudo tee /etc/cron.d/sharedmount
+
+# ---------------------------------------------------------------------------
+# Exclude cri-o and the Kubernetes tools from unintended upgrades
+# ---------------------------------------------------------------------------
+if ! grep -q '^exclude=.*kubeadm' /etc/dnf/dnf.conf 2>/dev/null; then
+    echo 'exclude=cri-o cri-tools kubelet kubeadm kubectl' | sudo tee -a /etc/dnf/dnf.conf > /dev/null
+fi
+
+# ---------------------------------------------------------------------------
+# Configure crictl timeout
+# ---------------------------------------------------------------------------
+sudo touch /etc/crictl.yaml
+if grep -q 'timeout' /etc/crictl.yaml; then
+    sudo sed -i 's/timeout.*/timeout: 30/g' /etc/crictl.yaml
+else
+    echo 'timeout: 30' | sudo tee -a /etc/crictl.yaml
+fi
+
+# ---------------------------------------------------------------------------
+# Proxy configuration for CRI-O (optional)
+# ---------------------------------------------------------------------------
+if [ -n "$PROXY" ]; then
+    echo "[InstallK8s] Configuring CRI-O proxy: $PROXY"
+    sudo mkdir -p /etc/systemd/system/crio.service.d
+    {
+        echo '[Service]'
+        echo "Environment='HTTP_PROXY=$PROXY'"
+        echo "Environment='HTTPS_PROXY=$PROXY'"
+        echo "Environment='http_proxy=$PROXY'"
+        echo "Environment='https_proxy=$PROXY'"
+        echo "Environment='NO_PROXY=$NO_PROXY'"
+        echo "Environment='no_proxy=$NO_PROXY'"
+    } | sudo tee /etc/systemd/system/crio.service.d/http-proxy.conf > /dev/null
+fi
+
+# ---------------------------------------------------------------------------
+# Container registry authentication (shsk2s.azurecr.io)
+# ---------------------------------------------------------------------------
+echo "[InstallK8s] Configuring container registry authentication"
+cat <<AUTH | sudo tee /tmp/auth.json > /dev/null
+{
+  "auths": {
+    "shsk2s.azurecr.io": {
+      "auth": "$REGISTRY_TOKEN"
+    }
+  }
+}
+AUTH
+sudo mkdir -p /root/.config/containers
+sudo mv /tmp/auth.json /root/.config/containers/auth.json
+
+# ---------------------------------------------------------------------------
+# Configure CRI-O: lower priority of default CNI bridge
+# ---------------------------------------------------------------------------
+echo "[InstallK8s] Configure CRI-O"
+CRIO_CNI_FILE='/etc/cni/net.d/10-crio-bridge.conf'
+if [ -f "$CRIO_CNI_FILE" ]; then
+    sudo mv "$CRIO_CNI_FILE" /etc/cni/net.d/100-crio-bridge.conf
+else
+    echo "[InstallK8s] File does not exist, no renaming of cni file $CRIO_CNI_FILE.."
+fi
+
+if ! grep -q '^[[:space:]]*unqualified-search-registries[[:space:]]*=' /etc/containers/registries.conf 2>/dev/null; then
+    echo 'unqualified-search-registries = ["docker.io", "quay.io"]' | sudo tee -a /etc/containers/registries.conf
+else
+    echo "[InstallK8s] unqualified-search-registries already configured in /etc/containers/registries.conf, skipping"
+fi
+
+KUBEADM_VERSION="$(kubeadm version -o short)"
+KUBEADM_PAUSE_IMAGE="$(kubeadm config images list --kubernetes-version "$KUBEADM_VERSION" | grep '/pause:' | tail -n 1 || true)"
+if [ -n "$KUBEADM_PAUSE_IMAGE" ]; then
+    echo "[InstallK8s] Configuring CRI-O pause image from kubeadm $KUBEADM_VERSION: $KUBEADM_PAUSE_IMAGE"
+    sudo mkdir -p /etc/crio/crio.conf.d
+    {
+        echo '[crio.image]'
+        echo "pause_image = \"$KUBEADM_PAUSE_IMAGE\""
+    } | sudo tee /etc/crio/crio.conf.d/20-k2s-kubeadm-pause.conf > /dev/null
+else
+    echo "[InstallK8s] WARNING: Could not resolve pause image from kubeadm; keeping CRI-O package default"
+fi
+
+# ---------------------------------------------------------------------------
+# Start CRI-O
+# ---------------------------------------------------------------------------
+echo "[InstallK8s] Starting CRI-O"
+sudo systemctl daemon-reload
+sudo systemctl enable crio || true
+sudo systemctl restart crio
+
+if [ "$IS_WSL" = "true" ]; then
+    echo "[InstallK8s] WARNING: WSL is not supported on RHEL-based hosts; ignoring WSL fix"
+fi
+
+echo "[InstallK8s] Kubernetes artifacts installation completed successfully"
@@ -55,6 +55,7 @@ func main() {
 		slog.Error("Failed to listen", "error", err)
 		return
 	}
+	notifyServiceManager(listener.Addr().String())
 	http.Serve(listener, proxyHandler)
 
 	// Wait for cleanup tasks before exiting
@@ -7,10 +7,14 @@
 package main
 
 import (
+	"fmt"
 	"log/slog"
+	"net"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 )
 
 // registerPlatformHandler registers Unix signal handlers for graceful shutdown.
@@ -21,6 +25,9 @@ func registerPlatformHandler() error {
 	go func() {
 		sig := <-sigCh
 		slog.Info("Signal received, shutting down.", "signal", sig)
+		if err := sdNotify("STOPPING=1"); err != nil {
+			slog.Warn("Failed to notify systemd about shutdown", "error", err)
+		}
 		cleanupWg.Add(1)
 		go func() {
 			defer cleanupWg.Done()
@@ -33,3 +40,62 @@ func registerPlatformHandler() error {
 
 	return nil
 }
+
+// notifyServiceManager reports readiness to systemd and, if the unit has a watchdog, pings it for as long as the proxy
+// accepts connections on addr. It does nothing if the proxy was not started by systemd with Type=notify.
+func notifyServiceManager(addr string) {
+	if err := sdNotify("READY=1"); err != nil {
+		slog.Warn("Failed to notify systemd about readiness", "error", err)
+		return
+	}
+
+	interval := watchdogInterval()
+	if interval == 0 {
+		return
+	}
+	slog.Info("Watchdog enabled", "interval", interval)
+	go func() {
+		for range time.Tick(interval) {
+			conn, err := net.DialTimeout("tcp", addr, interval/2)
+			if err != nil {
+				// no ping, systemd restarts the proxy once the watchdog expires
+				slog.Error("Proxy does not accept connections, skipping watchdog ping", "error", err)
+				continue
+			}
+			conn.Close()
+			if err := sdNotify("WATCHDOG=1"); err != nil {
+				slog.Warn("Failed to ping systemd watchdog", "error", err)
+			}
+		}
+	}()
+}
+
+// watchdogInterval returns half of the watchdog timeout systemd set for this process, 0 if there is none.
+func watchdogInterval() time.Duration {
+	if pid := os.Getenv("WATCHDOG_PID"); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return 0
+	}
+	usec, err := strconv.ParseInt(os.Getenv("WATCHDOG_USEC"), 10, 64)
+	if err != nil || usec <= 0 {
+		return 0
+	}
+	return time.Duration(usec) * time.Microsecond / 2
+}
+
+// sdNotify sends a state to the systemd notification socket, if any.
+func sdNotify(state string) error {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return nil
+	}
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return fmt.Errorf("connect to notification socket: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte(state)); err != nil {
+		return fmt.Errorf("send %q: %w", state, err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: © 2026 Siemens Healthineers AG
+//
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("systemd notification", func() {
+	Describe("sdNotify", func() {
+		When("no notification socket is set", func() {
+			It("does nothing", func() {
+				GinkgoT().Setenv("NOTIFY_SOCKET", "")
+
+				Expect(sdNotify("READY=1")).To(Succeed())
+			})
+		})
+
+		When("a notification socket is set", func() {
+			It("sends the state", func() {
+				socket := filepath.Join(GinkgoT().TempDir(), "notify")
+				conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+				Expect(err).ToNot(HaveOccurred())
+				DeferCleanup(conn.Close)
+				GinkgoT().Setenv("NOTIFY_SOCKET", socket)
+
+				Expect(sdNotify("READY=1")).To(Succeed())
+
+				buffer := make([]byte, 64)
+				Expect(conn.SetReadDeadline(time.Now().Add(time.Second))).To(Succeed())
+				n, err := conn.Read(buffer)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(buffer[:n])).To(Equal("READY=1"))
+			})
+		})
+	})
+
+	Describe("watchdogInterval", func() {
+		It("returns half of the watchdog timeout", func() {
+			GinkgoT().Setenv("WATCHDOG_USEC", "30000000")
+			GinkgoT().Setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()))
+
+			Expect(watchdogInterval()).To(Equal(15 * time.Second))
+		})
+
+		It("returns 0 without watchdog", func() {
+			GinkgoT().Setenv("WATCHDOG_USEC", "")
+
+			Expect(watchdogInterval()).To(BeZero())
+		})
+
+		It("returns 0 if the watchdog is meant for another process", func() {
+			GinkgoT().Setenv("WATCHDOG_USEC", "30000000")
+			GinkgoT().Setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()+1))
+
+			Expect(watchdogInterval()).To(BeZero())
+		})
+	})
+})
@@ -136,3 +136,6 @@ func registerPlatformHandler() error {
 	systemStartupCmd()
 	return nil
 }
+
+// notifyServiceManager does nothing on Windows, where NSSM supervises the proxy process.
+func notifyServiceManager(string) {}
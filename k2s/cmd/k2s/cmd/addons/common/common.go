@@ -11,6 +11,7 @@ import (
 
 	"github.com/siemens-healthineers/k2s/internal/terminal"
 
+	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
 	"github.com/siemens-healthineers/k2s/internal/core/addons"
 
 	"github.com/samber/lo"
@@ -22,6 +23,32 @@ func LogAddons(allAddons addons.Addons) {
 	slog.Debug("addons loaded", "count", len(allAddons), "addons", addonInfos{allAddons})
 }
 
+// EnabledImplementations returns the implementations of the enabled addons; addons with a single implementation are
+// recorded without implementation name.
+func EnabledImplementations(allAddons addons.Addons, enabledAddons []cconfig.Addon) []addons.Implementation {
+	var implementations []addons.Implementation
+	for _, addon := range allAddons {
+		for _, implementation := range addon.Spec.Implementations {
+			if isEnabled(addon.Metadata.Name, implementation.Name, enabledAddons) {
+				implementations = append(implementations, implementation)
+			}
+		}
+	}
+	return implementations
+}
+
+func isEnabled(addonName, implementationName string, enabledAddons []cconfig.Addon) bool {
+	for _, enabled := range enabledAddons {
+		if enabled.Name != addonName {
+			continue
+		}
+		if enabled.Implementation == implementationName || (enabled.Implementation == "" && implementationName == addonName) {
+			return true
+		}
+	}
+	return false
+}
+
 // FindImplementation resolves an addon implementation based on command args.
 //
 // Supported forms:
@@ -23,6 +23,14 @@ import (
 	"github.com/spf13/pflag"
 )
 
+const (
+	dryRunFlagName  = "dry-run"
+	dryRunFlagUsage = "Render the addon's manifests and show a server-side dry-run diff against the cluster without changing anything"
+)
+
+// dryRunCommands are the addon commands supporting the --dry-run flag.
+var dryRunCommands = []string{"enable", "disable", "update"}
+
 func NewCommands(allAddons addons.Addons) (commands []*cobra.Command, err error) {
 	commandMap := map[string]*cobra.Command{}
 
@@ -93,6 +101,7 @@ func newAddonCmd(addon addons.Addon, cmdName string) (*cobra.Command, error) {
 					}
 				}
 			}
+			addDryRunFlag(cmdName, cmd.Flags())
 
 			cmd.Flags().SortFlags = false
 			cmd.Flags().PrintDefaults()
@@ -120,6 +129,7 @@ func newImplementationCmd(addon addons.Addon, cmdName string, implementation add
 			return nil, err
 		}
 	}
+	addDryRunFlag(cmdName, cmd.Flags())
 
 	cmd.Flags().SortFlags = false
 	cmd.Flags().PrintDefaults()
@@ -157,6 +167,13 @@ func addFlags(flags []addons.CliFlag, cmd *cobra.Command) error {
 	return nil
 }
 
+func addDryRunFlag(cmdName string, flagSet *pflag.FlagSet) {
+	if !lo.Contains(dryRunCommands, cmdName) || flagSet.Lookup(dryRunFlagName) != nil {
+		return
+	}
+	flagSet.Bool(dryRunFlagName, false, dryRunFlagUsage)
+}
+
 func addFlag(flag addons.CliFlag, flagSet *pflag.FlagSet) error {
 	flagDescription, err := flag.FullDescription()
 	if err != nil {
@@ -231,6 +248,15 @@ func runCmd(cmd *cobra.Command, addon addons.Addon, cmdName string, implementati
 		return err
 	}
 
+	if dryRun, _ := cmd.Flags().GetBool(dryRunFlagName); dryRun {
+		if err := runDryRun(cmd.Flags(), context.Providers().Addon, addon, cmdName, implementation); err != nil {
+			return err
+		}
+
+		cmdSession.Finish()
+		return nil
+	}
+
 	err = context.Providers().Addon.RunCommand(provider.AddonRunCommandConfig{
 		AddonName:      addon.Metadata.Name,
 		CommandName:    cmdName,
@@ -248,6 +274,77 @@ func runCmd(cmd *cobra.Command, addon addons.Addon, cmdName string, implementati
 	return nil
 }
 
+func runDryRun(flags *pflag.FlagSet, addonProvider provider.AddonProvider, addon addons.Addon, cmdName string, implementation addons.Implementation) error {
+	manifestPaths, err := dryRunManifests(flags, addon, cmdName, implementation)
+	if err != nil {
+		return err
+	}
+
+	slog.Debug("Addon command dry-run prepared", "command", cmdName, "addon", addon.Metadata.Name, "manifests", manifestPaths)
+
+	result, err := addonProvider.DryRun(provider.AddonDryRunConfig{
+		AddonName:      addon.Metadata.Name,
+		CommandName:    cmdName,
+		AddonDirectory: addon.Directory,
+		ManifestPaths:  manifestPaths,
+		ShowOutput:     flags.Lookup(common.OutputFlagName) != nil && flags.Lookup(common.OutputFlagName).Value.String() == "true",
+	})
+	if err != nil {
+		return err
+	}
+
+	if !result.HasChanges {
+		pterm.Info.Printfln("Dry-run: '%s' would not change any resources of '%s' addon", cmdName, addon.Metadata.Name)
+		return nil
+	}
+
+	pterm.Info.Printfln("Dry-run: '%s' would make the following changes for '%s' addon (nothing was changed):", cmdName, addon.Metadata.Name)
+	pterm.Println(result.Diff)
+	return nil
+}
+
+// dryRunManifests returns the manifest sources of a command relative to the addon directory. Commands without
+// declared dry-run manifests fall back to the manifests 'enable' deploys: 'disable' uses all of them, since
+// undeployed variants are ignored on deletion, other commands the implementation's 'manifests' directory.
+func dryRunManifests(flags *pflag.FlagSet, addon addons.Addon, cmdName string, implementation addons.Implementation) ([]string, error) {
+	var commands map[string]addons.AddonCmd
+	if implementation.Commands != nil {
+		commands = *implementation.Commands
+	}
+
+	if dryRun := commands[cmdName].DryRun; dryRun != nil {
+		return dryRun.SelectManifests(func(name string) (string, bool) {
+			flag := flags.Lookup(name)
+			if flag == nil {
+				return "", false
+			}
+			return flag.Value.String(), true
+		}), nil
+	}
+
+	if enableDryRun := commands["enable"].DryRun; cmdName == "disable" && enableDryRun != nil {
+		return lo.Map(enableDryRun.Manifests, func(manifest addons.DryRunManifest, _ int) string { return manifest.Path }), nil
+	}
+
+	implDir := addon.Directory
+	if implementation.Name != addon.Metadata.Name {
+		implDir = filepath.Join(addon.Directory, implementation.Name)
+	}
+
+	var paths []string
+	for _, source := range provider.DiscoverManifests(filepath.Join(implDir, "manifests"), addon.Metadata.Name, implementation.Name) {
+		if source.Optional && cmdName != "disable" {
+			continue
+		}
+		relPath, err := filepath.Rel(addon.Directory, source.Path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve manifest path '%s': %w", source.Path, err)
+		}
+		paths = append(paths, filepath.ToSlash(relPath))
+	}
+	return paths, nil
+}
+
 func buildPsCmd(flags *pflag.FlagSet, cmdConfig addons.AddonCmd, addonDir string) (cmd string, params []string, err error) {
 	cmd = utils.FormatScriptFilePath(filepath.Join(addonDir, cmdConfig.Script.SubPath))
 	addParam := func(param string) { params = append(params, param) }
@@ -274,6 +371,10 @@ func convertToPsParam(flag *pflag.Flag, cmdConfig addons.AddonCmd, add func(stri
 		return nil
 	}
 
+	if flag.Name == dryRunFlagName {
+		return nil
+	}
+
 	scriptParam, found := lo.Find(cmdConfig.Script.ParameterMappings, func(mapping addons.ParameterMapping) bool {
 		return mapping.CliFlagName == flag.Name
 	})
@@ -6,6 +6,8 @@ package generic
 import (
 	"fmt"
 	"log/slog"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
@@ -113,6 +115,40 @@ var _ = Describe("generic pkg", func() {
 			})
 		})
 
+		When("command supports dry-run", func() {
+			It("adds the dry-run flag to enable, disable and update commands only", func() {
+				addons := addons.Addons{
+					addons.Addon{
+						Metadata: addons.AddonMetadata{Name: "a1"},
+						Spec: addons.AddonSpec{
+							Implementations: []addons.Implementation{{Name: "a1", Commands: &map[string]addons.AddonCmd{
+								"enable":  {},
+								"disable": {},
+								"backup":  {},
+							}}},
+						},
+					},
+				}
+
+				result, err := NewCommands(addons)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(HaveLen(3))
+
+				for _, cmd := range result {
+					addonCmd := cmd.Commands()[0]
+					dryRunFlag := addonCmd.Flags().Lookup(dryRunFlagName)
+
+					if cmd.Use == "backup" {
+						Expect(dryRunFlag).To(BeNil())
+					} else {
+						Expect(dryRunFlag).ToNot(BeNil())
+						Expect(dryRunFlag.DefValue).To(Equal("false"))
+					}
+				}
+			})
+		})
+
 		When("error occurred", func() {
 			It("returns error", func() {
 				addons := addons.Addons{
@@ -928,6 +964,15 @@ var _ = Describe("generic pkg", func() {
 			})
 		})
 
+		When("flag is dry-run flag", func() {
+			It("does nothing", func() {
+				flag := &pflag.Flag{Name: dryRunFlagName}
+				cmd := addons.AddonCmd{}
+
+				Expect(convertToPsParam(flag, cmd, nil)).To(Succeed())
+			})
+		})
+
 		When("flag not found in parameter mapping", func() {
 			It("does nothing", func() {
 				flag := &pflag.Flag{Name: "not-in-mapping"}
@@ -1080,4 +1125,68 @@ var _ = Describe("generic pkg", func() {
 			})
 		})
 	})
+
+	Describe("dryRunManifests", func() {
+		enableDryRun := &addons.DryRunConfig{Manifests: []addons.DryRunManifest{
+			{Path: "manifests/namespace.yaml"},
+			{Path: "manifests/full", When: map[string]string{"minimal": "false"}},
+			{Path: "manifests/minimal", When: map[string]string{"minimal": "true"}},
+		}}
+
+		It("selects the declared manifests of the command by flag values", func() {
+			flags := &pflag.FlagSet{}
+			flags.Bool("minimal", true, "")
+			commands := map[string]addons.AddonCmd{"enable": {DryRun: enableDryRun}}
+			implementation := addons.Implementation{Name: "viewer", Commands: &commands}
+
+			paths, err := dryRunManifests(flags, addons.Addon{Metadata: addons.AddonMetadata{Name: "viewer"}}, "enable", implementation)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(paths).To(Equal([]string{"manifests/namespace.yaml", "manifests/minimal"}))
+		})
+
+		It("uses all manifests of 'enable' for 'disable' without declared manifests", func() {
+			commands := map[string]addons.AddonCmd{"enable": {DryRun: enableDryRun}, "disable": {}}
+			implementation := addons.Implementation{Name: "viewer", Commands: &commands}
+
+			paths, err := dryRunManifests(&pflag.FlagSet{}, addons.Addon{Metadata: addons.AddonMetadata{Name: "viewer"}}, "disable", implementation)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(paths).To(Equal([]string{"manifests/namespace.yaml", "manifests/full", "manifests/minimal"}))
+		})
+
+		When("no manifests are declared", func() {
+			var addon addons.Addon
+
+			BeforeEach(func() {
+				addonDir := GinkgoT().TempDir()
+				for _, relPath := range []string{"traefik/manifests/traefik/kustomization.yaml", "traefik/manifests/ingress-nginx/kustomization.yaml", "traefik/manifests/rbac.yaml"} {
+					path := filepath.Join(addonDir, filepath.FromSlash(relPath))
+					Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+					Expect(os.WriteFile(path, []byte("kind: Deployment\n"), 0644)).To(Succeed())
+				}
+				addon = addons.Addon{Metadata: addons.AddonMetadata{Name: "ingress"}, Directory: addonDir}
+			})
+
+			It("falls back to the manifests directory without variants", func() {
+				commands := map[string]addons.AddonCmd{"update": {}}
+				implementation := addons.Implementation{Name: "traefik", Commands: &commands}
+
+				paths, err := dryRunManifests(&pflag.FlagSet{}, addon, "update", implementation)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(paths).To(ConsistOf("traefik/manifests/traefik", "traefik/manifests/rbac.yaml"))
+			})
+
+			It("falls back to the manifests directory including variants for 'disable'", func() {
+				commands := map[string]addons.AddonCmd{"disable": {}}
+				implementation := addons.Implementation{Name: "traefik", Commands: &commands}
+
+				paths, err := dryRunManifests(&pflag.FlagSet{}, addon, "disable", implementation)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(paths).To(ConsistOf("traefik/manifests/traefik", "traefik/manifests/ingress-nginx", "traefik/manifests/rbac.yaml"))
+			})
+		})
+	})
 })
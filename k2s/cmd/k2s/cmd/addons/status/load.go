@@ -6,12 +6,14 @@ package status
 import (
 	"fmt"
 
+	"github.com/siemens-healthineers/k2s/internal/core/addons/health"
 	"github.com/siemens-healthineers/k2s/internal/provider"
 )
 
 type LoadedAddonStatus struct {
 	Enabled *bool             `json:"enabled"`
 	Props   []AddonStatusProp `json:"props"`
+	Health  *health.Report    `json:"health,omitempty"`
 }
 
 type AddonStatusProp struct {
@@ -21,10 +23,11 @@ type AddonStatusProp struct {
 	Name    string  `json:"name"`
 }
 
-func LoadAddonStatus(addonProv provider.AddonProvider, addonName string, addonDirectory string) (*LoadedAddonStatus, error) {
+func LoadAddonStatus(addonProv provider.AddonProvider, addonName string, addonDirectory string, manifests []provider.AddonManifestSource) (*LoadedAddonStatus, error) {
 	result, err := addonProv.Status(provider.AddonStatusConfig{
 		Name:      addonName,
 		Directory: addonDirectory,
+		Manifests: manifests,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not load addon status for '%s': %w", addonName, err)
@@ -35,6 +38,7 @@ func LoadAddonStatus(addonProv provider.AddonProvider, addonName string, addonDi
 		if a.Name == addonName {
 			loaded := &LoadedAddonStatus{
 				Enabled: new(a.Enabled),
+				Health:  a.Health,
 			}
 			for _, p := range a.Props {
 				loaded.Props = append(loaded.Props, AddonStatusProp{
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/internal/core/addons/health"
 
 	"fmt"
 )
@@ -39,6 +40,7 @@ type AddonPrintStatus struct {
 	Implementation string            `json:"implementation"`
 	Enabled        *bool             `json:"enabled"`
 	Props          []AddonStatusProp `json:"props"`
+	Health         *health.Report    `json:"health,omitempty"`
 	Error          *string           `json:"error"`
 }
 
@@ -90,6 +92,7 @@ func (s *JsonPrinter) PrintStatus(addonName string, implementation string, loadF
 	var deferredErr error
 	printStatus.Enabled = loadedStatus.Enabled
 	printStatus.Props = loadedStatus.Props
+	printStatus.Health = loadedStatus.Health
 
 	slog.Info("Marhalling", "status", printStatus)
 
@@ -151,9 +154,39 @@ func (s *UserFriendlyPrinter) PrintStatus(addonName string, implementation strin
 		s.propPrinter.PrintProp(prop)
 	}
 
+	if status.Health != nil {
+		s.printHealth(status.Health)
+	}
+
 	return nil
 }
 
+func (s *UserFriendlyPrinter) printHealth(report *health.Report) {
+	s.terminalPrinter.Println("Health:", s.terminalPrinter.PrintCyanFg(string(report.State)))
+
+	for _, component := range report.Components {
+		text := fmt.Sprintf("%s %s/%s: %d/%d ready", component.Kind, component.Namespace, component.Name, component.ReadyReplicas, component.DesiredReplicas)
+		if component.Message != "" {
+			text += fmt.Sprintf(" (%s)", component.Message)
+		}
+
+		if component.State == health.Healthy {
+			s.terminalPrinter.PrintSuccess(text)
+		} else {
+			s.terminalPrinter.PrintWarning(text)
+		}
+	}
+
+	for _, ingress := range report.Ingresses {
+		text := fmt.Sprintf("Ingress %s/%s is reachable", ingress.Namespace, ingress.Name)
+		if ingress.Reachable {
+			s.terminalPrinter.PrintSuccess(text)
+			continue
+		}
+		s.terminalPrinter.PrintWarning(fmt.Sprintf("Ingress %s/%s is not reachable: %s", ingress.Namespace, ingress.Name, ingress.Message))
+	}
+}
+
 func (s *JsonPrinter) PrintSystemError(addon string, systemError error, systemCmdFailureFunc func() *common.CmdFailure) error {
 	printStatus := AddonPrintStatus{
 		Name:  addon,
@@ -20,6 +20,7 @@ import (
 	"github.com/siemens-healthineers/k2s/internal/core/addons"
 	"github.com/siemens-healthineers/k2s/internal/core/config"
 	"github.com/siemens-healthineers/k2s/internal/json"
+	"github.com/siemens-healthineers/k2s/internal/provider"
 )
 
 type StatusPrinter interface {
@@ -122,12 +123,49 @@ func runStatusCmd(cmd *cobra.Command, addon addons.Addon, implementation string,
 		}
 		slog.Info("Loading status", "addon", addonName, "directory", addonDir)
 
-		return LoadAddonStatus(context.Providers().Addon, addonName, addonDir)
+		return LoadAddonStatus(context.Providers().Addon, addonName, addonDir, healthManifests(addon, implementation))
 	}
 
 	return printer.PrintStatus(addon.Metadata.Name, implementation, loadFunc)
 }
 
+// healthManifests returns the manifest sources deployed by the implementation's 'enable' command,
+// as declared for dry-runs. Flag-dependent variants are optional, since only one of them is deployed.
+// Implementations without declared manifests fall back to the contents of their 'manifests' directory.
+func healthManifests(addon addons.Addon, implementation string) []provider.AddonManifestSource {
+	if implementation == "" {
+		implementation = addon.Metadata.Name
+	}
+
+	var sources []provider.AddonManifestSource
+	for _, impl := range addon.Spec.Implementations {
+		if impl.Name != implementation {
+			continue
+		}
+
+		var enableCmd addons.AddonCmd
+		if impl.Commands != nil {
+			enableCmd = (*impl.Commands)["enable"]
+		}
+		if enableCmd.DryRun == nil {
+			implDir := addon.Directory
+			if impl.Name != addon.Metadata.Name {
+				implDir = filepath.Join(addon.Directory, impl.Name)
+			}
+			sources = append(sources, provider.DiscoverManifests(filepath.Join(implDir, "manifests"), addon.Metadata.Name, impl.Name)...)
+			continue
+		}
+
+		for _, manifest := range enableCmd.DryRun.Manifests {
+			sources = append(sources, provider.AddonManifestSource{
+				Path:     filepath.Join(addon.Directory, filepath.FromSlash(manifest.Path)),
+				Optional: len(manifest.When) > 0,
+			})
+		}
+	}
+	return sources
+}
+
 func determinePrinter(outputOption string) StatusPrinter {
 	terminalPrinter := terminal.NewTerminalPrinter()
 
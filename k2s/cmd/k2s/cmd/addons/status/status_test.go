@@ -6,10 +6,15 @@ package status
 import (
 	"errors"
 	"log/slog"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
 	"github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/addons"
+	"github.com/siemens-healthineers/k2s/internal/core/addons/health"
+	"github.com/siemens-healthineers/k2s/internal/provider"
 	r "github.com/siemens-healthineers/k2s/internal/reflection"
 
 	"github.com/go-logr/logr"
@@ -189,6 +194,29 @@ var _ = Describe("status pkg", func() {
 					Expect(err).ToNot(HaveOccurred())
 					printerMock.AssertExpectations(GinkgoT())
 				})
+
+				It("includes the health report", func() {
+					addonName := "test-addon"
+					loadStatus := &LoadedAddonStatus{Enabled: new(true), Health: &health.Report{State: health.Degraded}}
+
+					loaderMock := &mockObject{}
+					loaderMock.On(r.GetFunctionName(loaderMock.loadAddonStatus), addonName, "").Return(loadStatus, nil)
+
+					marshalMock := &mockObject{}
+					marshalMock.On(r.GetFunctionName(marshalMock.marshalIndent), mock.MatchedBy(func(status AddonPrintStatus) bool {
+						return status.Health == loadStatus.Health
+					})).Return([]byte("status"), nil)
+
+					printerMock := &mockObject{}
+					printerMock.On(r.GetFunctionName(printerMock.Println), "status").Once()
+
+					sut := NewJsonPrinter(printerMock, marshalMock.marshalIndent)
+
+					err := sut.PrintStatus(addonName, "", loaderMock.loadAddonStatus)
+
+					Expect(err).ToNot(HaveOccurred())
+					marshalMock.AssertExpectations(GinkgoT())
+				})
 			})
 		})
 
@@ -532,6 +560,44 @@ var _ = Describe("status pkg", func() {
 					propPrintMock.AssertExpectations(GinkgoT())
 					spinnerMock.AssertExpectations(GinkgoT())
 				})
+
+				It("prints the health of components and ingresses", func() {
+					addonName := "test-addon"
+					loadedStatus := &LoadedAddonStatus{
+						Enabled: new(true),
+						Health: &health.Report{
+							State: health.Degraded,
+							Components: []health.Component{
+								{Kind: "Deployment", Namespace: "ns", Name: "a", State: health.Healthy, DesiredReplicas: 1, ReadyReplicas: 1},
+								{Kind: "DaemonSet", Namespace: "ns", Name: "b", State: health.Degraded, DesiredReplicas: 3, ReadyReplicas: 2, Message: "2/3 replicas ready"},
+							},
+							Ingresses: []health.Ingress{{Namespace: "ns", Name: "c", Message: "timeout"}},
+						},
+					}
+
+					spinnerMock := &mockObject{}
+					spinnerMock.On(r.GetFunctionName(spinnerMock.Stop)).Return(nil).Once()
+
+					printerMock := &mockObject{}
+					printerMock.On(r.GetFunctionName(printerMock.PrintHeader), mock.Anything)
+					printerMock.On(r.GetFunctionName(printerMock.StartSpinner), mock.Anything).Return(spinnerMock, nil)
+					printerMock.On(r.GetFunctionName(printerMock.Println), "Health:", "colored-text").Once()
+					printerMock.On(r.GetFunctionName(printerMock.Println), mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+					printerMock.On(r.GetFunctionName(printerMock.PrintCyanFg), mock.Anything).Return("colored-text")
+					printerMock.On(r.GetFunctionName(printerMock.PrintSuccess), "Deployment ns/a: 1/1 ready").Once()
+					printerMock.On(r.GetFunctionName(printerMock.PrintWarning), "DaemonSet ns/b: 2/3 ready (2/3 replicas ready)").Once()
+					printerMock.On(r.GetFunctionName(printerMock.PrintWarning), "Ingress ns/c is not reachable: timeout").Once()
+
+					loaderMock := &mockObject{}
+					loaderMock.On(r.GetFunctionName(loaderMock.loadAddonStatus), addonName, "").Return(loadedStatus, nil)
+
+					sut := NewUserFriendlyPrinter(printerMock)
+
+					err := sut.PrintStatus(addonName, "", loaderMock.loadAddonStatus)
+
+					Expect(err).ToNot(HaveOccurred())
+					printerMock.AssertExpectations(GinkgoT())
+				})
 			})
 		})
 
@@ -566,6 +632,89 @@ var _ = Describe("status pkg", func() {
 		})
 	})
 
+	Describe("healthManifests", func() {
+		It("returns the dry-run manifests of the enable command with flag-dependent ones being optional", func() {
+			commands := map[string]addons.AddonCmd{
+				"enable": {DryRun: &addons.DryRunConfig{Manifests: []addons.DryRunManifest{
+					{Path: "manifests/base"},
+					{Path: "manifests/variant", When: map[string]string{"flag": "true"}},
+				}}},
+			}
+			addon := addons.Addon{
+				Metadata:  addons.AddonMetadata{Name: "test-addon"},
+				Spec:      addons.AddonSpec{Implementations: []addons.Implementation{{Name: "test-addon", Commands: &commands}}},
+				Directory: filepath.Join("addons", "test-addon"),
+			}
+
+			sources := healthManifests(addon, "")
+
+			Expect(sources).To(Equal([]provider.AddonManifestSource{
+				{Path: filepath.Join("addons", "test-addon", "manifests", "base")},
+				{Path: filepath.Join("addons", "test-addon", "manifests", "variant"), Optional: true},
+			}))
+		})
+
+		It("returns nothing if the implementation has neither dry-run manifests nor a manifests directory", func() {
+			addon := addons.Addon{
+				Metadata:  addons.AddonMetadata{Name: "test-addon"},
+				Spec:      addons.AddonSpec{Implementations: []addons.Implementation{{Name: "impl"}}},
+				Directory: GinkgoT().TempDir(),
+			}
+
+			Expect(healthManifests(addon, "impl")).To(BeEmpty())
+		})
+
+		When("the addon declares no dry-run manifests", func() {
+			var addonDir string
+
+			writeFile := func(relPath string) {
+				path := filepath.Join(addonDir, filepath.FromSlash(relPath))
+				Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+				Expect(os.WriteFile(path, []byte("kind: Deployment\n"), 0644)).To(Succeed())
+			}
+
+			BeforeEach(func() {
+				addonDir = GinkgoT().TempDir()
+			})
+
+			It("returns the kustomizations and YAML files of the manifests directory with variants being optional", func() {
+				writeFile("manifests/viewer/kustomization.yaml")
+				writeFile("manifests/ingress-nginx/kustomization.yaml")
+				writeFile("manifests/rbac.yaml")
+				writeFile("manifests/chart/templates/deployment.yaml")
+				writeFile("manifests/README.md")
+				commands := map[string]addons.AddonCmd{"enable": {}}
+				addon := addons.Addon{
+					Metadata:  addons.AddonMetadata{Name: "viewer"},
+					Spec:      addons.AddonSpec{Implementations: []addons.Implementation{{Name: "viewer", Commands: &commands}}},
+					Directory: addonDir,
+				}
+
+				sources := healthManifests(addon, "")
+
+				Expect(sources).To(ConsistOf(
+					provider.AddonManifestSource{Path: filepath.Join(addonDir, "manifests", "viewer")},
+					provider.AddonManifestSource{Path: filepath.Join(addonDir, "manifests", "ingress-nginx"), Optional: true},
+					provider.AddonManifestSource{Path: filepath.Join(addonDir, "manifests", "rbac.yaml")},
+				))
+			})
+
+			It("returns the manifests directory of the implementation if it is a kustomization", func() {
+				writeFile("traefik/manifests/kustomization.yaml")
+				writeFile("traefik/manifests/deployment.yaml")
+				addon := addons.Addon{
+					Metadata:  addons.AddonMetadata{Name: "ingress"},
+					Spec:      addons.AddonSpec{Implementations: []addons.Implementation{{Name: "nginx"}, {Name: "traefik"}}},
+					Directory: addonDir,
+				}
+
+				sources := healthManifests(addon, "traefik")
+
+				Expect(sources).To(Equal([]provider.AddonManifestSource{{Path: filepath.Join(addonDir, "traefik", "manifests")}}))
+			})
+		})
+	})
+
 	Describe("propPrint", func() {
 		Describe("PrintProp", func() {
 			It("orchestrates text colorization and printing", func() {
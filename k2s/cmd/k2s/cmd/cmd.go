@@ -12,6 +12,7 @@ import (
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/addons"
 	cc "github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/doctor"
 	im "github.com/siemens-healthineers/k2s/cmd/k2s/cmd/image"
 	in "github.com/siemens-healthineers/k2s/cmd/k2s/cmd/install"
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/node"
@@ -104,6 +105,7 @@ func CreateRootCmd(logger *logging.Slogger) (*cobra.Command, error) {
 	cmd.AddCommand(un.Uninstallk8sCmd)
 	cmd.AddCommand(im.ImageCmd)
 	cmd.AddCommand(stat.StatusCmd)
+	cmd.AddCommand(doctor.DoctorCmd)
 	cmd.AddCommand(addonsCmd)
 	cmd.AddCommand(ve.VersionCmd)
 	cmd.AddCommand(sys.SystemCmd)
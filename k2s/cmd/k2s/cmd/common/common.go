@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/utils/logging"
+	"github.com/siemens-healthineers/k2s/internal/cli"
 	"github.com/siemens-healthineers/k2s/internal/contracts/config"
 	bl "github.com/siemens-healthineers/k2s/internal/logging"
 	"github.com/siemens-healthineers/k2s/internal/os"
@@ -36,6 +37,8 @@ type CmdFailure struct {
 	Code              string          `json:"code"`
 	Message           string          `json:"message"`
 	SuppressCliOutput bool
+	// ExitCode overrides the default exit code of failed commands if set
+	ExitCode cli.ExitCode `json:"-"`
 }
 
 type CmdResult struct {
@@ -0,0 +1,257 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package doctor
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	ac "github.com/siemens-healthineers/k2s/cmd/k2s/cmd/addons/common"
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/cmd/k2s/utils"
+	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/addons"
+	"github.com/siemens-healthineers/k2s/internal/core/config"
+	"github.com/siemens-healthineers/k2s/internal/core/doctor"
+	"github.com/siemens-healthineers/k2s/internal/json"
+	"github.com/siemens-healthineers/k2s/internal/provider"
+	"github.com/siemens-healthineers/k2s/internal/terminal"
+)
+
+type colorPrinter interface {
+	PrintRedFg(text string) string
+	PrintGreenFg(text string) string
+	PrintCyanFg(text string) string
+}
+
+const (
+	outputFlagName = "output"
+	checkFlagName  = "check"
+	jsonOption     = "json"
+
+	doctorCommandExample = `
+  # Run all health checks
+  k2s doctor
+
+  # Run selected health checks
+  k2s doctor --check api-server,cluster-dns-from-host
+
+  # Run all health checks and output the results as JSON
+  k2s doctor -o json
+`
+)
+
+var DoctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Runs health checks on the K2s cluster and suggests remediations",
+	Long: `
+Runs health checks on the K2s cluster, e.g. whether the API server is reachable, certificates are valid,
+the proxy is reachable, the routes to the Windows pod subnets are present, cluster DNS works, Flannel and
+kube-proxy pods are healthy and the control-plane has enough disk space. Enabled addons contribute their own checks.
+
+Each failed check is reported with its severity and a remediation hint. The command fails if any critical
+or warning check fails, so that it can be used in scripts.
+	`,
+	RunE:    runDoctor,
+	Example: doctorCommandExample,
+}
+
+func init() {
+	DoctorCmd.Flags().StringP(outputFlagName, "o", "", "Output format modifier. Currently supported: 'json' for output as JSON structure")
+	DoctorCmd.Flags().StringSlice(checkFlagName, nil, "Comma-separated IDs of the checks to run; all checks are run by default")
+	DoctorCmd.Flags().SortFlags = false
+	DoctorCmd.Flags().PrintDefaults()
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(cmd.CommandPath())
+
+	outputOption, err := cmd.Flags().GetString(outputFlagName)
+	if err != nil {
+		return err
+	}
+	if outputOption != "" && outputOption != jsonOption {
+		return fmt.Errorf("parameter '%s' not supported for flag 'o'", outputOption)
+	}
+
+	checkIds, err := cmd.Flags().GetStringSlice(checkFlagName)
+	if err != nil {
+		return err
+	}
+
+	context := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	runtimeConfig, err := config.ReadRuntimeConfig(context.Config().Host().K2sSetupConfigDir())
+	if err != nil {
+		if errors.Is(err, cconfig.ErrSystemInCorruptedState) {
+			return common.CreateSystemInCorruptedStateCmdFailure()
+		}
+		if errors.Is(err, cconfig.ErrSystemNotInstalled) {
+			return common.CreateSystemNotInstalledCmdFailure()
+		}
+		return err
+	}
+
+	if runtime.GOOS != "linux" {
+		if err := context.EnsureK2sK8sContext(runtimeConfig.ClusterConfig().Name()); err != nil {
+			return err
+		}
+	}
+
+	allAddons, err := addons.LoadAddons(utils.InstallDir())
+	if err != nil {
+		return err
+	}
+
+	podsChecks, err := addonPodsChecks(allAddons, runtimeConfig.ClusterConfig().EnabledAddons())
+	if err != nil {
+		return err
+	}
+
+	registry := doctor.NewRegistry()
+	err = registry.Register(context.Providers().System.DoctorChecks(provider.SystemDoctorConfig{
+		ControlPlaneName:      strings.ToLower(runtimeConfig.ControlPlaneConfig().Hostname()),
+		ControlPlaneIpAddress: context.Config().ControlPlane().IpAddress(),
+		SshPrivateKeyPath:     context.Config().Host().SshConfig().CurrentPrivateKeyPath(),
+		LinuxOnly:             runtimeConfig.InstallConfig().LinuxOnly(),
+		PodsChecks:            podsChecks,
+	})...)
+	if err != nil {
+		return err
+	}
+
+	printer := terminal.NewTerminalPrinter()
+
+	spinner, err := common.StartSpinner(printer)
+	if err != nil {
+		return err
+	}
+
+	report, err := registry.Run(checkIds...)
+
+	common.StopSpinner(spinner)
+
+	if err != nil {
+		return err
+	}
+
+	if outputOption == jsonOption {
+		bytes, err := json.MarshalIndent(report)
+		if err != nil {
+			return err
+		}
+		printer.Println(string(bytes))
+	} else {
+		printer.PrintHeader("K2s DOCTOR")
+		printer.PrintTableWithHeaders(buildResultsTable(report, printer))
+
+		if remediations := buildRemediations(report); len(remediations) > 0 {
+			printer.PrintWarning("Remediation hints:")
+			printer.PrintTreeListItems(remediations)
+		}
+	}
+
+	if failure := newChecksFailedFailure(report); failure != nil {
+		failure.SuppressCliOutput = outputOption == jsonOption
+		return failure
+	}
+
+	if outputOption != jsonOption {
+		printer.PrintSuccess("All checks passed")
+	}
+
+	cmdSession.Finish()
+
+	return nil
+}
+
+// addonPodsChecks returns the checks declared by the enabled addon implementations; their IDs are prefixed with
+// the addon (and implementation) name, e.g. 'ingress-nginx/controller-ready'.
+func addonPodsChecks(allAddons addons.Addons, enabledAddons []cconfig.Addon) ([]doctor.PodsCheck, error) {
+	var checks []doctor.PodsCheck
+	for _, implementation := range ac.EnabledImplementations(allAddons, enabledAddons) {
+		for _, check := range implementation.Checks {
+			if check.Pods == nil {
+				continue
+			}
+
+			severity, err := doctor.ParseSeverity(check.Severity)
+			if err != nil {
+				return nil, fmt.Errorf("addon '%s': check '%s': %w", implementation.AddonsCmdName, check.ID, err)
+			}
+
+			checks = append(checks, doctor.PodsCheck{
+				ID:          implementation.ExportDirectoryName + "/" + check.ID,
+				Description: check.Description,
+				Severity:    severity,
+				Remediation: check.Remediation,
+				Namespace:   check.Pods.Namespace,
+				Selector:    check.Pods.Selector,
+			})
+		}
+	}
+	return checks, nil
+}
+
+func buildResultsTable(report *doctor.Report, printer colorPrinter) [][]string {
+	table := [][]string{{"CHECK", "SEVERITY", "STATUS", "DETAILS"}}
+	for _, result := range report.Results {
+		status := string(result.Status)
+		details := result.Description
+
+		switch result.Status {
+		case doctor.StatusPassed:
+			status = printer.PrintGreenFg(status)
+		case doctor.StatusFailed:
+			status = printer.PrintRedFg(status)
+			details = result.Message
+		case doctor.StatusSkipped:
+			status = printer.PrintCyanFg(status)
+			details = result.Message
+		}
+
+		table = append(table, []string{result.ID, string(result.Severity), status, details})
+	}
+	return table
+}
+
+func buildRemediations(report *doctor.Report) []string {
+	var remediations []string
+	for _, result := range report.Results {
+		if result.Status == doctor.StatusFailed && result.Remediation != "" {
+			remediations = append(remediations, fmt.Sprintf("%s: %s", result.ID, result.Remediation))
+		}
+	}
+	return remediations
+}
+
+// newChecksFailedFailure returns an error failure if critical checks failed, a warning failure if warning checks failed.
+func newChecksFailedFailure(report *doctor.Report) *common.CmdFailure {
+	if critical := report.Failed(doctor.SeverityCritical); len(critical) > 0 {
+		return &common.CmdFailure{
+			Severity: common.SeverityError,
+			Code:     "doctor-critical-checks-failed",
+			Message:  fmt.Sprintf("%d critical check(s) failed: %s", len(critical), joinIds(critical)),
+		}
+	}
+	if warnings := report.Failed(doctor.SeverityWarning); len(warnings) > 0 {
+		return &common.CmdFailure{
+			Severity: common.SeverityWarning,
+			Code:     "doctor-checks-failed",
+			Message:  fmt.Sprintf("%d check(s) failed: %s", len(warnings), joinIds(warnings)),
+		}
+	}
+	return nil
+}
+
+func joinIds(results []doctor.Result) string {
+	var ids []string
+	for _, result := range results {
+		ids = append(ids, result.ID)
+	}
+	return strings.Join(ids, ", ")
+}
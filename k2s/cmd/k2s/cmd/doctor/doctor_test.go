@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package doctor
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/addons"
+	"github.com/siemens-healthineers/k2s/internal/core/doctor"
+)
+
+type plainPrinter struct{}
+
+func (plainPrinter) PrintRedFg(text string) string   { return "red:" + text }
+func (plainPrinter) PrintGreenFg(text string) string { return "green:" + text }
+func (plainPrinter) PrintCyanFg(text string) string  { return "cyan:" + text }
+
+func TestDoctorPkg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "doctor cmd Unit Tests", Label("unit", "ci", "doctor"))
+}
+
+var _ = Describe("doctor command", func() {
+	Describe("addonPodsChecks", func() {
+		newAddon := func(name string, implementations ...addons.Implementation) addons.Addon {
+			addon := addons.Addon{Metadata: addons.AddonMetadata{Name: name}}
+			for _, implementation := range implementations {
+				implementation.ExportDirectoryName = name
+				if implementation.Name != name {
+					implementation.ExportDirectoryName = name + "-" + implementation.Name
+				}
+				implementation.AddonsCmdName = name + " " + implementation.Name
+				addon.Spec.Implementations = append(addon.Spec.Implementations, implementation)
+			}
+			return addon
+		}
+		newCheck := func(id, severity string) addons.HealthCheck {
+			return addons.HealthCheck{
+				ID:          id,
+				Description: id + " description",
+				Severity:    severity,
+				Remediation: id + " remediation",
+				Pods:        &addons.PodsHealthCheck{Namespace: "ns", Selector: "app=" + id},
+			}
+		}
+
+		allAddons := addons.Addons{
+			newAddon("dashboard", addons.Implementation{Name: "dashboard", Checks: []addons.HealthCheck{newCheck("headlamp-ready", "warning")}}),
+			newAddon("ingress",
+				addons.Implementation{Name: "nginx", Checks: []addons.HealthCheck{newCheck("controller-ready", "critical")}},
+				addons.Implementation{Name: "traefik", Checks: []addons.HealthCheck{newCheck("controller-ready", "warning")}},
+			),
+		}
+
+		It("returns checks of enabled implementations with prefixed IDs", func() {
+			checks, err := addonPodsChecks(allAddons, []cconfig.Addon{{Name: "dashboard"}, {Name: "ingress", Implementation: "nginx"}})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checks).To(HaveExactElements(
+				doctor.PodsCheck{ID: "dashboard/headlamp-ready", Description: "headlamp-ready description", Severity: doctor.SeverityWarning, Remediation: "headlamp-ready remediation", Namespace: "ns", Selector: "app=headlamp-ready"},
+				doctor.PodsCheck{ID: "ingress-nginx/controller-ready", Description: "controller-ready description", Severity: doctor.SeverityCritical, Remediation: "controller-ready remediation", Namespace: "ns", Selector: "app=controller-ready"},
+			))
+		})
+
+		It("returns no checks if no addon is enabled", func() {
+			checks, err := addonPodsChecks(allAddons, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checks).To(BeEmpty())
+		})
+
+		It("fails on invalid severity", func() {
+			invalid := addons.Addons{newAddon("dashboard", addons.Implementation{Name: "dashboard", Checks: []addons.HealthCheck{newCheck("headlamp-ready", "fatal")}})}
+
+			_, err := addonPodsChecks(invalid, []cconfig.Addon{{Name: "dashboard"}})
+
+			Expect(err).To(MatchError(ContainSubstring("addon 'dashboard dashboard': check 'headlamp-ready': invalid severity 'fatal'")))
+		})
+	})
+
+	Describe("buildResultsTable", func() {
+		It("shows description of passed checks and message otherwise", func() {
+			report := &doctor.Report{Results: []doctor.Result{
+				{ID: "api-server", Description: "API server reachable", Severity: doctor.SeverityCritical, Status: doctor.StatusPassed},
+				{ID: "disk-space", Description: "disk", Severity: doctor.SeverityWarning, Status: doctor.StatusFailed, Message: "90% used"},
+				{ID: "windows-pod-routes", Description: "routes", Severity: doctor.SeverityCritical, Status: doctor.StatusSkipped, Message: "Linux-only setup"},
+			}}
+
+			table := buildResultsTable(report, plainPrinter{})
+
+			Expect(table).To(Equal([][]string{
+				{"CHECK", "SEVERITY", "STATUS", "DETAILS"},
+				{"api-server", "critical", "green:passed", "API server reachable"},
+				{"disk-space", "warning", "red:failed", "90% used"},
+				{"windows-pod-routes", "critical", "cyan:skipped", "Linux-only setup"},
+			}))
+		})
+	})
+
+	Describe("buildRemediations", func() {
+		It("lists remediations of failed checks", func() {
+			report := &doctor.Report{Results: []doctor.Result{
+				{ID: "api-server", Status: doctor.StatusPassed},
+				{ID: "disk-space", Status: doctor.StatusFailed, Remediation: "prune images"},
+				{ID: "proxy", Status: doctor.StatusFailed},
+			}}
+
+			Expect(buildRemediations(report)).To(Equal([]string{"disk-space: prune images"}))
+		})
+	})
+
+	Describe("newChecksFailedFailure", func() {
+		It("returns error failure if critical checks failed", func() {
+			report := &doctor.Report{Results: []doctor.Result{
+				{ID: "api-server", Severity: doctor.SeverityCritical, Status: doctor.StatusFailed},
+				{ID: "disk-space", Severity: doctor.SeverityWarning, Status: doctor.StatusFailed},
+			}}
+
+			failure := newChecksFailedFailure(report)
+
+			Expect(failure.Severity).To(Equal(common.SeverityError))
+			Expect(failure.Code).To(Equal("doctor-critical-checks-failed"))
+			Expect(failure.Message).To(Equal("1 critical check(s) failed: api-server"))
+		})
+
+		It("returns warning failure if warning checks failed", func() {
+			report := &doctor.Report{Results: []doctor.Result{
+				{ID: "proxy", Severity: doctor.SeverityWarning, Status: doctor.StatusFailed},
+				{ID: "info", Severity: doctor.SeverityInfo, Status: doctor.StatusFailed},
+			}}
+
+			failure := newChecksFailedFailure(report)
+
+			Expect(failure.Severity).To(Equal(common.SeverityWarning))
+			Expect(failure.Message).To(Equal("1 check(s) failed: proxy"))
+		})
+
+		It("returns nil if only info checks failed", func() {
+			report := &doctor.Report{Results: []doctor.Result{{ID: "info", Severity: doctor.SeverityInfo, Status: doctor.StatusFailed}}}
+
+			Expect(newChecksFailedFailure(report)).To(BeNil())
+		})
+	})
+})
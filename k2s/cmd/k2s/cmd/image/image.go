@@ -26,4 +26,6 @@ func init() {
 	ImageCmd.AddCommand(pullCmd)
 	ImageCmd.AddCommand(pushCmd)
 	ImageCmd.AddCommand(tagCmd)
+	ImageCmd.AddCommand(syncCmd)
+	ImageCmd.AddCommand(pruneCmd)
 }
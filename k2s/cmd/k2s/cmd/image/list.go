@@ -13,12 +13,14 @@ import (
 
 	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
 	"github.com/siemens-healthineers/k2s/internal/core/config"
+	"github.com/siemens-healthineers/k2s/internal/core/images"
 	"github.com/siemens-healthineers/k2s/internal/provider"
 	"github.com/siemens-healthineers/k2s/internal/terminal"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
 
 	"github.com/siemens-healthineers/k2s/internal/json"
+	"github.com/siemens-healthineers/k2s/internal/yaml"
 )
 
 type Spinner interface {
@@ -28,37 +30,43 @@ type Spinner interface {
 type LoadedImages struct {
 	common.CmdResult
 	ContainerImages   []containerImage `json:"containerimages"`
+	Images            []images.Image   `json:"images"`
 	ContainerRegistry *string          `json:"containerregistry"`
 	PushedImages      []pushedImage    `json:"pushedimages"`
 }
 
 type PrintImages struct {
-	ContainerImages   []containerImage `json:"containerimages"`
-	ContainerRegistry *string          `json:"containerregistry"`
-	PushedImages      []pushedImage    `json:"pushedimages"`
-	Error             *string          `json:"error"`
+	ContainerImages   []containerImage `json:"containerimages" yaml:"containerimages"`
+	Images            []images.Image   `json:"images" yaml:"images"`
+	ContainerRegistry *string          `json:"containerregistry" yaml:"containerregistry"`
+	PushedImages      []pushedImage    `json:"pushedimages" yaml:"pushedimages"`
+	Error             *string          `json:"error" yaml:"error"`
 }
 
 type containerImage struct {
-	ImageId    string `json:"imageid"`
-	Repository string `json:"repository"`
-	Tag        string `json:"tag"`
-	Node       string `json:"node"`
-	Size       string `json:"size"`
+	ImageId    string `json:"imageid" yaml:"imageid"`
+	Repository string `json:"repository" yaml:"repository"`
+	Tag        string `json:"tag" yaml:"tag"`
+	Node       string `json:"node" yaml:"node"`
+	Size       string `json:"size" yaml:"size"`
+	digest     string
 }
 
 type pushedImage struct {
-	Name string `json:"name"`
-	Tag  string `json:"tag"`
-	Node string `json:"node"`
+	Name string `json:"name" yaml:"name"`
+	Tag  string `json:"tag" yaml:"tag"`
+	Node string `json:"node" yaml:"node"`
 }
 
 const (
 	includeK8sImages = "include-k8s-images"
 	nodeFlagName     = "node"
 	nodesFlagName    = "nodes"
+	filterFlagName   = "filter"
 	outputFlagName   = "output"
 	jsonOption       = "json"
+	yamlOption       = "yaml"
+	wideOption       = "wide"
 
 	cmdExample = `
   # List images from the default nodes (Linux control-plane and local Windows host)
@@ -81,12 +89,26 @@ const (
 
   # List images from multiple specific nodes only
   k2s image ls --nodes worker-1,worker-2
+
+  # List images with digests, compressed/uncompressed sizes and usage
+  k2s image ls -o wide
+
+  # List images in YAML output format
+  k2s image ls -o yaml
+
+  # List images not used by any Pod and larger than 100MB
+  k2s image ls --filter unused=true --filter "size>100MB"
+
+  # List dangling images and images of a repository
+  k2s image ls --filter dangling=true
+  k2s image ls --filter "reference=*nginx*"
 `
 )
 
 var (
-	containerImagesTableHeaders = []string{"ImageId", "Repository", "Tag", "Node", "Size"}
-	pushedImagesTableHeaders    = []string{"Name", "Tag", "Node"}
+	containerImagesTableHeaders     = []string{"ImageId", "Repository", "Tag", "Nodes", "Size"}
+	wideContainerImagesTableHeaders = []string{"ImageId", "Digest", "Repository", "Tag", "Nodes", "Compressed", "Uncompressed", "Dangling", "Unused"}
+	pushedImagesTableHeaders        = []string{"Name", "Tag", "Node"}
 
 	listCmd = &cobra.Command{
 		Use:     "ls",
@@ -100,7 +122,8 @@ func init() {
 	listCmd.Flags().BoolP(includeK8sImages, "A", false, "Include kubernetes container images if specified")
 	listCmd.Flags().String(nodeFlagName, "", "Node name to list images from (e.g. worker-1)")
 	listCmd.Flags().String(nodesFlagName, "", "Comma-separated node names to list images from (e.g. worker-1,worker-2)")
-	listCmd.Flags().StringP(common.OutputFlagName, common.OutputFlagShorthand, "", "Output format modifier. Currently supported: 'json' for output as JSON structure")
+	listCmd.Flags().StringArray(filterFlagName, nil, "Filter images by '<key><operator><value>' conditions, comma-separated or repeated (keys: reference, node, dangling, unused, size)")
+	listCmd.Flags().StringP(common.OutputFlagName, common.OutputFlagShorthand, "", "Output format modifier. Currently supported: 'wide' for more information, 'json' and 'yaml' for output as JSON/YAML structure")
 	listCmd.Flags().SortFlags = false
 	listCmd.Flags().PrintDefaults()
 }
@@ -111,10 +134,20 @@ func listImages(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if outputOption != "" && outputOption != jsonOption {
+	if outputOption != "" && outputOption != jsonOption && outputOption != yamlOption && outputOption != wideOption {
 		return fmt.Errorf("parameter '%s' not supported for flag '%s'", outputOption, common.OutputFlagName)
 	}
 
+	filterExpressions, err := cmd.Flags().GetStringArray(filterFlagName)
+	if err != nil {
+		return err
+	}
+
+	filter, err := images.ParseFilter(filterExpressions)
+	if err != nil {
+		return err
+	}
+
 	includeK8sImages, err := strconv.ParseBool(cmd.Flags().Lookup(includeK8sImages).Value.String())
 	if err != nil {
 		return err
@@ -155,6 +188,7 @@ func listImages(cmd *cobra.Command, args []string) error {
 		if result.ContainerRegistry != "" {
 			loaded.ContainerRegistry = new(result.ContainerRegistry)
 		}
+		var nodeImages []images.NodeImage
 		for _, img := range result.ContainerImages {
 			loaded.ContainerImages = append(loaded.ContainerImages, containerImage{
 				ImageId:    img.ImageId,
@@ -162,6 +196,16 @@ func listImages(cmd *cobra.Command, args []string) error {
 				Tag:        img.Tag,
 				Node:       img.Node,
 				Size:       img.Size,
+				digest:     img.Digest,
+			})
+			nodeImages = append(nodeImages, images.NodeImage{
+				ImageId:          img.ImageId,
+				Repository:       img.Repository,
+				Tag:              img.Tag,
+				Digest:           img.Digest,
+				Node:             img.Node,
+				CompressedSize:   img.CompressedSize,
+				UncompressedSize: img.UncompressedSize,
 			})
 		}
 		for _, img := range result.PushedImages {
@@ -171,13 +215,34 @@ func listImages(cmd *cobra.Command, args []string) error {
 				Node: img.Node,
 			})
 		}
+
+		loaded.Images = filter.Apply(images.Aggregate(nodeImages, result.PodImages))
+		loaded.ContainerImages = selectContainerImages(loaded.ContainerImages, loaded.Images)
 		return loaded, nil
 	}
 
-	if outputOption == jsonOption {
-		return printImagesAsJson(getImagesFunc, terminalPrinter.Println)
+	switch outputOption {
+	case jsonOption:
+		return printImagesStructured(getImagesFunc, terminalPrinter.Println, json.MarshalIndent)
+	case yamlOption:
+		return printImagesStructured(getImagesFunc, terminalPrinter.Println, yaml.Marshal)
+	default:
+		return printImagesToUser(getImagesFunc, terminalPrinter, outputOption == wideOption)
 	}
-	return printImagesToUser(getImagesFunc, terminalPrinter)
+}
+
+// selectContainerImages returns the per-node images belonging to the given (filtered) aggregated images.
+func selectContainerImages(containerImages []containerImage, selected []images.Image) []containerImage {
+	var result []containerImage
+	for _, containerImage := range containerImages {
+		for _, image := range selected {
+			if containerImage.digest == image.Digest && containerImage.ImageId == image.ImageId {
+				result = append(result, containerImage)
+				break
+			}
+		}
+	}
+	return result
 }
 
 func handleListImagesRuntimeConfigErr(err error, outputOption string, printlnFunc func(m ...any)) error {
@@ -197,7 +262,7 @@ func handleListImagesRuntimeConfigErr(err error, outputOption string, printlnFun
 	return err
 }
 
-func printImagesAsJson(getImagesFunc func() (*LoadedImages, error), printlnFunc func(m ...any)) error {
+func printImagesStructured(getImagesFunc func() (*LoadedImages, error), printlnFunc func(m ...any), marshalFunc func(data any) ([]byte, error)) error {
 	loadedImages, err := getImagesFunc()
 	if err != nil {
 		return err
@@ -205,6 +270,7 @@ func printImagesAsJson(getImagesFunc func() (*LoadedImages, error), printlnFunc
 
 	printImages := PrintImages{
 		ContainerImages:   loadedImages.ContainerImages,
+		Images:            loadedImages.Images,
 		ContainerRegistry: loadedImages.ContainerRegistry,
 		PushedImages:      loadedImages.PushedImages,
 	}
@@ -216,7 +282,7 @@ func printImagesAsJson(getImagesFunc func() (*LoadedImages, error), printlnFunc
 		deferredErr = loadedImages.Failure
 	}
 
-	bytes, err := json.MarshalIndent(printImages)
+	bytes, err := marshalFunc(printImages)
 	if err != nil {
 		return fmt.Errorf("error happened during list images: %w", errors.Join(deferredErr, err))
 	}
@@ -244,13 +310,13 @@ func printSystemErrJson(printlnFunc func(m ...any), systemError error, systemCmd
 	return failure
 }
 
-func printImagesToUser(getImagesFunc func() (*LoadedImages, error), printer terminal.TerminalPrinter) error {
+func printImagesToUser(getImagesFunc func() (*LoadedImages, error), printer terminal.TerminalPrinter, wide bool) error {
 	spinner, err := common.StartSpinner(printer)
 	if err != nil {
 		return err
 	}
 
-	images, err := getImagesFunc()
+	loadedImages, err := getImagesFunc()
 
 	common.StopSpinner(spinner)
 
@@ -258,36 +324,76 @@ func printImagesToUser(getImagesFunc func() (*LoadedImages, error), printer term
 		return err
 	}
 
-	if images.Failure != nil {
-		return images.Failure
+	if loadedImages.Failure != nil {
+		return loadedImages.Failure
 	}
 
-	if len(images.ContainerImages) > 0 {
-		printAvailableImages(printer, images.ContainerImages)
+	if len(loadedImages.Images) > 0 {
+		printer.PrintHeader("Available Images")
+		printer.PrintTableWithHeaders(buildImagesTable(loadedImages.Images, wide))
 	} else {
 		printer.PrintInfoln("No container images were found in the cluster")
 	}
 
-	if images.ContainerRegistry != nil && *images.ContainerRegistry != "" {
-		if len(images.PushedImages) == 0 {
-			printer.PrintInfoln("No pushed images in registry " + *images.ContainerRegistry)
+	if loadedImages.ContainerRegistry != nil && *loadedImages.ContainerRegistry != "" {
+		if len(loadedImages.PushedImages) == 0 {
+			printer.PrintInfoln("No pushed images in registry " + *loadedImages.ContainerRegistry)
 		} else {
-			printAvailableImagesInContainerRegistry(printer, *images.ContainerRegistry, images.PushedImages)
+			printAvailableImagesInContainerRegistry(printer, *loadedImages.ContainerRegistry, loadedImages.PushedImages)
 		}
 	}
 
 	return nil
 }
 
-func printAvailableImages(terminalPrinter terminal.TerminalPrinter, containerImages []containerImage) {
-	terminalPrinter.PrintHeader("Available Images")
+// buildImagesTable returns one row per image reference, untagged images get a single '<none>' row.
+func buildImagesTable(inventory []images.Image, wide bool) [][]string {
+	table := [][]string{containerImagesTableHeaders}
+	if wide {
+		table = [][]string{wideContainerImagesTableHeaders}
+	}
 
-	containerImagesTable := [][]string{containerImagesTableHeaders}
-	for _, containerImage := range containerImages {
-		row := []string{containerImage.ImageId, containerImage.Repository, containerImage.Tag, containerImage.Node, containerImage.Size}
-		containerImagesTable = append(containerImagesTable, row)
+	for _, image := range inventory {
+		references := image.References
+		if len(references) == 0 {
+			references = []string{""}
+		}
+
+		nodes := strings.Join(image.Nodes, ",")
+		for _, reference := range references {
+			repository, tag := splitReference(reference)
+
+			if !wide {
+				size := image.CompressedSize
+				if size == 0 {
+					size = image.UncompressedSize
+				}
+				table = append(table, []string{image.ImageId, repository, tag, nodes, images.FormatSize(size)})
+				continue
+			}
+
+			digest := image.Digest
+			if digest == "" {
+				digest = "<none>"
+			}
+			table = append(table, []string{
+				image.ImageId, digest, repository, tag, nodes,
+				images.FormatSize(image.CompressedSize), images.FormatSize(image.UncompressedSize),
+				strconv.FormatBool(image.Dangling), strconv.FormatBool(image.Unused),
+			})
+		}
+	}
+	return table
+}
+
+func splitReference(reference string) (repository string, tag string) {
+	if reference == "" {
+		return "<none>", "<none>"
+	}
+	if index := strings.LastIndex(reference, ":"); index > strings.LastIndex(reference, "/") {
+		return reference[:index], reference[index+1:]
 	}
-	terminalPrinter.PrintTableWithHeaders(containerImagesTable)
+	return reference, "<none>"
 }
 
 func printAvailableImagesInContainerRegistry(terminalPrinter terminal.TerminalPrinter, containerRegistry string, pushedImages []pushedImage) {
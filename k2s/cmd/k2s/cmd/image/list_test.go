@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package image
+
+import (
+	"github.com/siemens-healthineers/k2s/internal/core/images"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("list", func() {
+	inventory := []images.Image{
+		{
+			ImageId:          "aaa",
+			Digest:           "sha256:111",
+			References:       []string{"docker.io/library/nginx:1.27", "k2s.registry.local:30500/nginx"},
+			Nodes:            []string{"kubemaster", "worker-1"},
+			CompressedSize:   75_200_000,
+			UncompressedSize: 190_000_000,
+		},
+		{ImageId: "bbb", References: []string{}, Nodes: []string{"winnode"}, UncompressedSize: 1_000, Dangling: true, Unused: true},
+	}
+
+	Describe("buildImagesTable", func() {
+		It("prints one row per reference with aggregated nodes", func() {
+			table := buildImagesTable(inventory, false)
+
+			Expect(table).To(Equal([][]string{
+				containerImagesTableHeaders,
+				{"aaa", "docker.io/library/nginx", "1.27", "kubemaster,worker-1", "75.2MB"},
+				{"aaa", "k2s.registry.local:30500/nginx", "<none>", "kubemaster,worker-1", "75.2MB"},
+				{"bbb", "<none>", "<none>", "winnode", "1kB"},
+			}))
+		})
+
+		It("prints digests, both sizes and usage in wide mode", func() {
+			table := buildImagesTable(inventory, true)
+
+			Expect(table).To(HaveLen(4))
+			Expect(table[0]).To(Equal(wideContainerImagesTableHeaders))
+			Expect(table[1]).To(Equal([]string{"aaa", "sha256:111", "docker.io/library/nginx", "1.27", "kubemaster,worker-1", "75.2MB", "190MB", "false", "false"}))
+			Expect(table[3]).To(Equal([]string{"bbb", "<none>", "<none>", "<none>", "winnode", "-", "1kB", "true", "true"}))
+		})
+	})
+
+	Describe("selectContainerImages", func() {
+		It("keeps the per-node images of the selected images only, matching digest and image ID", func() {
+			containerImages := []containerImage{
+				{ImageId: "aaa", Node: "kubemaster", digest: "sha256:111"},
+				{ImageId: "aaa", Node: "worker-1", digest: "sha256:111"},
+				{ImageId: "bbb", Node: "winnode"},
+				{ImageId: "ccc", Node: "winnode"},
+				{ImageId: "ddd", Node: "winnode", digest: "sha256:111"},
+			}
+
+			selected := selectContainerImages(containerImages, inventory)
+
+			Expect(selected).To(HaveLen(3))
+			Expect(selected[2].ImageId).To(Equal("bbb"))
+		})
+	})
+})
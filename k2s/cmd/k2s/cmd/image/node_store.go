@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package image
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	cssh "github.com/siemens-healthineers/k2s/internal/contracts/ssh"
+	"github.com/siemens-healthineers/k2s/internal/core/clusterconfig"
+	"github.com/siemens-healthineers/k2s/internal/core/images"
+	"github.com/siemens-healthineers/k2s/internal/definitions"
+	"github.com/siemens-healthineers/k2s/internal/providers/ssh"
+)
+
+// remoteArchiveName is the image archive on a node, relative to the remote user's home directory.
+const remoteArchiveName = "k2s-image-sync.tar"
+
+type syncNode struct {
+	name      string
+	os        string
+	ipAddress string
+	username  string
+	local     bool // the Windows host, accessed without SSH
+}
+
+// sshNodeStore accesses the container image store of a node via SSH/SFTP.
+type sshNodeStore struct {
+	node       syncNode
+	privateKey string
+}
+
+func (s *sshNodeStore) Name() string {
+	return s.node.name
+}
+
+func (s *sshNodeStore) OS() string {
+	return s.node.os
+}
+
+func (s *sshNodeStore) Digest(image string) (string, error) {
+	output, err := s.exec(s.withSudo("crictl inspecti -o json " + s.quote(image)))
+	if err != nil {
+		if isImageNotFoundOutput(output) {
+			return "", nil
+		}
+		return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(output))
+	}
+	return parseInspectedImageId(output)
+}
+
+func (s *sshNodeStore) Export(image string, archivePath string) error {
+	var exportCmd string
+	if s.node.os == clusterconfig.OsTypeWindows {
+		exportCmd = fmt.Sprintf("ctr -n k8s.io images export %s %s", remoteArchiveName, s.quote(images.NormalizeReference(image)))
+	} else {
+		exportCmd = fmt.Sprintf("sudo buildah push %s %s", s.quote(image), s.quote("oci-archive:"+remoteArchiveName+":"+image))
+	}
+	defer s.removeRemoteArchive()
+
+	if output, err := s.exec(exportCmd); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(output))
+	}
+	return ssh.Copy(cssh.CopyOptions{Source: remoteArchiveName, Target: archivePath, Direction: cssh.CopyFromNode}, s.connectionOptions(nil))
+}
+
+func (s *sshNodeStore) Import(image string, archivePath string) error {
+	if err := ssh.Copy(cssh.CopyOptions{Source: archivePath, Target: remoteArchiveName, Direction: cssh.CopyToNode}, s.connectionOptions(nil)); err != nil {
+		return err
+	}
+	defer s.removeRemoteArchive()
+
+	var importCmd string
+	if s.node.os == clusterconfig.OsTypeWindows {
+		importCmd = "ctr -n k8s.io images import " + remoteArchiveName
+	} else {
+		importCmd = "sudo buildah pull oci-archive:" + remoteArchiveName
+	}
+
+	if output, err := s.exec(importCmd); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(output))
+	}
+	return nil
+}
+
+// removeRemoteArchive deletes the archive on the node; a failed cleanup does not fail the sync.
+func (s *sshNodeStore) removeRemoteArchive() {
+	if output, err := s.exec(removeArchiveCommand(s.node.os)); err != nil {
+		slog.Warn("Failed to remove image archive on node", "node", s.node.name, "error", err, "output", output)
+	}
+}
+
+// removeArchiveCommand runs 'del' via cmd on Windows nodes, since the default SSH shell may be PowerShell, where
+// 'del' is an alias of Remove-Item not accepting '/q'.
+func removeArchiveCommand(nodeOs string) string {
+	if nodeOs == clusterconfig.OsTypeWindows {
+		return `cmd /c del /q "` + remoteArchiveName + `"`
+	}
+	return "sudo rm -f " + remoteArchiveName
+}
+
+// quote protects an argument from the remote shell. Since the shell of Windows nodes may be cmd or PowerShell,
+// which do not share escaping rules, image references are additionally validated before syncing.
+func (s *sshNodeStore) quote(arg string) string {
+	if s.node.os == clusterconfig.OsTypeWindows {
+		return `"` + arg + `"`
+	}
+	return quoteShellArg(arg)
+}
+
+// quoteShellArg single-quotes a value for POSIX shells.
+func quoteShellArg(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+func (s *sshNodeStore) withSudo(command string) string {
+	if s.node.os == clusterconfig.OsTypeWindows {
+		return command
+	}
+	return "sudo " + command
+}
+
+// exec runs the command on the node, returning stdout and stderr combined
+func (s *sshNodeStore) exec(command string) (string, error) {
+	output := new(bytes.Buffer)
+
+	slog.Debug("Executing command on node", "node", s.node.name, "command", command)
+
+	err := ssh.Exec(command+" 2>&1", s.connectionOptions(output))
+	return output.String(), err
+}
+
+func (s *sshNodeStore) connectionOptions(output *bytes.Buffer) cssh.ConnectionOptions {
+	options := cssh.ConnectionOptions{
+		IpAddress:         s.node.ipAddress,
+		Port:              definitions.SSHDefaultPort,
+		RemoteUser:        s.node.username,
+		SshPrivateKeyPath: s.privateKey,
+		Timeout:           definitions.SSHDefaultTimeout,
+	}
+	if output != nil {
+		options.StdOutWriter = output
+	}
+	return options
+}
+
+// localNodeStore accesses the container image store of the Windows host, which is a node itself.
+type localNodeStore struct {
+	node       syncNode
+	installDir string
+}
+
+func (s *localNodeStore) Name() string {
+	return s.node.name
+}
+
+func (s *localNodeStore) OS() string {
+	return s.node.os
+}
+
+func (s *localNodeStore) Digest(image string) (string, error) {
+	output, err := s.exec(filepath.Join(s.installDir, "bin", "crictl.exe"), "inspecti", "-o", "json", image)
+	if err != nil {
+		if isImageNotFoundOutput(output) {
+			return "", nil
+		}
+		return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(output))
+	}
+	return parseInspectedImageId(output)
+}
+
+func (s *localNodeStore) Export(image string, archivePath string) error {
+	return s.ctr("images", "export", archivePath, images.NormalizeReference(image))
+}
+
+func (s *localNodeStore) Import(image string, archivePath string) error {
+	return s.ctr("images", "import", archivePath)
+}
+
+func (s *localNodeStore) ctr(args ...string) error {
+	output, err := s.exec(filepath.Join(s.installDir, "bin", "containerd", "ctr.exe"), append([]string{"-n", "k8s.io"}, args...)...)
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(output))
+	}
+	return nil
+}
+
+// exec runs the binary on the host, returning stdout and stderr combined
+func (s *localNodeStore) exec(binary string, args ...string) (string, error) {
+	slog.Debug("Executing command on local node", "node", s.node.name, "binary", binary, "args", args)
+
+	output, err := exec.Command(binary, args...).CombinedOutput()
+	return string(output), err
+}
+
+func isImageNotFoundOutput(output string) bool {
+	output = strings.ToLower(output)
+	return strings.Contains(output, "no such image") || strings.Contains(output, "not found")
+}
+
+func parseInspectedImageId(output string) (string, error) {
+	var inspected struct {
+		Status struct {
+			Id string `json:"id"`
+		} `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(output), &inspected); err != nil {
+		return "", fmt.Errorf("failed to parse image inspection: %w", err)
+	}
+	return inspected.Status.Id, nil
+}
@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package image
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+
+	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/config"
+	"github.com/siemens-healthineers/k2s/internal/core/images"
+	kstrings "github.com/siemens-healthineers/k2s/internal/primitives/strings"
+	"github.com/siemens-healthineers/k2s/internal/provider"
+	"github.com/siemens-healthineers/k2s/internal/terminal"
+)
+
+const (
+	unusedForFlagName    = "unused-for"
+	keepLatestFlagName   = "keep-latest"
+	danglingOnlyFlagName = "dangling-only"
+	maxUsageFlagName     = "max-usage"
+	pruneDryRunFlagName  = "dry-run"
+)
+
+var (
+	pruneTableHeaders = []string{"Node", "ImageId", "References", "Size", "Created", "Last Used"}
+
+	pruneCmd = &cobra.Command{
+		Use:   "prune",
+		Short: "Remove container images from the nodes according to a policy",
+		Long: `Removes container images from the nodes according to a policy. Images used by Pods and Kubernetes system images are never removed.
+All given criteria must be met; without any criteria, only dangling images are removed.`,
+		Example: `
+  # Preview which images would be removed and the space reclaimed per node
+  k2s image prune --unused-for 7d --dry-run
+
+  # Remove images not used for 7 days, keeping the 2 most recent images per repository and node
+  k2s image prune --unused-for 7d --keep-latest 2
+
+  # Remove dangling images from a specific worker node only
+  k2s image prune --dangling-only --node worker-1
+
+  # Remove the oldest images on nodes whose images occupy more than 20GB, until falling below
+  k2s image prune --max-usage 20GB
+`,
+		RunE: pruneImages,
+	}
+)
+
+func init() {
+	addPrunePolicyFlags(pruneCmd.Flags())
+	pruneCmd.Flags().Bool(pruneDryRunFlagName, false, "Show the images to be removed and the space reclaimed per node without removing anything")
+	addNodeSelectionFlags(pruneCmd)
+	pruneCmd.Flags().SortFlags = false
+	pruneCmd.Flags().PrintDefaults()
+}
+
+func pruneImages(cmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(cmd.CommandPath())
+	printer := terminal.NewTerminalPrinter()
+
+	policy, err := parsePrunePolicy(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool(pruneDryRunFlagName)
+	if err != nil {
+		return fmt.Errorf("unable to parse flag '%s': %w", pruneDryRunFlagName, err)
+	}
+
+	nodeSelector, err := parseNodeSelector(cmd)
+	if err != nil {
+		return err
+	}
+
+	showOutput, err := strconv.ParseBool(cmd.Flags().Lookup(common.OutputFlagName).Value.String())
+	if err != nil {
+		return err
+	}
+
+	context := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	if _, err := config.ReadRuntimeConfig(context.Config().Host().K2sSetupConfigDir()); err != nil {
+		if errors.Is(err, cconfig.ErrSystemInCorruptedState) {
+			return common.CreateSystemInCorruptedStateCmdFailure()
+		}
+		if errors.Is(err, cconfig.ErrSystemNotInstalled) {
+			return common.CreateSystemNotInstalledCmdFailure()
+		}
+		return err
+	}
+
+	spinner, err := common.StartSpinner(printer)
+	if err != nil {
+		return err
+	}
+
+	result, err := context.Providers().Image.List(provider.ImageListConfig{
+		Nodes:            nodeSelector,
+		RequirePodImages: true,
+		ShowOutput:       showOutput,
+	})
+
+	common.StopSpinner(spinner)
+
+	if err != nil {
+		return err
+	}
+
+	var nodeImages []images.NodeImage
+	for _, img := range result.ContainerImages {
+		nodeImages = append(nodeImages, images.NodeImage{
+			ImageId:          img.ImageId,
+			Repository:       img.Repository,
+			Tag:              img.Tag,
+			Digest:           img.Digest,
+			Node:             img.Node,
+			CompressedSize:   img.CompressedSize,
+			UncompressedSize: img.UncompressedSize,
+			Created:          img.Created,
+			LastUsed:         img.LastUsed,
+		})
+	}
+
+	plans := images.PlanPrune(nodeImages, result.PodImages, policy, time.Now())
+
+	if !hasPruneCandidates(plans) {
+		printer.PrintInfoln("No container images to prune")
+		cmdSession.Finish()
+		return nil
+	}
+
+	printer.PrintHeader("Images to prune")
+	printer.PrintTableWithHeaders(buildPruneTable(plans))
+	for _, plan := range plans {
+		printer.PrintInfofln("Node '%s': %d image(s), %s of %s reclaimable", plan.Node, len(plan.Images), images.FormatSize(plan.Reclaimed), images.FormatSize(plan.Usage))
+	}
+
+	if dryRun {
+		printer.PrintInfoln("Dry run, no images were removed")
+		cmdSession.Finish()
+		return nil
+	}
+
+	var errs []error
+	for _, plan := range plans {
+		var reclaimed int64
+		for _, candidate := range plan.Images {
+			if err := context.Providers().Image.Remove(provider.ImageRemoveConfig{
+				ImageId:    candidate.ImageId,
+				Nodes:      plan.Node,
+				ShowOutput: showOutput,
+			}); err != nil {
+				errs = append(errs, fmt.Errorf("failed to remove image '%s' from node '%s': %w", candidate.ImageId, plan.Node, err))
+				continue
+			}
+			reclaimed += candidate.Size
+		}
+		if len(plan.Images) > 0 {
+			printer.PrintSuccess(fmt.Sprintf("Node '%s': %s reclaimed", plan.Node, images.FormatSize(reclaimed)))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	cmdSession.Finish()
+
+	return nil
+}
+
+func addPrunePolicyFlags(flags *pflag.FlagSet) {
+	flags.String(unusedForFlagName, "", "Remove only images no container was started from for the given time, e.g. '7d' or '12h'; images never run count from their creation")
+	flags.Int(keepLatestFlagName, 0, "Keep the given number of most recent images per repository and node")
+	flags.Bool(danglingOnlyFlagName, false, "Remove only images without repository and tag")
+	flags.String(maxUsageFlagName, "", "Prune only nodes whose images occupy more than the given size (e.g. '20GB'), removing the oldest images until falling below")
+}
+
+func parsePrunePolicy(flags *pflag.FlagSet) (images.PrunePolicy, error) {
+	var policy images.PrunePolicy
+
+	unusedFor, err := flags.GetString(unusedForFlagName)
+	if err != nil {
+		return policy, fmt.Errorf("unable to parse flag '%s': %w", unusedForFlagName, err)
+	}
+	if unusedFor != "" {
+		if policy.UnusedFor, err = kstrings.ParseAge(unusedFor); err != nil {
+			return policy, fmt.Errorf("invalid value for flag '%s': %w", unusedForFlagName, err)
+		}
+	}
+
+	if policy.KeepLatest, err = flags.GetInt(keepLatestFlagName); err != nil {
+		return policy, fmt.Errorf("unable to parse flag '%s': %w", keepLatestFlagName, err)
+	}
+	if policy.KeepLatest < 0 {
+		return policy, fmt.Errorf("invalid value for flag '%s': must not be negative", keepLatestFlagName)
+	}
+
+	if policy.DanglingOnly, err = flags.GetBool(danglingOnlyFlagName); err != nil {
+		return policy, fmt.Errorf("unable to parse flag '%s': %w", danglingOnlyFlagName, err)
+	}
+
+	maxUsage, err := flags.GetString(maxUsageFlagName)
+	if err != nil {
+		return policy, fmt.Errorf("unable to parse flag '%s': %w", maxUsageFlagName, err)
+	}
+	if maxUsage != "" {
+		if policy.MaxUsage, err = images.ParseSize(maxUsage); err != nil {
+			return policy, fmt.Errorf("invalid value for flag '%s': %w", maxUsageFlagName, err)
+		}
+	}
+
+	if policy == (images.PrunePolicy{}) {
+		policy.DanglingOnly = true
+	}
+	return policy, nil
+}
+
+func buildPruneTable(plans []images.NodePrunePlan) [][]string {
+	table := [][]string{pruneTableHeaders}
+	for _, plan := range plans {
+		for _, candidate := range plan.Images {
+			references := strings.Join(candidate.References, ",")
+			if references == "" {
+				references = "<none>"
+			}
+			table = append(table, []string{plan.Node, candidate.ImageId, references, images.FormatSize(candidate.Size), formatDate(candidate.Created), formatDate(candidate.LastUsed)})
+		}
+	}
+	return table
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format(time.DateOnly)
+}
+
+func hasPruneCandidates(plans []images.NodePrunePlan) bool {
+	for _, plan := range plans {
+		if len(plan.Images) > 0 {
+			return true
+		}
+	}
+	return false
+}
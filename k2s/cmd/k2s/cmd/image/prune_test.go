@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package image
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/siemens-healthineers/k2s/internal/core/images"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("prune", func() {
+	Describe("parsePrunePolicy", func() {
+		parse := func(args ...string) (images.PrunePolicy, error) {
+			flags := pflag.NewFlagSet("prune", pflag.ContinueOnError)
+			addPrunePolicyFlags(flags)
+			Expect(flags.Parse(args)).To(Succeed())
+			return parsePrunePolicy(flags)
+		}
+
+		It("prunes dangling images only if no criteria are given", func() {
+			policy, err := parse()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy).To(Equal(images.PrunePolicy{DanglingOnly: true}))
+		})
+
+		It("parses all criteria", func() {
+			policy, err := parse("--unused-for", "7d", "--keep-latest", "2", "--max-usage", "20GB")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy).To(Equal(images.PrunePolicy{UnusedFor: 7 * 24 * time.Hour, KeepLatest: 2, MaxUsage: 20_000_000_000}))
+		})
+
+		DescribeTable("rejects invalid values",
+			func(args []string, expectedError string) {
+				_, err := parse(args...)
+
+				Expect(err).To(MatchError(ContainSubstring(expectedError)))
+			},
+			Entry("age", []string{"--unused-for", "a week"}, "invalid value for flag 'unused-for'"),
+			Entry("negative count", []string{"--keep-latest", "-1"}, "must not be negative"),
+			Entry("size", []string{"--max-usage", "lots"}, "invalid value for flag 'max-usage'"),
+		)
+	})
+
+	Describe("buildPruneTable", func() {
+		It("prints one row per image and node", func() {
+			plans := []images.NodePrunePlan{
+				{Node: "n1", Images: []images.PruneCandidate{
+					{ImageId: "aaa", References: []string{"app:v1", "app:old"}, Size: 1_500_000, Created: time.Date(2026, 1, 2, 12, 0, 0, 0, time.Local), LastUsed: time.Date(2026, 3, 4, 12, 0, 0, 0, time.Local)},
+					{ImageId: "bbb", References: []string{}, Size: 1_000},
+				}},
+				{Node: "n2", Images: []images.PruneCandidate{}},
+			}
+
+			Expect(buildPruneTable(plans)).To(Equal([][]string{
+				pruneTableHeaders,
+				{"n1", "aaa", "app:v1,app:old", "1.5MB", "2026-01-02", "2026-03-04"},
+				{"n1", "bbb", "<none>", "1kB", "-", "-"},
+			}))
+		})
+	})
+})
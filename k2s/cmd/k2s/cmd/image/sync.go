@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package image
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+
+	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/clusterconfig"
+	"github.com/siemens-healthineers/k2s/internal/core/config"
+	"github.com/siemens-healthineers/k2s/internal/core/images"
+	"github.com/siemens-healthineers/k2s/internal/definitions"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+const (
+	syncImageNameFlagName = "name"
+	sourceNodeFlagName    = "from"
+)
+
+var (
+	syncCommandExample = `
+  # Copy an image to two worker nodes; the source node is detected automatically
+  k2s image sync --name k2s.registry.local/myimage:v1 --nodes worker-1,worker-2
+
+  # Copy an image from the control-plane to a worker node
+  k2s image sync --name k2s.registry.local/myimage:v1 --from kubemaster --nodes worker-1
+`
+	syncCmd = &cobra.Command{
+		Use:   "sync",
+		Short: "Copy an image between nodes",
+		Long: `Copies an image from the container image store of one node to other nodes via SSH, without a registry.
+Nodes already holding the image are skipped. Since container images are platform-specific, images can only be synced between nodes of the same OS.`,
+		Example: syncCommandExample,
+		RunE:    syncImage,
+	}
+)
+
+func init() {
+	syncCmd.Flags().StringP(syncImageNameFlagName, "n", "", "[required] Name of the container image including tag")
+	syncCmd.Flags().String(nodesFlagName, "", "[required] Comma-separated names of the nodes to copy the image to (e.g. worker-1,worker-2)")
+	syncCmd.Flags().String(sourceNodeFlagName, "", "Name of the node to copy the image from; if omitted, the first node holding the image is used")
+	syncCmd.MarkFlagRequired(syncImageNameFlagName)
+	syncCmd.MarkFlagRequired(nodesFlagName)
+	syncCmd.Flags().SortFlags = false
+	syncCmd.Flags().PrintDefaults()
+}
+
+func syncImage(cmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(cmd.CommandPath())
+
+	imageName, err := cmd.Flags().GetString(syncImageNameFlagName)
+	if err != nil {
+		return fmt.Errorf("unable to parse flag '%s': %w", syncImageNameFlagName, err)
+	}
+	if err := images.ValidateReference(imageName); err != nil {
+		return err
+	}
+
+	targetNames, err := cmd.Flags().GetString(nodesFlagName)
+	if err != nil {
+		return fmt.Errorf("unable to parse flag '%s': %w", nodesFlagName, err)
+	}
+
+	sourceName, err := cmd.Flags().GetString(sourceNodeFlagName)
+	if err != nil {
+		return fmt.Errorf("unable to parse flag '%s': %w", sourceNodeFlagName, err)
+	}
+
+	context := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	k2sConfig := context.Config()
+	runtimeConfig, err := config.ReadRuntimeConfig(k2sConfig.Host().K2sSetupConfigDir())
+	if err != nil {
+		if errors.Is(err, cconfig.ErrSystemInCorruptedState) {
+			return common.CreateSystemInCorruptedStateCmdFailure()
+		}
+		if errors.Is(err, cconfig.ErrSystemNotInstalled) {
+			return common.CreateSystemNotInstalledCmdFailure()
+		}
+		return err
+	}
+
+	cluster, err := clusterconfig.Read(k2sConfig.Host().K2sSetupConfigDir())
+	if err != nil {
+		return fmt.Errorf("failed to read cluster config: %w", err)
+	}
+
+	var localHost string
+	if runtime.GOOS == "windows" && !runtimeConfig.InstallConfig().LinuxOnly() {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("failed to determine host name: %w", err)
+		}
+		localHost = strings.ToLower(hostname)
+	}
+
+	nodes := collectSyncNodes(runtimeConfig, k2sConfig.ControlPlane().IpAddress(), cluster, localHost)
+
+	newStore := func(node syncNode) images.NodeStore {
+		if node.local {
+			return &localNodeStore{node: node, installDir: k2sConfig.Host().K2sInstallDir()}
+		}
+		return &sshNodeStore{node: node, privateKey: k2sConfig.Host().SshConfig().CurrentPrivateKeyPath()}
+	}
+
+	targets, err := selectSyncNodes(nodes, targetNames)
+	if err != nil {
+		return err
+	}
+	targetStores := make([]images.NodeStore, 0, len(targets))
+	for _, target := range targets {
+		targetStores = append(targetStores, newStore(target))
+	}
+
+	var source images.NodeStore
+	if sourceName != "" {
+		sourceNodes, err := selectSyncNodes(nodes, sourceName)
+		if err != nil {
+			return err
+		}
+		source = newStore(sourceNodes[0])
+	} else {
+		var candidates []images.NodeStore
+		for _, node := range nodes {
+			if !containsNode(targets, node.name) {
+				candidates = append(candidates, newStore(node))
+			}
+		}
+		source, err = images.FindSource(imageName, candidates)
+		if err != nil {
+			return err
+		}
+	}
+
+	pterm.Printfln("🤖 Syncing image '%s' from node '%s'..", imageName, source.Name())
+
+	archiveDir, err := os.MkdirTemp("", "k2s-image-sync-")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(archiveDir)
+
+	results, err := images.Sync(imageName, source, targetStores, archiveDir)
+	for _, result := range results {
+		switch result.Status {
+		case images.SyncCopied:
+			pterm.Success.Printfln("Image copied to node '%s'", result.Node)
+		case images.SyncSkipped:
+			pterm.Info.Printfln("Image already present on node '%s', skipped", result.Node)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	cmdSession.Finish()
+
+	return nil
+}
+
+// collectSyncNodes returns the control-plane, the Windows worker VM of Linux hosts, the nodes added via
+// 'k2s node add' with SSH access and, if localHost is set, the Windows host itself.
+func collectSyncNodes(runtimeConfig *cconfig.K2sRuntimeConfig, controlPlaneIp string, cluster *clusterconfig.Cluster, localHost string) []syncNode {
+	nodes := []syncNode{{
+		name:      runtimeConfig.ControlPlaneConfig().Hostname(),
+		os:        clusterconfig.OsTypeLinux,
+		ipAddress: controlPlaneIp,
+		username:  definitions.SSHRemoteUser,
+	}}
+
+	if worker := runtimeConfig.ClusterConfig().WindowsWorker(); worker != nil {
+		nodes = append(nodes, syncNode{name: worker.NodeName(), os: clusterconfig.OsTypeWindows, ipAddress: worker.IpAddress(), username: definitions.SSHRemoteUser})
+	}
+
+	if localHost != "" {
+		nodes = append(nodes, syncNode{name: localHost, os: clusterconfig.OsTypeWindows, local: true})
+	}
+
+	if cluster != nil {
+		for _, node := range cluster.Nodes {
+			if node.IpAddress == "" || node.Username == "" || containsNode(nodes, node.Name) {
+				continue
+			}
+			nodes = append(nodes, syncNode{name: node.Name, os: string(node.OS), ipAddress: node.IpAddress, username: node.Username})
+		}
+	}
+	return nodes
+}
+
+// selectSyncNodes resolves comma-separated node names case-insensitively
+func selectSyncNodes(nodes []syncNode, names string) ([]syncNode, error) {
+	var selected []syncNode
+	for name := range strings.SplitSeq(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || containsNode(selected, name) {
+			continue
+		}
+
+		index := findNode(nodes, name)
+		if index < 0 {
+			known := make([]string, 0, len(nodes))
+			for _, node := range nodes {
+				known = append(known, node.name)
+			}
+			return nil, fmt.Errorf("node '%s' not found or not reachable via SSH, available nodes: %s", name, strings.Join(known, ", "))
+		}
+		selected = append(selected, nodes[index])
+	}
+
+	if len(selected) == 0 {
+		return nil, errors.New("no node names provided")
+	}
+	return selected, nil
+}
+
+func containsNode(nodes []syncNode, name string) bool {
+	return findNode(nodes, name) >= 0
+}
+
+func findNode(nodes []syncNode, name string) int {
+	for i, node := range nodes {
+		if strings.EqualFold(node.name, name) {
+			return i
+		}
+	}
+	return -1
+}
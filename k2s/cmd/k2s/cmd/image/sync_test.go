@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package image
+
+import (
+	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/clusterconfig"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sync", func() {
+	nodes := []syncNode{
+		{name: "kubemaster", os: "linux"},
+		{name: "worker-1", os: "linux"},
+		{name: "winnode", os: "windows"},
+	}
+
+	Describe("collectSyncNodes", func() {
+		cluster := &clusterconfig.Cluster{Nodes: []clusterconfig.Node{
+			{Name: "worker-1", IpAddress: "172.19.1.101", Username: "admin", OS: "linux"},
+			{Name: "worker-2", OS: "linux"},
+		}}
+
+		newRuntimeConfig := func(windowsWorker *cconfig.K2sWindowsWorkerConfig) *cconfig.K2sRuntimeConfig {
+			controlPlane := cconfig.NewK2sControlPlaneConfig("kubemaster")
+			return cconfig.NewK2sRuntimeConfig(cconfig.NewK2sClusterConfig("k2s", nil, controlPlane, nil, windowsWorker), nil, controlPlane)
+		}
+
+		It("returns the control-plane, the Windows worker VM and the nodes with SSH access", func() {
+			windowsWorker := cconfig.NewK2sWindowsWorkerConfig("winnode", "k2s-win", "172.19.1.102")
+
+			result := collectSyncNodes(newRuntimeConfig(windowsWorker), "172.19.1.100", cluster, "")
+
+			Expect(result).To(Equal([]syncNode{
+				{name: "kubemaster", os: "linux", ipAddress: "172.19.1.100", username: "remote"},
+				{name: "winnode", os: "windows", ipAddress: "172.19.1.102", username: "remote"},
+				{name: "worker-1", os: "linux", ipAddress: "172.19.1.101", username: "admin"},
+			}))
+		})
+
+		It("returns the Windows host as local node", func() {
+			result := collectSyncNodes(newRuntimeConfig(nil), "172.19.1.100", nil, "winhost")
+
+			Expect(result).To(Equal([]syncNode{
+				{name: "kubemaster", os: "linux", ipAddress: "172.19.1.100", username: "remote"},
+				{name: "winhost", os: "windows", local: true},
+			}))
+		})
+	})
+
+	Describe("selectSyncNodes", func() {
+		It("resolves names case-insensitively and ignores duplicates", func() {
+			selected, err := selectSyncNodes(nodes, "Worker-1, winnode,worker-1")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selected).To(Equal([]syncNode{nodes[1], nodes[2]}))
+		})
+
+		It("returns an error listing available nodes on unknown names", func() {
+			_, err := selectSyncNodes(nodes, "worker-1,worker-9")
+
+			Expect(err).To(MatchError("node 'worker-9' not found or not reachable via SSH, available nodes: kubemaster, worker-1, winnode"))
+		})
+
+		It("returns an error if no names are provided", func() {
+			_, err := selectSyncNodes(nodes, " , ")
+
+			Expect(err).To(MatchError("no node names provided"))
+		})
+	})
+
+	Describe("parseInspectedImageId", func() {
+		It("returns the image ID", func() {
+			id, err := parseInspectedImageId(`{"status":{"id":"sha256:abc","repoDigests":["docker.io/library/nginx@sha256:def"]}}`)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id).To(Equal("sha256:abc"))
+		})
+	})
+
+	Describe("removeArchiveCommand", func() {
+		It("deletes the archive via cmd on Windows nodes regardless of the SSH shell", func() {
+			Expect(removeArchiveCommand("windows")).To(Equal(`cmd /c del /q "k2s-image-sync.tar"`))
+		})
+
+		It("deletes the archive with sudo on Linux nodes", func() {
+			Expect(removeArchiveCommand("linux")).To(Equal("sudo rm -f k2s-image-sync.tar"))
+		})
+	})
+
+	Describe("quote", func() {
+		It("single-quotes arguments on Linux nodes", func() {
+			store := &sshNodeStore{node: syncNode{os: "linux"}}
+
+			Expect(store.quote("app:v1'; reboot '")).To(Equal(`'app:v1'\''; reboot '\'''`))
+		})
+
+		It("double-quotes arguments on Windows nodes", func() {
+			store := &sshNodeStore{node: syncNode{os: "windows"}}
+
+			Expect(store.quote("app:v1")).To(Equal(`"app:v1"`))
+		})
+	})
+
+	Describe("isImageNotFoundOutput", func() {
+		It("detects missing images", func() {
+			Expect(isImageNotFoundOutput(`time="..." level=fatal msg="no such image \"app:v1\" present"`)).To(BeTrue())
+			Expect(isImageNotFoundOutput("connection refused")).To(BeFalse())
+		})
+	})
+})
@@ -13,6 +13,7 @@ import (
 	"embed"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/internal/core/kubeadmconfig"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -27,6 +28,7 @@ type installConfigAccess struct {
 	validator          configValidator
 	converter          configConverter
 	overwriter         configOverwriter
+	kubeadm            *kubeadmconfig.Customization
 }
 
 type embeddedFileReader struct{}
@@ -62,6 +64,8 @@ type InstallConfig struct {
 	Env        EnvConfig      `mapstructure:"env"`
 	Behavior   BehaviorConfig `mapstructure:"installBehavior"`
 	LinuxOnly  bool           `mapstructure:"linuxOnly"`
+	// Kubeadm is parsed from the user-provided config file directly since viper does not preserve the case of keys
+	Kubeadm *kubeadmconfig.Customization `mapstructure:"-"`
 }
 
 type NodeConfig struct {
@@ -138,6 +142,9 @@ const (
 	LinuxOnlyFlagName  = "linux-only"
 	LinuxOnlyFlagUsage = "No Windows worker node will be set up"
 
+	WindowsWorkerFlagName  = "windows-worker"
+	WindowsWorkerFlagUsage = "Set up a Windows worker node in a libvirt/KVM VM (native Linux hosts only)"
+
 	AppendLogFlagName  = "append-log"
 	AppendLogFlagUsage = "Append logs to existing log file"
 
@@ -146,6 +153,12 @@ const (
 
 	RestartFlagUsage = "Number of times to restart cluster post installation."
 
+	ResumeFlagName  = "resume"
+	ResumeFlagUsage = "Continue an interrupted installation from the failed step (native Linux hosts only)"
+
+	RollbackFlagName  = "rollback"
+	RollbackFlagUsage = "Undo the completed steps of an interrupted installation (native Linux hosts only)"
+
 	minMemoryBytes int64 = 2 * 1024 * 1024 * 1024
 	maxMemoryBytes int64 = 128 * 1024 * 1024 * 1024
 )
@@ -190,6 +203,8 @@ func (i *installConfigAccess) Load(kind Kind, flags *pflag.FlagSet) (*InstallCon
 		return nil, err
 	}
 
+	config.Kubeadm = i.kubeadm
+
 	i.overwriter.overwrite(config, i.config, flags)
 
 	autoEnableDynamicMemory(config)
@@ -252,7 +267,15 @@ func (i *installConfigAccess) loadUserConfig(kind Kind) error {
 		return err
 	}
 
-	return i.validator.validate(kind, i.config)
+	if err := i.validator.validate(kind, i.config); err != nil {
+		return err
+	}
+
+	i.kubeadm, err = kubeadmconfig.Parse(userContent)
+	if err != nil {
+		return fmt.Errorf("error in user-provided config: %w", err)
+	}
+	return nil
 }
 
 func (config *InstallConfig) findNodeByRole(role string) (*NodeConfig, bool) {
@@ -320,6 +320,50 @@ var _ = Describe("config", func() {
 			})
 		})
 
+		When("kubeadm section is present", func() {
+			It("keeps the case of its keys", func() {
+				kind := Kind("test-kind")
+				configFilePath := "path-to-user-test-config"
+				config := viper.New()
+				config.Set(ConfigFileFlagName, configFilePath)
+				config.SetConfigType("yaml")
+
+				osReaderMock := &mockObject{}
+				osReaderMock.On(r.GetFunctionName(osReaderMock.readFile), configFilePath).Return([]byte("kubeadm:\n  kubeletConfiguration:\n    maxPods: 200\n"), nil)
+
+				validatorMock := &mockObject{}
+				validatorMock.On(r.GetFunctionName(validatorMock.validate), kind, config).Return(nil)
+
+				sut := &installConfigAccess{
+					osFileReader: osReaderMock,
+					config:       config,
+					validator:    validatorMock}
+
+				Expect(sut.loadUserConfig(kind)).To(Succeed())
+				Expect(sut.kubeadm.KubeletConfiguration).To(HaveKeyWithValue("maxPods", 200))
+			})
+
+			It("returns an error if the section is invalid", func() {
+				kind := Kind("test-kind")
+				configFilePath := "path-to-user-test-config"
+				config := viper.New()
+				config.Set(ConfigFileFlagName, configFilePath)
+				config.SetConfigType("yaml")
+
+				osReaderMock := &mockObject{}
+				osReaderMock.On(r.GetFunctionName(osReaderMock.readFile), configFilePath).Return([]byte("kubeadm:\n  kubeproxyConfiguration: {}\n"), nil)
+
+				validatorMock := &mockObject{}
+				validatorMock.On(r.GetFunctionName(validatorMock.validate), kind, config).Return(nil)
+
+				sut := &installConfigAccess{
+					osFileReader: osReaderMock,
+					config:       config,
+					validator:    validatorMock}
+
+				Expect(sut.loadUserConfig(kind)).To(MatchError(ContainSubstring("error in user-provided config: invalid 'kubeadm' section")))
+			})
+		})
 	})
 
 	Describe("findNodeByRole", func() {
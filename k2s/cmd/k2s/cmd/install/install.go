@@ -74,6 +74,15 @@ var (
 
 	# install K2s setup forcing an online installation, i.e. downloading files
 	k2s install --force-online-installation
+
+	# install K2s setup on a native Linux host with a Windows worker node in a libvirt/KVM VM
+	k2s install --windows-worker
+
+	# continue an interrupted installation on a native Linux host from the failed step
+	k2s install --linux-only --resume
+
+	# undo the completed steps of an interrupted installation on a native Linux host
+	k2s install --rollback
 	`
 
 	InstallCmd = &cobra.Command{
@@ -126,9 +135,14 @@ func bindFlags(cmd *cobra.Command) {
 
 	// convenience flag; not configurable in config file
 	cmd.Flags().Bool(ic.LinuxOnlyFlagName, false, ic.LinuxOnlyFlagUsage)
+	cmd.Flags().Bool(ic.WindowsWorkerFlagName, false, ic.WindowsWorkerFlagUsage)
+	cmd.MarkFlagsMutuallyExclusive(ic.LinuxOnlyFlagName, ic.WindowsWorkerFlagName)
 
 	cmd.Flags().Bool(ic.AppendLogFlagName, false, ic.AppendLogFlagUsage)
 	cmd.Flags().Bool(ic.SkipStartFlagName, false, ic.SkipStartFlagUsage)
+	cmd.Flags().Bool(ic.ResumeFlagName, false, ic.ResumeFlagUsage)
+	cmd.Flags().Bool(ic.RollbackFlagName, false, ic.RollbackFlagUsage)
+	cmd.MarkFlagsMutuallyExclusive(ic.ResumeFlagName, ic.RollbackFlagName)
 
 	cmd.Flags().SortFlags = false
 	cmd.Flags().PrintDefaults()
@@ -216,10 +230,28 @@ func install(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	windowsWorker, err := cmd.Flags().GetBool(ic.WindowsWorkerFlagName)
+	if err != nil {
+		return err
+	}
+	resume, err := cmd.Flags().GetBool(ic.ResumeFlagName)
+	if err != nil {
+		return err
+	}
+	rollback, err := cmd.Flags().GetBool(ic.RollbackFlagName)
+	if err != nil {
+		return err
+	}
 	if runtime.GOOS == "linux" {
-		if err := validateLinuxInstallOptions(cmd, linuxOnly); err != nil {
+		if err := validateLinuxInstallOptions(cmd, linuxOnly, windowsWorker, rollback); err != nil {
 			return err
 		}
+	} else if windowsWorker {
+		return fmt.Errorf("--%s is supported for native Linux hosts only", ic.WindowsWorkerFlagName)
+	} else if resume || rollback {
+		return errors.New("--resume and --rollback are supported for native Linux hosts only")
+	} else if installConfig.Kubeadm != nil {
+		return errors.New("the 'kubeadm' section of the install config is supported for native Linux hosts only")
 	}
 
 	cmdSession := cc.StartCmdSession(cmd.CommandPath())
@@ -272,6 +304,7 @@ func install(cmd *cobra.Command, args []string) error {
 		MasterDiskSize:                    node.Resources.Disk,
 		DynamicMemory:                     node.Resources.DynamicMemory,
 		LinuxOnly:                         linuxOnly,
+		WindowsWorker:                     windowsWorker,
 		WSL:                               installConfig.Behavior.Wsl,
 		ShowLogs:                          installConfig.Behavior.ShowOutput,
 		SkipStart:                         installConfig.Behavior.SkipStart,
@@ -288,6 +321,9 @@ func install(cmd *cobra.Command, args []string) error {
 		Version:                           fmt.Sprintf("%s", ver),
 		ClusterName:                       "k2s-cluster",
 		ControlPlaneHostname:              hostname,
+		Resume:                            resume,
+		Rollback:                          rollback,
+		Kubeadm:                           installConfig.Kubeadm,
 		StdWriter:                         outputWriter,
 	})
 	if err != nil {
@@ -319,13 +355,12 @@ func install(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func validateLinuxInstallOptions(cmd *cobra.Command, linuxOnly bool) error {
-	if !linuxOnly {
-		return errors.New("Linux host installation currently supports only 'k2s install --linux-only'; Windows worker provisioning is not supported yet")
+func validateLinuxInstallOptions(cmd *cobra.Command, linuxOnly bool, windowsWorker bool, rollback bool) error {
+	if !linuxOnly && !windowsWorker && !rollback {
+		return fmt.Errorf("Linux host installation requires either --%s or --%s", ic.LinuxOnlyFlagName, ic.WindowsWorkerFlagName)
 	}
 
 	unsupportedFlags := []string{
-		cc.ForceOnlineInstallFlagName,
 		cc.DeleteFilesFlagName,
 		ic.K8sBinFlagName,
 		ic.WslFlagName,
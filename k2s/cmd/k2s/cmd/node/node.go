@@ -9,6 +9,7 @@ import (
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/node/copy"
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/node/exec"
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/node/remove"
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/node/resize"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +20,7 @@ func NewCmd() *cobra.Command {
 	}
 	cmd.AddCommand(add.NewCmd())
 	cmd.AddCommand(remove.NewCmd())
+	cmd.AddCommand(resize.NewCmd())
 	cmd.AddCommand(copy.NewCmd())
 	cmd.AddCommand(exec.NewCmd())
 	cmd.AddCommand(connect.NewCmd())
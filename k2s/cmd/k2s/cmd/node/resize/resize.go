@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package resize
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/pterm/pterm"
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/config"
+	"github.com/siemens-healthineers/k2s/internal/definitions"
+	"github.com/siemens-healthineers/k2s/internal/primitives/units"
+	"github.com/siemens-healthineers/k2s/internal/provider"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	MachineName          = "name"
+	MachineNameFlagUsage = "Hostname of the node (default: the Windows worker node)"
+	CPUsFlagName         = "cpus"
+	CPUsFlagUsage        = "Number of virtual CPUs"
+	MemoryFlagName       = "memory"
+	MemoryFlagUsage      = "Amount of RAM, the startup memory with dynamic memory (minimum 2GB, format: <number>[<unit>], where unit = MB or GB)"
+	MemoryMaxFlagName    = "memory-max"
+	MemoryMaxFlagUsage   = "Maximum amount of RAM; enables dynamic memory, requires --memory (format: <number>[<unit>], where unit = MB or GB)"
+	DiskFlagName         = "disk"
+	DiskFlagUsage        = "Disk size; the disk can only grow (format: <number>[<unit>], where unit = GB)"
+)
+
+const (
+	mebibyte       int64 = 1 << 20
+	gibibyte       int64 = 1 << 30
+	minMemoryBytes       = 2 * gibibyte
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resize",
+		Short: "Change CPU, memory and disk size of a VM-hosted node",
+		Long:  "Changes CPU, memory and disk size of a node hosted in a VM managed by K2s. Currently, this covers the Windows worker VM on native Linux hosts. A running node is drained and restarted to apply the change.",
+		Example: `  # Assign 6 CPUs and 8GB RAM to the Windows worker node
+  k2s node resize --cpus 6 --memory 8GB
+
+  # Enable dynamic memory between 4GB and 12GB
+  k2s node resize --memory 4GB --memory-max 12GB
+
+  # Grow the disk of the Windows worker node
+  k2s node resize --disk 100GB`,
+		RunE: resizeNode,
+	}
+	cmd.Flags().StringP(MachineName, "m", "", MachineNameFlagUsage)
+	cmd.Flags().Int(CPUsFlagName, 0, CPUsFlagUsage)
+	cmd.Flags().String(MemoryFlagName, "", MemoryFlagUsage)
+	cmd.Flags().String(MemoryMaxFlagName, "", MemoryMaxFlagUsage)
+	cmd.Flags().String(DiskFlagName, "", DiskFlagUsage)
+	cmd.MarkFlagsOneRequired(CPUsFlagName, MemoryFlagName, DiskFlagName)
+
+	cmd.Flags().SortFlags = false
+	cmd.Flags().PrintDefaults()
+
+	return cmd
+}
+
+func resizeNode(ccmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(ccmd.CommandPath())
+	context := ccmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	runtimeConfig, err := config.ReadRuntimeConfig(context.Config().Host().K2sSetupConfigDir())
+	if err != nil {
+		if errors.Is(err, cconfig.ErrSystemInCorruptedState) {
+			return common.CreateSystemInCorruptedStateCmdFailure()
+		}
+		if errors.Is(err, cconfig.ErrSystemNotInstalled) {
+			return common.CreateSystemNotInstalledCmdFailure()
+		}
+		return err
+	}
+
+	if runtimeConfig.InstallConfig().SetupName() != definitions.SetupNameK2s {
+		return errors.New("resizing node is not supported for this setup type. Aborting")
+	}
+
+	resizeConfig, err := buildResizeConfig(ccmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	pterm.Printfln("🤖 Resizing node of K2s cluster")
+
+	if err := context.Providers().Node.Resize(*resizeConfig); err != nil {
+		return err
+	}
+
+	cmdSession.Finish()
+
+	return nil
+}
+
+func buildResizeConfig(flags *pflag.FlagSet) (*provider.NodeResizeConfig, error) {
+	outputFlag, err := strconv.ParseBool(flags.Lookup(common.OutputFlagName).Value.String())
+	if err != nil {
+		return nil, err
+	}
+	cpus, err := flags.GetInt(CPUsFlagName)
+	if err != nil {
+		return nil, err
+	}
+	if flags.Changed(CPUsFlagName) && cpus < 1 {
+		return nil, fmt.Errorf("--%s must be at least 1", CPUsFlagName)
+	}
+
+	memory, err := parseSize(flags, MemoryFlagName, minMemoryBytes)
+	if err != nil {
+		return nil, err
+	}
+	memoryMax, err := parseSize(flags, MemoryMaxFlagName, minMemoryBytes)
+	if err != nil {
+		return nil, err
+	}
+	if memoryMax > 0 {
+		if memory == 0 {
+			return nil, fmt.Errorf("--%s requires --%s", MemoryMaxFlagName, MemoryFlagName)
+		}
+		if memoryMax < memory {
+			return nil, fmt.Errorf("--%s must not be less than --%s", MemoryMaxFlagName, MemoryFlagName)
+		}
+	}
+	disk, err := parseSize(flags, DiskFlagName, gibibyte)
+	if err != nil {
+		return nil, err
+	}
+	if disk%gibibyte != 0 {
+		return nil, fmt.Errorf("--%s must be a multiple of 1GB", DiskFlagName)
+	}
+
+	return &provider.NodeResizeConfig{
+		NodeName:    flags.Lookup(MachineName).Value.String(),
+		CPUCount:    cpus,
+		MemoryMB:    int(memory / mebibyte),
+		MaxMemoryMB: int(memoryMax / mebibyte),
+		DiskSizeGB:  int(disk / gibibyte),
+		ShowOutput:  outputFlag,
+	}, nil
+}
+
+// parseSize returns the size of the flag in bytes, zero if the flag is not set.
+func parseSize(flags *pflag.FlagSet, flagName string, minBytes int64) (int64, error) {
+	value := flags.Lookup(flagName).Value.String()
+	if value == "" {
+		return 0, nil
+	}
+	size, err := units.ParseBase2Bytes(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s' for --%s: %w", value, flagName, err)
+	}
+	if int64(size) < minBytes {
+		return 0, fmt.Errorf("--%s must be at least %s", flagName, units.BytesQuantity(minBytes))
+	}
+	return int64(size), nil
+}
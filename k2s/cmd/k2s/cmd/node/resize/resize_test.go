@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package resize
+
+import (
+	"testing"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/internal/provider"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestResize(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "resize Unit Tests", Label("unit", "ci"))
+}
+
+var _ = Describe("resize", func() {
+	Describe("buildResizeConfig", func() {
+		build := func(args ...string) (*provider.NodeResizeConfig, error) {
+			cmd := NewCmd()
+			cmd.Flags().BoolP(common.OutputFlagName, common.OutputFlagShorthand, false, "")
+			Expect(cmd.Flags().Parse(args)).To(Succeed())
+			return buildResizeConfig(cmd.Flags())
+		}
+
+		It("converts the sizes to the units of the node provider", func() {
+			config, err := build("--name", "winnode", "--cpus", "6", "--memory", "4GB", "--memory-max", "12GB", "--disk", "100GB", "-o")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*config).To(Equal(provider.NodeResizeConfig{
+				NodeName:    "winnode",
+				CPUCount:    6,
+				MemoryMB:    4096,
+				MaxMemoryMB: 12288,
+				DiskSizeGB:  100,
+				ShowOutput:  true,
+			}))
+		})
+
+		It("keeps unset resources at zero", func() {
+			config, err := build("--memory", "2560MB")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*config).To(Equal(provider.NodeResizeConfig{MemoryMB: 2560}))
+		})
+
+		DescribeTable("rejects invalid values",
+			func(expectedErr string, args ...string) {
+				config, err := build(args...)
+
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+				Expect(config).To(BeNil())
+			},
+			Entry("no CPUs", "--cpus must be at least 1", "--cpus", "0"),
+			Entry("too little memory", "--memory must be at least 2GiB", "--memory", "1GB"),
+			Entry("unparsable memory", "invalid value 'lots' for --memory", "--memory", "lots"),
+			Entry("max memory without memory", "--memory-max requires --memory", "--memory-max", "8GB"),
+			Entry("max memory below memory", "--memory-max must not be less than --memory", "--memory", "8GB", "--memory-max", "4GB"),
+			Entry("disk below 1GB", "--disk must be at least 1GiB", "--disk", "512MB"),
+			Entry("fractional disk size", "--disk must be a multiple of 1GB", "--disk", "1536MB"),
+		)
+	})
+})
@@ -4,16 +4,29 @@
 package status
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
 	"runtime"
+	"slices"
+	"time"
 
+	ac "github.com/siemens-healthineers/k2s/cmd/k2s/cmd/addons/common"
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/cmd/k2s/utils"
 
 	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/addons"
 	"github.com/siemens-healthineers/k2s/internal/core/config"
+	"github.com/siemens-healthineers/k2s/internal/core/events"
 	"github.com/siemens-healthineers/k2s/internal/json"
+	"github.com/siemens-healthineers/k2s/internal/provider"
 	"github.com/siemens-healthineers/k2s/internal/terminal"
+	"github.com/siemens-healthineers/k2s/internal/yaml"
 
 	"github.com/spf13/cobra"
 )
@@ -23,31 +36,76 @@ type StatusPrinter interface {
 }
 
 const (
-	outputFlagName = "output"
-	wideOption     = "wide"
-	jsonOption     = "json"
+	outputFlagName   = "output"
+	watchFlagName    = "watch"
+	intervalFlagName = "interval"
+	checkFlagName    = "check"
+	eventsFlagName   = "events"
+	wideOption       = "wide"
+	jsonOption       = "json"
+	yamlOption       = "yaml"
+	metricsAddonName = "metrics"
+
+	defaultWatchInterval = 5 * time.Second
+	minWatchInterval     = time.Second
+
+	// clearScreenSequence moves the cursor to the top-left corner and clears the terminal
+	clearScreenSequence = "\033[H\033[2J"
+	yamlDocumentStart   = "---\n"
 
 	statusCommandExample = `
   # Status of the cluster
   k2s status
 
-  # Status of the cluster with more information
+  # Status of the cluster with more information, including recent warning events
   k2s status -o wide
 
+  # Status of the cluster including recent warning events
+  k2s status --events
+
   # Status of the cluster in JSON output format
   k2s status -o json
+
+  # Status of the cluster in YAML output format
+  k2s status -o yaml
+
+  # Status of the cluster, refreshed every 10 seconds until Ctrl+C is pressed
+  k2s status --watch --interval 10s
+
+  # Health of the cluster, indicated by the exit code
+  k2s status --check
 `
 )
 
 var StatusCmd = &cobra.Command{
-	Use:     "status",
-	Short:   "Prints out status information about the K2s cluster on this machine",
+	Use:   "status",
+	Short: "Prints out status information about the K2s cluster on this machine",
+	Long: `
+Prints out status information about the K2s cluster on this machine, including the allocatable, requested and
+(if the metrics addon is enabled) used CPU and memory of each node as well as node pressure conditions.
+
+With -o wide or --events, the warning events of the last hour in the system namespaces and the namespaces of the
+enabled addons are shown, grouped by involved object.
+
+With --check, only the health of the cluster is printed (or the full status with JSON/YAML output) and the exit code
+indicates the health:
+  0: healthy
+  1: failure, e.g. corrupted system state
+  2: degraded, e.g. nodes not ready, essential Pods not running or other issues found
+  3: stopped
+  4: not installed
+	`,
 	RunE:    printStatus,
 	Example: statusCommandExample,
 }
 
 func init() {
-	StatusCmd.Flags().StringP(outputFlagName, "o", "", "Output format modifier. Currently supported: 'wide' for more information and 'json' for output as JSON structure")
+	StatusCmd.Flags().StringP(outputFlagName, "o", "", "Output format modifier. Currently supported: 'wide' for more information, 'json' and 'yaml' for output as JSON/YAML structure")
+	StatusCmd.Flags().BoolP(watchFlagName, "w", false, "Refresh the status periodically until interrupted; with JSON/YAML output, a document is printed per refresh")
+	StatusCmd.Flags().Duration(intervalFlagName, defaultWatchInterval, "Refresh interval in watch mode")
+	StatusCmd.Flags().Bool(checkFlagName, false, "Print the health only and indicate it by the exit code, see above")
+	StatusCmd.Flags().Bool(eventsFlagName, false, "Include recent warning events of system and addon namespaces; implied by '-o wide'")
+	StatusCmd.MarkFlagsMutuallyExclusive(watchFlagName, checkFlagName)
 	StatusCmd.Flags().SortFlags = false
 	StatusCmd.Flags().PrintDefaults()
 }
@@ -58,26 +116,49 @@ func printStatus(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if outputOption != "" && outputOption != wideOption && outputOption != jsonOption {
+	if outputOption != "" && outputOption != wideOption && outputOption != jsonOption && outputOption != yamlOption {
 		return fmt.Errorf("parameter '%s' not supported for flag 'o'", outputOption)
 	}
 
+	watchEnabled, err := cmd.Flags().GetBool(watchFlagName)
+	if err != nil {
+		return err
+	}
+
+	interval, err := cmd.Flags().GetDuration(intervalFlagName)
+	if err != nil {
+		return err
+	}
+
+	if interval < minWatchInterval {
+		return fmt.Errorf("interval must be at least %s", minWatchInterval)
+	}
+
+	checkEnabled, err := cmd.Flags().GetBool(checkFlagName)
+	if err != nil {
+		return err
+	}
+
+	eventsEnabled, err := cmd.Flags().GetBool(eventsFlagName)
+	if err != nil {
+		return err
+	}
+
 	terminalPrinter := terminal.NewTerminalPrinter()
+	marshalFunc := determineMarshalFunc(outputOption, watchEnabled)
 
 	context := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
 	runtimeConfig, err := config.ReadRuntimeConfig(context.Config().Host().K2sSetupConfigDir())
 	if err != nil {
 		if errors.Is(err, cconfig.ErrSystemInCorruptedState) {
-			if outputOption == jsonOption {
-				return printSystemErrJson(terminalPrinter.Println, cconfig.ErrSystemInCorruptedState, common.CreateSystemInCorruptedStateCmdFailure)
-			}
-			return common.CreateSystemInCorruptedStateCmdFailure()
+			return printSystemErr(terminalPrinter.Println, marshalFunc, cconfig.ErrSystemInCorruptedState, HealthStateCorrupted, common.CreateSystemInCorruptedStateCmdFailure())
 		}
 		if errors.Is(err, cconfig.ErrSystemNotInstalled) {
-			if outputOption == jsonOption {
-				return printSystemErrJson(terminalPrinter.Println, cconfig.ErrSystemNotInstalled, common.CreateSystemNotInstalledCmdFailure)
+			failure := common.CreateSystemNotInstalledCmdFailure()
+			if checkEnabled {
+				failure.ExitCode = ExitCodeNotInstalled
 			}
-			return common.CreateSystemNotInstalledCmdFailure()
+			return printSystemErr(terminalPrinter.Println, marshalFunc, cconfig.ErrSystemNotInstalled, HealthStateNotInstalled, failure)
 		}
 
 		return err
@@ -89,36 +170,187 @@ func printStatus(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	printer := determinePrinter(outputOption, runtimeConfig, terminalPrinter, context)
+	var eventNamespaces []string
+	if eventsEnabled || outputOption == wideOption {
+		eventNamespaces = determineEventNamespaces(runtimeConfig.ClusterConfig().EnabledAddons(), addons.LoadAddons)
+	}
+
+	loadFunc := newLoadFunc(runtimeConfig, context, eventNamespaces)
+
+	switch {
+	case watchEnabled:
+		if marshalFunc == nil {
+			// the previous status stays visible while the next one is loading
+			loadFunc = clearScreenAfter(loadFunc)
+		}
+
+		watchContext, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+
+		return watch(watchContext, determinePrinter(outputOption, runtimeConfig, terminalPrinter, marshalFunc, loadFunc), interval)
+	case checkEnabled:
+		return check(func(loadFunc func() (*LoadedStatus, error)) StatusPrinter {
+			return determineCheckPrinter(runtimeConfig, terminalPrinter, marshalFunc, loadFunc)
+		}, loadFunc)
+	default:
+		return determinePrinter(outputOption, runtimeConfig, terminalPrinter, marshalFunc, loadFunc).Print()
+	}
+}
+
+// watch prints the status until the context is done; printing errors end the watch.
+func watch(ctx context.Context, printer StatusPrinter, interval time.Duration) error {
+	for {
+		if err := printer.Print(); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// check prints the status and returns a failure with the exit code matching the health of the system.
+func check(newPrinter func(loadFunc func() (*LoadedStatus, error)) StatusPrinter, loadFunc func() (*LoadedStatus, error)) error {
+	var loadedStatus *LoadedStatus
+	recordingLoadFunc := func() (*LoadedStatus, error) {
+		status, err := loadFunc()
+		loadedStatus = status
+		return status, err
+	}
+
+	if err := newPrinter(recordingLoadFunc).Print(); err != nil {
+		return err
+	}
+
+	if failure := newHealthFailure(EvaluateHealth(loadedStatus)); failure != nil {
+		return failure
+	}
+	return nil
+}
+
+func newLoadFunc(config *cconfig.K2sRuntimeConfig, context *common.CmdContext, eventNamespaces []string) func() (*LoadedStatus, error) {
+	statusConfig := provider.ClusterStatusConfig{
+		LoadResources:   true,
+		LoadUsage:       isAddonEnabled(config, metricsAddonName),
+		EventNamespaces: eventNamespaces,
+	}
 
-	return printer.Print()
+	return func() (*LoadedStatus, error) {
+		status, err := loadStatus(context, statusConfig)
+		if err != nil {
+			return nil, err
+		}
+		if status.RunningState != nil && status.RunningState.IsRunning {
+			status.RunningState.Issues = append(status.RunningState.Issues, loadUserCertIssues(context.Config().Host().K2sSetupConfigDir(), time.Now())...)
+		}
+		return status, nil
+	}
 }
 
-func determinePrinter(outputOption string, config *cconfig.K2sRuntimeConfig, terminalPrinter TerminalPrinter, context *common.CmdContext) StatusPrinter {
-	loadFunc := func() (*LoadedStatus, error) {
-		return LoadStatus(context)
+// determineMarshalFunc returns the marshal function of structured output formats, nil for user-friendly output.
+// In watch mode, YAML documents are separated by document start markers.
+func determineMarshalFunc(outputOption string, watchEnabled bool) func(any) ([]byte, error) {
+	switch outputOption {
+	case jsonOption:
+		return json.MarshalIndent
+	case yamlOption:
+		return func(data any) ([]byte, error) {
+			jsonBytes, err := json.MarshalIndent(data)
+			if err != nil {
+				return nil, err
+			}
+			yamlBytes, err := yaml.FromJson(jsonBytes)
+			if err != nil {
+				return nil, err
+			}
+			if watchEnabled {
+				yamlBytes = append([]byte(yamlDocumentStart), yamlBytes...)
+			}
+			return bytes.TrimSuffix(yamlBytes, []byte("\n")), nil
+		}
+	default:
+		return nil
 	}
+}
 
-	if outputOption == jsonOption {
-		return NewJsonPrinter(config, terminalPrinter.Println, json.MarshalIndent, loadFunc)
+func determinePrinter(outputOption string, config *cconfig.K2sRuntimeConfig, terminalPrinter TerminalPrinter, marshalFunc func(any) ([]byte, error), loadFunc func() (*LoadedStatus, error)) StatusPrinter {
+	if marshalFunc != nil {
+		return NewJsonPrinter(config, terminalPrinter.Println, marshalFunc, loadFunc)
 	}
 	return NewUserFriendlyPrinter(config, outputOption == wideOption, terminalPrinter, loadFunc)
 }
 
-func printSystemErrJson(printlnFunc func(m ...any), systemError error, systemCmdFailureFunc func() *common.CmdFailure) error {
+// determineCheckPrinter prints the full status for structured output formats, the health only otherwise.
+func determineCheckPrinter(config *cconfig.K2sRuntimeConfig, terminalPrinter TerminalPrinter, marshalFunc func(any) ([]byte, error), loadFunc func() (*LoadedStatus, error)) StatusPrinter {
+	if marshalFunc != nil {
+		return NewJsonPrinter(config, terminalPrinter.Println, marshalFunc, loadFunc)
+	}
+	return NewHealthPrinter(terminalPrinter, loadFunc)
+}
+
+func clearScreenAfter(loadFunc func() (*LoadedStatus, error)) func() (*LoadedStatus, error) {
+	return func() (*LoadedStatus, error) {
+		status, err := loadFunc()
+		fmt.Print(clearScreenSequence)
+		return status, err
+	}
+}
+
+// determineEventNamespaces returns the system namespaces and the namespaces of the enabled addons; if the addons
+// cannot be loaded, the system namespaces only.
+func determineEventNamespaces(enabledAddons []cconfig.Addon, loadAddons func(installDir string) (addons.Addons, error)) []string {
+	namespaces := slices.Clone(events.SystemNamespaces)
+	if len(enabledAddons) == 0 {
+		return namespaces
+	}
+
+	allAddons, err := loadAddons(utils.InstallDir())
+	if err != nil {
+		slog.Warn("Could not load addons, showing events of system namespaces only", "error", err)
+		return namespaces
+	}
+
+	for _, implementation := range ac.EnabledImplementations(allAddons, enabledAddons) {
+		for _, namespace := range implementation.Namespaces {
+			if !slices.Contains(namespaces, namespace) {
+				namespaces = append(namespaces, namespace)
+			}
+		}
+	}
+	return namespaces
+}
+
+func isAddonEnabled(config *cconfig.K2sRuntimeConfig, name string) bool {
+	return slices.ContainsFunc(config.ClusterConfig().EnabledAddons(), func(addon cconfig.Addon) bool {
+		return addon.Name == name
+	})
+}
+
+// printSystemErr prints a status document containing the system error for structured output formats only;
+// the failure is printed by the CLI otherwise.
+func printSystemErr(printlnFunc func(m ...any), marshalFunc func(any) ([]byte, error), systemError error, healthState HealthState, failure *common.CmdFailure) error {
+	if marshalFunc == nil {
+		return failure
+	}
+
 	errCode := systemError.Error()
 	status := PrintStatus{
-		Error: &errCode,
+		ApiVersion: StatusApiVersion,
+		Kind:       StatusKind,
+		Health:     &Health{State: healthState},
+		Error:      &errCode,
 	}
 
-	bytes, err := json.MarshalIndent(status)
+	bytes, err := marshalFunc(status)
 	if err != nil {
 		return err
 	}
 
 	printlnFunc(string(bytes))
 
-	failure := systemCmdFailureFunc()
 	failure.SuppressCliOutput = true
 
 	return failure
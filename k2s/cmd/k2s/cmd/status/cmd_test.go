@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package status
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/addons"
+	"github.com/siemens-healthineers/k2s/internal/core/events"
+)
+
+type printerFunc func() error
+
+func (f printerFunc) Print() error {
+	return f()
+}
+
+var _ = Describe("cmd", func() {
+	Describe("watch", func() {
+		It("prints repeatedly until the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			count := 0
+			printer := printerFunc(func() error {
+				count++
+				if count == 3 {
+					cancel()
+				}
+				return nil
+			})
+
+			err := watch(ctx, printer, time.Millisecond)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(3))
+		})
+
+		It("stops on printing error", func() {
+			expectedErr := errors.New("oops")
+			count := 0
+			printer := printerFunc(func() error {
+				count++
+				return expectedErr
+			})
+
+			err := watch(context.Background(), printer, time.Millisecond)
+
+			Expect(err).To(MatchError(expectedErr))
+			Expect(count).To(Equal(1))
+		})
+	})
+
+	Describe("check", func() {
+		It("returns failure with exit code if system is degraded", func() {
+			loadFunc := func() (*LoadedStatus, error) {
+				return &LoadedStatus{RunningState: &RunningState{IsRunning: true}, Nodes: []Node{{Name: "n1"}}}, nil
+			}
+			newPrinter := func(loadFunc func() (*LoadedStatus, error)) StatusPrinter {
+				return printerFunc(func() error {
+					_, err := loadFunc()
+					return err
+				})
+			}
+
+			err := check(newPrinter, loadFunc)
+
+			failure, ok := errors.AsType[*common.CmdFailure](err)
+			Expect(ok).To(BeTrue())
+			Expect(failure.ExitCode).To(Equal(ExitCodeDegraded))
+			Expect(failure.Message).To(Equal("The system is degraded: node 'n1' is not ready"))
+		})
+
+		It("returns nil if system is healthy", func() {
+			loadFunc := func() (*LoadedStatus, error) {
+				return &LoadedStatus{RunningState: &RunningState{IsRunning: true}}, nil
+			}
+			newPrinter := func(loadFunc func() (*LoadedStatus, error)) StatusPrinter {
+				return printerFunc(func() error {
+					_, err := loadFunc()
+					return err
+				})
+			}
+
+			Expect(check(newPrinter, loadFunc)).To(Succeed())
+		})
+
+		It("returns printing error", func() {
+			expectedErr := errors.New("oops")
+			newPrinter := func(func() (*LoadedStatus, error)) StatusPrinter {
+				return printerFunc(func() error { return expectedErr })
+			}
+
+			Expect(check(newPrinter, nil)).To(MatchError(expectedErr))
+		})
+	})
+
+	Describe("determineMarshalFunc", func() {
+		data := map[string]any{"apiVersion": "v1"}
+
+		It("returns nil for user-friendly output", func() {
+			Expect(determineMarshalFunc(wideOption, false)).To(BeNil())
+		})
+
+		It("marshals YAML", func() {
+			actual, err := determineMarshalFunc(yamlOption, false)(data)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(actual)).To(Equal("apiVersion: v1"))
+		})
+
+		It("marshals YAML documents with start marker in watch mode", func() {
+			actual, err := determineMarshalFunc(yamlOption, true)(data)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(actual)).To(Equal("---\napiVersion: v1"))
+		})
+	})
+
+	Describe("determineEventNamespaces", func() {
+		loadAddons := func(string) (addons.Addons, error) {
+			return addons.Addons{
+				{
+					Metadata: addons.AddonMetadata{Name: "logging"},
+					Spec: addons.AddonSpec{Implementations: []addons.Implementation{
+						{Name: "logging", Namespaces: []string{"logging", "kube-system"}},
+					}},
+				},
+				{
+					Metadata: addons.AddonMetadata{Name: "ingress"},
+					Spec: addons.AddonSpec{Implementations: []addons.Implementation{
+						{Name: "nginx", Namespaces: []string{"ingress-nginx"}},
+						{Name: "traefik", Namespaces: []string{"ingress-traefik"}},
+					}},
+				},
+			}, nil
+		}
+
+		It("returns system namespaces and namespaces of enabled addons without duplicates", func() {
+			namespaces := determineEventNamespaces([]cconfig.Addon{{Name: "logging"}, {Name: "ingress", Implementation: "traefik"}}, loadAddons)
+
+			Expect(namespaces).To(Equal([]string{"kube-system", "kube-flannel", "logging", "ingress-traefik"}))
+		})
+
+		It("returns system namespaces only if addons cannot be loaded", func() {
+			failingLoad := func(string) (addons.Addons, error) { return nil, errors.New("oops") }
+
+			namespaces := determineEventNamespaces([]cconfig.Addon{{Name: "logging"}}, failingLoad)
+
+			Expect(namespaces).To(Equal(events.SystemNamespaces))
+		})
+	})
+})
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package status
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/internal/cli"
+)
+
+type HealthState string
+
+// Health summarizes the status for automation; Reasons explain a degraded state.
+type Health struct {
+	State   HealthState `json:"state"`
+	Reasons []string    `json:"reasons,omitempty"`
+}
+
+const (
+	HealthStateHealthy      HealthState = "healthy"
+	HealthStateDegraded     HealthState = "degraded"
+	HealthStateStopped      HealthState = "stopped"
+	HealthStateNotInstalled HealthState = "not-installed"
+	HealthStateCorrupted    HealthState = "corrupted"
+
+	// exit codes of 'k2s status --check'; a corrupted system results in cli.ExitCodeFailure
+	ExitCodeDegraded     cli.ExitCode = 2
+	ExitCodeStopped      cli.ExitCode = 3
+	ExitCodeNotInstalled cli.ExitCode = 4
+)
+
+// EvaluateHealth determines the health of a loaded status: a running system is degraded if any issue was found,
+// a node is not ready or under resource pressure, or an essential Pod is not running.
+func EvaluateHealth(status *LoadedStatus) Health {
+	if status.RunningState == nil || !status.RunningState.IsRunning {
+		return Health{State: HealthStateStopped}
+	}
+
+	reasons := append([]string{}, status.RunningState.Issues...)
+	for _, node := range status.Nodes {
+		if !node.IsReady {
+			reasons = append(reasons, fmt.Sprintf("node '%s' is not ready", node.Name))
+		}
+		if node.Resources != nil && len(node.Resources.Pressure) > 0 {
+			reasons = append(reasons, fmt.Sprintf("node '%s' is under resource pressure: %s", node.Name, strings.Join(node.Resources.Pressure, ", ")))
+		}
+	}
+	for _, pod := range status.Pods {
+		if !pod.IsRunning {
+			reasons = append(reasons, fmt.Sprintf("Pod '%s/%s' is not running", pod.Namespace, pod.Name))
+		}
+	}
+
+	if len(reasons) > 0 {
+		return Health{State: HealthStateDegraded, Reasons: reasons}
+	}
+	return Health{State: HealthStateHealthy}
+}
+
+// newHealthFailure returns the failure carrying the exit code of a degraded or stopped system, nil if the system is healthy.
+// The failure is not printed since the status output contains the health already.
+func newHealthFailure(health Health) *common.CmdFailure {
+	switch health.State {
+	case HealthStateDegraded:
+		return &common.CmdFailure{
+			Severity:          common.SeverityWarning,
+			Code:              "system-degraded",
+			Message:           "The system is degraded: " + strings.Join(health.Reasons, "; "),
+			SuppressCliOutput: true,
+			ExitCode:          ExitCodeDegraded,
+		}
+	case HealthStateStopped:
+		return &common.CmdFailure{
+			Severity:          common.SeverityWarning,
+			Code:              "system-not-running",
+			Message:           common.ErrSystemNotRunningMsg,
+			SuppressCliOutput: true,
+			ExitCode:          ExitCodeStopped,
+		}
+	default:
+		return nil
+	}
+}
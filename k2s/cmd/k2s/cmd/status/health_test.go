@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package status
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/siemens-healthineers/k2s/internal/core/noderesources"
+)
+
+var _ = Describe("health", func() {
+	Describe("EvaluateHealth", func() {
+		It("returns stopped if system is not running", func() {
+			health := EvaluateHealth(&LoadedStatus{RunningState: &RunningState{IsRunning: false, Issues: []string{"API server not reachable"}}})
+
+			Expect(health).To(Equal(Health{State: HealthStateStopped}))
+		})
+
+		It("returns healthy if nodes are ready and Pods are running", func() {
+			health := EvaluateHealth(&LoadedStatus{
+				RunningState: &RunningState{IsRunning: true},
+				Nodes:        []Node{{Name: "n1", IsReady: true, Resources: &noderesources.Resources{}}},
+				Pods:         []Pod{{Name: "p1", IsRunning: true}},
+			})
+
+			Expect(health).To(Equal(Health{State: HealthStateHealthy}))
+		})
+
+		It("returns healthy despite notes", func() {
+			health := EvaluateHealth(&LoadedStatus{
+				RunningState: &RunningState{IsRunning: true, Notes: []string{"host service 'kubelet' was restarted 1 time(s)"}},
+				Nodes:        []Node{{Name: "n1", IsReady: true, Resources: &noderesources.Resources{}}},
+			})
+
+			Expect(health).To(Equal(Health{State: HealthStateHealthy}))
+		})
+
+		It("returns degraded with all reasons", func() {
+			health := EvaluateHealth(&LoadedStatus{
+				RunningState: &RunningState{IsRunning: true, Issues: []string{"certificate expires soon"}},
+				Nodes: []Node{
+					{Name: "n1", IsReady: false},
+					{Name: "n2", IsReady: true, Resources: &noderesources.Resources{Pressure: []string{"DiskPressure"}}},
+				},
+				Pods: []Pod{{Namespace: "kube-system", Name: "coredns", IsRunning: false}},
+			})
+
+			Expect(health).To(Equal(Health{State: HealthStateDegraded, Reasons: []string{
+				"certificate expires soon",
+				"node 'n1' is not ready",
+				"node 'n2' is under resource pressure: DiskPressure",
+				"Pod 'kube-system/coredns' is not running",
+			}}))
+		})
+	})
+
+	Describe("newHealthFailure", func() {
+		It("returns nil if system is healthy", func() {
+			Expect(newHealthFailure(Health{State: HealthStateHealthy})).To(BeNil())
+		})
+
+		DescribeTable("returns silent failure with exit code", func(health Health, expectedCode string, expectedExitCode int) {
+			failure := newHealthFailure(health)
+
+			Expect(failure.Code).To(Equal(expectedCode))
+			Expect(int(failure.ExitCode)).To(Equal(expectedExitCode))
+			Expect(failure.SuppressCliOutput).To(BeTrue())
+		},
+			Entry("degraded", Health{State: HealthStateDegraded, Reasons: []string{"x"}}, "system-degraded", 2),
+			Entry("stopped", Health{State: HealthStateStopped}, "system-not-running", 3),
+		)
+	})
+})
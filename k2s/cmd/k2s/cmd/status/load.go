@@ -4,7 +4,14 @@
 package status
 
 import (
+	"fmt"
+	"log/slog"
+	"time"
+
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/internal/core/events"
+	"github.com/siemens-healthineers/k2s/internal/core/noderesources"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
 	"github.com/siemens-healthineers/k2s/internal/provider"
 )
 
@@ -14,6 +21,8 @@ type LoadedStatus struct {
 	Nodes          []Node          `json:"nodes"`
 	Pods           []Pod           `json:"pods"`
 	K8sVersionInfo *K8sVersionInfo `json:"k8sVersionInfo"`
+	// Events is nil if no events were requested or they could not be loaded.
+	Events []events.Group `json:"events,omitempty"`
 }
 
 type Pod struct {
@@ -29,22 +38,24 @@ type Pod struct {
 }
 
 type Node struct {
-	Status           string   `json:"status"`
-	Name             string   `json:"name"`
-	Role             string   `json:"role"`
-	Age              string   `json:"age"`
-	KubeletVersion   string   `json:"kubeletVersion"`
-	KernelVersion    string   `json:"kernelVersion"`
-	OsImage          string   `json:"osImage"`
-	ContainerRuntime string   `json:"containerRuntime"`
-	InternalIp       string   `json:"internalIp"`
-	IsReady          bool     `json:"isReady"`
-	Capacity         Capacity `json:"capacity"`
+	Status           string                   `json:"status"`
+	Name             string                   `json:"name"`
+	Role             string                   `json:"role"`
+	Age              string                   `json:"age"`
+	KubeletVersion   string                   `json:"kubeletVersion"`
+	KernelVersion    string                   `json:"kernelVersion"`
+	OsImage          string                   `json:"osImage"`
+	ContainerRuntime string                   `json:"containerRuntime"`
+	InternalIp       string                   `json:"internalIp"`
+	IsReady          bool                     `json:"isReady"`
+	Capacity         Capacity                 `json:"capacity"`
+	Resources        *noderesources.Resources `json:"resources,omitempty"`
 }
 
 type RunningState struct {
 	IsRunning bool     `json:"isRunning"`
 	Issues    []string `json:"issues"`
+	Notes     []string `json:"notes,omitempty"`
 }
 
 type K8sVersionInfo struct {
@@ -62,7 +73,11 @@ type Capacity struct {
 // The provider returns domain types which are mapped back to the command-local
 // types used by the printer chain.
 func LoadStatus(ctx *common.CmdContext) (*LoadedStatus, error) {
-	clusterStatus, err := ctx.Providers().Cluster.Status(provider.ClusterStatusConfig{})
+	return loadStatus(ctx, provider.ClusterStatusConfig{})
+}
+
+func loadStatus(ctx *common.CmdContext, config provider.ClusterStatusConfig) (*LoadedStatus, error) {
+	clusterStatus, err := ctx.Providers().Cluster.Status(config)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +87,9 @@ func LoadStatus(ctx *common.CmdContext) (*LoadedStatus, error) {
 		RunningState: &RunningState{
 			IsRunning: clusterStatus.IsRunning,
 			Issues:    clusterStatus.Issues,
+			Notes:     clusterStatus.Notes,
 		},
+		Events: clusterStatus.Events,
 	}
 
 	for _, n := range clusterStatus.Nodes {
@@ -92,6 +109,7 @@ func LoadStatus(ctx *common.CmdContext) (*LoadedStatus, error) {
 				Storage: n.Capacity.Storage,
 				Memory:  n.Capacity.Memory,
 			},
+			Resources: n.Resources,
 		})
 	}
 
@@ -118,3 +136,29 @@ func LoadStatus(ctx *common.CmdContext) (*LoadedStatus, error) {
 
 	return result, nil
 }
+
+// loadUserCertIssues reports client certificates of K2s users expiring within the renewal period.
+func loadUserCertIssues(configDir string, now time.Time) []string {
+	registeredUsers, err := registry.NewRegistry(configDir).Load()
+	if err != nil {
+		slog.Warn("Could not load K2s users, skipping user certificate expiry check", "error", err)
+		return nil
+	}
+	return userCertIssues(registeredUsers.ExpiringWithin(registry.DefaultRenewalPeriod, now), now)
+}
+
+func userCertIssues(expiring []registry.UserEntry, now time.Time) []string {
+	var issues []string
+	for _, entry := range expiring {
+		expiry := entry.CertExpiry.Local().Format(time.DateOnly)
+		renewHint := fmt.Sprintf("run 'k2s system users renew -u %s'", entry.K2sUserName)
+
+		if entry.CertExpiry.After(now) {
+			days := int(entry.CertExpiry.Sub(now).Hours() / 24)
+			issues = append(issues, fmt.Sprintf("Client certificate of user '%s' expires on %s (in %d days), %s", entry.K2sUserName, expiry, days, renewHint))
+		} else {
+			issues = append(issues, fmt.Sprintf("Client certificate of user '%s' expired on %s, %s", entry.K2sUserName, expiry, renewHint))
+		}
+	}
+	return issues
+}
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package status
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
+)
+
+var _ = Describe("load", func() {
+	Describe("userCertIssues", func() {
+		It("reports expiring and expired user certificates with renewal hint", func() {
+			now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.Local)
+			expiring := []registry.UserEntry{
+				{K2sUserName: "k2s-john", CertExpiry: now.AddDate(0, 0, 12)},
+				{K2sUserName: "k2s-jane", CertExpiry: now.AddDate(0, 0, -1)},
+			}
+
+			issues := userCertIssues(expiring, now)
+
+			Expect(issues).To(Equal([]string{
+				"Client certificate of user 'k2s-john' expires on 2026-06-13 (in 12 days), run 'k2s system users renew -u k2s-john'",
+				"Client certificate of user 'k2s-jane' expired on 2026-05-31, run 'k2s system users renew -u k2s-jane'",
+			}))
+		})
+	})
+})
@@ -7,10 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
 	"github.com/siemens-healthineers/k2s/internal/contracts/config"
 
+	"github.com/siemens-healthineers/k2s/internal/core/events"
+	"github.com/siemens-healthineers/k2s/internal/core/noderesources"
 	"github.com/siemens-healthineers/k2s/internal/definitions"
 	"github.com/siemens-healthineers/k2s/internal/primitives/arrays"
 	"github.com/siemens-healthineers/k2s/internal/primitives/units"
@@ -46,12 +51,23 @@ type UserFriendlyPrinter struct {
 	terminalPrinter    TerminalPrinter
 }
 
+// HealthPrinter prints the health of the system only.
+type HealthPrinter struct {
+	terminalPrinter TerminalPrinter
+	loadFunc        func() (*LoadedStatus, error)
+}
+
+// PrintStatus is the structured status output; its schema is versioned by ApiVersion, see status.schema.json.
 type PrintStatus struct {
+	ApiVersion     string          `json:"apiVersion"`
+	Kind           string          `json:"kind"`
+	Health         *Health         `json:"health"`
 	SetupInfo      *PrintSetupInfo `json:"setupInfo"`
 	RunningState   *RunningState   `json:"runningState"`
 	Nodes          []Node          `json:"nodes"`
 	Pods           []Pod           `json:"pods"`
 	K8sVersionInfo *K8sVersionInfo `json:"k8sVersionInfo"`
+	Events         []events.Group  `json:"events,omitempty"`
 	Error          *string         `json:"error"`
 }
 
@@ -61,6 +77,13 @@ type PrintSetupInfo struct {
 	LinuxOnly bool   `json:"linuxOnly"`
 }
 
+const (
+	StatusApiVersion = "v1"
+	StatusKind       = "SystemStatus"
+
+	maxEventMessageLength = 80
+)
+
 type basePrinter struct {
 	config   *config.K2sRuntimeConfig
 	loadFunc func() (*LoadedStatus, error)
@@ -94,6 +117,13 @@ func NewUserFriendlyPrinter(
 	}
 }
 
+func NewHealthPrinter(terminalPrinter TerminalPrinter, loadFunc func() (*LoadedStatus, error)) *HealthPrinter {
+	return &HealthPrinter{
+		terminalPrinter: terminalPrinter,
+		loadFunc:        loadFunc,
+	}
+}
+
 func (p *JsonPrinter) Print() error {
 	loadedStatus, err := p.loadFunc()
 	if err != nil {
@@ -101,6 +131,8 @@ func (p *JsonPrinter) Print() error {
 	}
 
 	printStatus := PrintStatus{
+		ApiVersion: StatusApiVersion,
+		Kind:       StatusKind,
 		SetupInfo: &PrintSetupInfo{
 			Version:   p.config.InstallConfig().Version(),
 			Name:      string(p.config.InstallConfig().SetupName()),
@@ -110,6 +142,7 @@ func (p *JsonPrinter) Print() error {
 		Nodes:          loadedStatus.Nodes,
 		Pods:           loadedStatus.Pods,
 		K8sVersionInfo: loadedStatus.K8sVersionInfo,
+		Events:         loadedStatus.Events,
 	}
 
 	var deferredErr error
@@ -117,6 +150,8 @@ func (p *JsonPrinter) Print() error {
 		printStatus.Error = new(loadedStatus.Failure.Code)
 		loadedStatus.Failure.SuppressCliOutput = true
 		deferredErr = loadedStatus.Failure
+	} else {
+		printStatus.Health = new(EvaluateHealth(loadedStatus))
 	}
 
 	slog.Info("Marshalling", "status", printStatus)
@@ -171,11 +206,18 @@ func (p *UserFriendlyPrinter) Print() error {
 	if !status.RunningState.IsRunning {
 		p.terminalPrinter.PrintInfoln(common.ErrSystemNotRunningMsg)
 		p.terminalPrinter.PrintTreeListItems(status.RunningState.Issues)
+		p.printNotes(status.RunningState.Notes)
 		return nil
 	}
 
 	p.terminalPrinter.PrintSuccess("The system is running")
 
+	if len(status.RunningState.Issues) > 0 {
+		p.terminalPrinter.PrintWarning("Issues found:")
+		p.terminalPrinter.PrintTreeListItems(status.RunningState.Issues)
+	}
+	p.printNotes(status.RunningState.Notes)
+
 	if p.config.InstallConfig().SetupName() == definitions.SetupNameBuildOnlyEnv {
 		slog.Debug("Setup type has no K8s components, skipping", "type", definitions.SetupNameBuildOnlyEnv)
 		return nil
@@ -197,9 +239,54 @@ func (p *UserFriendlyPrinter) Print() error {
 
 	p.printPodsStatus(status.Pods, p.showAdditionalInfo)
 
+	p.printEvents(status.Events, time.Now())
+
+	return nil
+}
+
+func (p *HealthPrinter) Print() error {
+	spinner, err := common.StartSpinner(p.terminalPrinter)
+	if err != nil {
+		return err
+	}
+
+	status, err := p.loadFunc()
+
+	common.StopSpinner(spinner)
+
+	if err != nil {
+		return fmt.Errorf("status could not be loaded: %w", err)
+	}
+
+	if status.Failure != nil {
+		return status.Failure
+	}
+
+	health := EvaluateHealth(status)
+	stateText := string(health.State)
+	if health.State == HealthStateHealthy {
+		stateText = p.terminalPrinter.PrintGreenFg(stateText)
+	} else {
+		stateText = p.terminalPrinter.PrintRedFg(stateText)
+	}
+
+	p.terminalPrinter.Println(fmt.Sprintf("Health: %s", stateText))
+
+	if len(health.Reasons) > 0 {
+		p.terminalPrinter.PrintTreeListItems(health.Reasons)
+	}
+
 	return nil
 }
 
+func (p *UserFriendlyPrinter) printNotes(notes []string) {
+	if len(notes) == 0 {
+		return
+	}
+	p.terminalPrinter.PrintInfoln("Notes:")
+	p.terminalPrinter.PrintTreeListItems(notes)
+}
+
 func (p *UserFriendlyPrinter) printK8sVersion(version string, versionType string) {
 	versionText := p.terminalPrinter.PrintCyanFg(version)
 	line := fmt.Sprintf("K8s %s version: '%s'", versionType, versionText)
@@ -212,11 +299,12 @@ func (p *UserFriendlyPrinter) printNodesStatus(nodes []Node, showAdditionalInfo
 		return false, nil
 	}
 
-	headers := createNodeHeaders(showAdditionalInfo)
+	columns := determineResourceColumns(nodes)
+	headers := createNodeHeaders(showAdditionalInfo, columns)
 
 	table := [][]string{headers}
 
-	rows, allOkay, err := p.buildNodeRows(nodes, showAdditionalInfo)
+	rows, allOkay, err := p.buildNodeRows(nodes, showAdditionalInfo, columns)
 	if err != nil {
 		return false, fmt.Errorf("could not build node rows: %w", err)
 	}
@@ -231,6 +319,11 @@ func (p *UserFriendlyPrinter) printNodesStatus(nodes []Node, showAdditionalInfo
 		p.terminalPrinter.PrintWarning("Some nodes are not ready")
 	}
 
+	if pressure := buildPressureWarnings(nodes); len(pressure) > 0 {
+		p.terminalPrinter.PrintWarning("Some nodes are under resource pressure:")
+		p.terminalPrinter.PrintTreeListItems(pressure)
+	}
+
 	p.terminalPrinter.Println()
 
 	return allOkay, nil
@@ -260,9 +353,95 @@ func (p *UserFriendlyPrinter) printPodsStatus(pods []Pod, showAdditionalInfo boo
 	p.terminalPrinter.Println()
 }
 
-func createNodeHeaders(showAdditionalInfo bool) []string {
+// resourceColumns denotes the optional resource columns of the nodes table; they are only shown if the resources
+// of at least one node are known.
+type resourceColumns struct {
+	resources bool
+	usage     bool
+}
+
+func determineResourceColumns(nodes []Node) resourceColumns {
+	var columns resourceColumns
+	for _, node := range nodes {
+		if node.Resources == nil {
+			continue
+		}
+		columns.resources = true
+		if node.Resources.Usage != nil {
+			columns.usage = true
+		}
+	}
+	return columns
+}
+
+// printEvents prints the recent warning events if they were loaded, i.e. are not nil.
+func (p *UserFriendlyPrinter) printEvents(warningEvents []events.Group, now time.Time) {
+	if warningEvents == nil {
+		return
+	}
+
+	if len(warningEvents) == 0 {
+		p.terminalPrinter.PrintSuccess(fmt.Sprintf("No warning events in the last %s", formatAge(events.DefaultWindow)))
+		p.terminalPrinter.Println()
+		return
+	}
+
+	p.terminalPrinter.PrintWarning(fmt.Sprintf("Warning events in the last %s:", formatAge(events.DefaultWindow)))
+	p.terminalPrinter.PrintTableWithHeaders(buildEventsTable(warningEvents, now))
+	p.terminalPrinter.Println()
+}
+
+func buildEventsTable(warningEvents []events.Group, now time.Time) [][]string {
+	table := [][]string{{"LAST SEEN", "NAMESPACE", "OBJECT", "REASON", "COUNT", "MESSAGE"}}
+	for _, group := range warningEvents {
+		table = append(table, []string{
+			formatAge(now.Sub(group.LastSeen)) + " ago",
+			group.Namespace,
+			strings.ToLower(group.Kind) + "/" + group.Name,
+			group.Reason,
+			strconv.Itoa(group.Count),
+			truncate(group.Message, maxEventMessageLength),
+		})
+	}
+	return table
+}
+
+// formatAge formats durations like kubectl, e.g. '45s', '12m' or '3h'.
+func formatAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", max(int(d.Seconds()), 0))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
+
+func truncate(text string, maxLength int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	runes := []rune(text)
+	if len(runes) <= maxLength {
+		return text
+	}
+	return string(runes[:maxLength-3]) + "..."
+}
+
+func createNodeHeaders(showAdditionalInfo bool, columns resourceColumns) []string {
 	headers := []string{"STATUS", "NAME", "ROLE", "AGE", "VERSION", "CPUs", "RAM", "DISK"}
 
+	if columns.resources {
+		headers = append(headers, "CPU REQUESTED", "RAM REQUESTED")
+	}
+	if columns.usage {
+		headers = append(headers, "CPU USED", "RAM USED")
+	}
+	if columns.resources {
+		headers = append(headers, "PRESSURE")
+	}
+
 	if showAdditionalInfo {
 		headers = append(headers, "INTERNAL-IP", "OS-IMAGE", "KERNEL-VERSION", "CONTAINER-RUNTIME")
 	}
@@ -281,12 +460,12 @@ func createPodHeaders(showAdditionalInfo bool) []string {
 	return headers
 }
 
-func (p *UserFriendlyPrinter) buildNodeRows(nodes []Node, showAdditionalInfo bool) ([][]string, bool, error) {
+func (p *UserFriendlyPrinter) buildNodeRows(nodes []Node, showAdditionalInfo bool, columns resourceColumns) ([][]string, bool, error) {
 	allOkay := true
 	var rows [][]string
 
 	for _, node := range nodes {
-		row, err := p.buildNodeRow(node, showAdditionalInfo)
+		row, err := p.buildNodeRow(node, showAdditionalInfo, columns)
 		if err != nil {
 			return nil, false, fmt.Errorf("could not build node row: %w", err)
 		}
@@ -317,7 +496,7 @@ func (p *UserFriendlyPrinter) buildPodRows(pods []Pod, showAdditionalInfo bool)
 	return rows, allOkay
 }
 
-func (p *UserFriendlyPrinter) buildNodeRow(node Node, showAdditionalInfo bool) ([]string, error) {
+func (p *UserFriendlyPrinter) buildNodeRow(node Node, showAdditionalInfo bool, columns resourceColumns) ([]string, error) {
 	status := p.determineStatusColor(node.IsReady, node.Status)
 	memory, err := units.ParseBase2Bytes(node.Capacity.Memory)
 	if err != nil {
@@ -329,6 +508,7 @@ func (p *UserFriendlyPrinter) buildNodeRow(node Node, showAdditionalInfo bool) (
 	}
 
 	row := []string{status, node.Name, node.Role, node.Age, node.KubeletVersion, node.Capacity.Cpu, memory.String(), storage.String()}
+	row = append(row, p.buildResourceCells(node.Resources, columns)...)
 
 	if showAdditionalInfo {
 		row = append(row, node.InternalIp, node.OsImage, node.KernelVersion, node.ContainerRuntime)
@@ -337,6 +517,67 @@ func (p *UserFriendlyPrinter) buildNodeRow(node Node, showAdditionalInfo bool) (
 	return row, nil
 }
 
+// buildResourceCells returns the requested and used resources relative to the allocatable resources, e.g. '1350m/4 (33%)'.
+func (p *UserFriendlyPrinter) buildResourceCells(resources *noderesources.Resources, columns resourceColumns) []string {
+	const unknown = "-"
+
+	var cells []string
+	if columns.resources {
+		if resources == nil {
+			cells = append(cells, unknown, unknown)
+		} else {
+			cells = append(cells,
+				formatCpuShare(resources.Requested.CpuMillis, resources.Allocatable.CpuMillis, true),
+				formatMemoryShare(resources.Requested.MemoryBytes, resources.Allocatable.MemoryBytes, true))
+		}
+	}
+	if columns.usage {
+		if resources == nil || resources.Usage == nil {
+			cells = append(cells, unknown, unknown)
+		} else {
+			cells = append(cells,
+				formatCpuShare(resources.Usage.CpuMillis, resources.Allocatable.CpuMillis, false),
+				formatMemoryShare(resources.Usage.MemoryBytes, resources.Allocatable.MemoryBytes, false))
+		}
+	}
+	if columns.resources {
+		switch {
+		case resources == nil:
+			cells = append(cells, unknown)
+		case len(resources.Pressure) > 0:
+			cells = append(cells, p.terminalPrinter.PrintRedFg(strings.Join(resources.Pressure, ",")))
+		default:
+			cells = append(cells, "none")
+		}
+	}
+	return cells
+}
+
+func formatCpuShare(millis, allocatable int64, showAllocatable bool) string {
+	return formatShare(noderesources.FormatCpu(millis), noderesources.FormatCpu(allocatable), noderesources.Percent(millis, allocatable), showAllocatable)
+}
+
+func formatMemoryShare(bytes, allocatable int64, showAllocatable bool) string {
+	return formatShare(units.BytesQuantity(bytes).String(), units.BytesQuantity(allocatable).String(), noderesources.Percent(bytes, allocatable), showAllocatable)
+}
+
+func formatShare(amount, allocatable string, percent int64, showAllocatable bool) string {
+	if showAllocatable {
+		return fmt.Sprintf("%s/%s (%d%%)", amount, allocatable, percent)
+	}
+	return fmt.Sprintf("%s (%d%%)", amount, percent)
+}
+
+func buildPressureWarnings(nodes []Node) []string {
+	var warnings []string
+	for _, node := range nodes {
+		if node.Resources != nil && len(node.Resources.Pressure) > 0 {
+			warnings = append(warnings, fmt.Sprintf("%s: %s", node.Name, strings.Join(node.Resources.Pressure, ", ")))
+		}
+	}
+	return warnings
+}
+
 func (p *UserFriendlyPrinter) buildPodRow(pod Pod, showAdditionalInfo bool) []string {
 	status := p.determineStatusColor(pod.IsRunning, pod.Status)
 
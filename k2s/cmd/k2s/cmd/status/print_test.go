@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package status
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/siemens-healthineers/k2s/internal/core/events"
+)
+
+var _ = Describe("print", func() {
+	Describe("buildEventsTable", func() {
+		It("shows one row per event group", func() {
+			now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+			warningEvents := []events.Group{
+				{Namespace: "kube-system", Kind: "Pod", Name: "coredns-1", Reason: "BackOff", Message: "Back-off restarting\nfailed container", Count: 7, LastSeen: now.Add(-90 * time.Second)},
+				{Namespace: "logging", Kind: "DaemonSet", Name: "fluent-bit", Reason: "FailedCreate", Message: strings.Repeat("x", 100), Count: 1, LastSeen: now.Add(-2 * time.Hour)},
+			}
+
+			table := buildEventsTable(warningEvents, now)
+
+			Expect(table).To(Equal([][]string{
+				{"LAST SEEN", "NAMESPACE", "OBJECT", "REASON", "COUNT", "MESSAGE"},
+				{"1m ago", "kube-system", "pod/coredns-1", "BackOff", "7", "Back-off restarting failed container"},
+				{"2h ago", "logging", "daemonset/fluent-bit", "FailedCreate", "1", strings.Repeat("x", 77) + "..."},
+			}))
+		})
+	})
+
+	Describe("formatAge", func() {
+		DescribeTable("formats like kubectl", func(d time.Duration, expected string) {
+			Expect(formatAge(d)).To(Equal(expected))
+		},
+			Entry("seconds", 45*time.Second, "45s"),
+			Entry("minutes", 12*time.Minute, "12m"),
+			Entry("hours", 3*time.Hour, "3h"),
+			Entry("days", 50*time.Hour, "2d"),
+			Entry("clock skew", -5*time.Second, "0s"),
+		)
+	})
+})
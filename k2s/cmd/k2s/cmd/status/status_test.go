@@ -4,17 +4,23 @@
 package status_test
 
 import (
+	gojson "encoding/json"
 	"errors"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/santhosh-tekuri/jsonschema/v5"
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/status"
 	"github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/events"
+	"github.com/siemens-healthineers/k2s/internal/core/noderesources"
 	"github.com/siemens-healthineers/k2s/internal/definitions"
+	"github.com/siemens-healthineers/k2s/internal/json"
 	"github.com/siemens-healthineers/k2s/internal/reflection"
 	"github.com/stretchr/testify/mock"
 )
@@ -248,6 +254,67 @@ var _ = Describe("status pkg", func() {
 			})
 		})
 
+		Describe("JsonPrinter output", func() {
+			It("is valid against the versioned status schema", func() {
+				loadedStatus := &status.LoadedStatus{
+					RunningState: &status.RunningState{IsRunning: true, Issues: []string{"some issue"}},
+					Nodes: []status.Node{{
+						Name:     "n1",
+						Capacity: status.Capacity{Cpu: "4", Memory: "8Gi", Storage: "50Gi"},
+						Resources: &noderesources.Resources{
+							Allocatable: noderesources.Amounts{CpuMillis: 4000, MemoryBytes: 8 << 30},
+							Usage:       &noderesources.Amounts{CpuMillis: 100},
+							Pressure:    []string{"PIDPressure"},
+						},
+					}},
+					Pods:           []status.Pod{{Name: "p1", IsRunning: true}},
+					K8sVersionInfo: &status.K8sVersionInfo{K8sServerVersion: "v1.35.0", K8sClientVersion: "v1.35.0"},
+					Events:         []events.Group{{Namespace: "kube-system", Kind: "Pod", Name: "coredns", Reason: "BackOff", Count: 3, LastSeen: time.Now()}},
+				}
+				runtimeConfig := config.NewK2sRuntimeConfig(nil, config.NewK2sInstallConfig("test-name", true, "test-version", false, false), nil)
+
+				var output string
+				printlnFunc := func(m ...any) { output = m[0].(string) }
+
+				sut := status.NewJsonPrinter(runtimeConfig, printlnFunc, json.MarshalIndent, func() (*status.LoadedStatus, error) { return loadedStatus, nil })
+
+				Expect(sut.Print()).To(Succeed())
+
+				schema, err := jsonschema.Compile("status.schema.json")
+				Expect(err).ToNot(HaveOccurred())
+
+				var document any
+				Expect(gojson.Unmarshal([]byte(output), &document)).To(Succeed())
+				Expect(schema.Validate(document)).To(Succeed())
+				Expect(document).To(HaveKeyWithValue("apiVersion", status.StatusApiVersion))
+				Expect(document).To(HaveKeyWithValue("health", HaveKeyWithValue("state", "degraded")))
+			})
+		})
+
+		Describe("HealthPrinter", func() {
+			It("prints the health state with reasons", func() {
+				loadedStatus := &status.LoadedStatus{
+					RunningState: &status.RunningState{IsRunning: true},
+					Nodes:        []status.Node{{Name: "n1", IsReady: false}},
+				}
+
+				spinnerMock := &mockObject{}
+				spinnerMock.On(reflection.GetFunctionName(spinnerMock.Stop)).Return(nil)
+
+				printerMock := &mockObject{}
+				printerMock.On(reflection.GetFunctionName(printerMock.StartSpinner), mock.Anything).Return(spinnerMock, nil)
+				printerMock.On(reflection.GetFunctionName(printerMock.PrintRedFg), "degraded").Return("degraded-red")
+				printerMock.On(reflection.GetFunctionName(printerMock.Println), "Health: degraded-red").Once()
+				printerMock.On(reflection.GetFunctionName(printerMock.PrintTreeListItems), []string{"node 'n1' is not ready"}).Once()
+
+				sut := status.NewHealthPrinter(printerMock, func() (*status.LoadedStatus, error) { return loadedStatus, nil })
+
+				Expect(sut.Print()).To(Succeed())
+
+				printerMock.AssertExpectations(GinkgoT())
+			})
+		})
+
 		Describe("UserFriendlyPrinter", func() {
 			Describe("Print", func() {
 				When("start spinner error occurred", func() {
@@ -539,6 +606,65 @@ var _ = Describe("status pkg", func() {
 							printerMock.AssertExpectations(GinkgoT())
 						})
 
+						When("issues exist", func() {
+							BeforeEach(func() {
+								runtimeConfig = config.NewK2sRuntimeConfig(nil, config.NewK2sInstallConfig(definitions.SetupNameBuildOnlyEnv, false, "test-version", false, false), nil)
+								loadedStatus.RunningState.Issues = []string{"cert expires soon"}
+							})
+
+							It("prints the issues", func() {
+								printerMock := &mockObject{}
+								printerMock.On(reflection.GetFunctionName(printerMock.StartSpinner), mock.Anything).Return(spinnerMock, nil)
+								printerMock.On(reflection.GetFunctionName(printerMock.PrintHeader), mock.Anything)
+								printerMock.On(reflection.GetFunctionName(printerMock.PrintCyanFg), mock.Anything).Return("")
+								printerMock.On(reflection.GetFunctionName(printerMock.Println), mock.Anything)
+								printerMock.On(reflection.GetFunctionName(printerMock.PrintSuccess), "The system is running").Once()
+								printerMock.On(reflection.GetFunctionName(printerMock.PrintWarning), "Issues found:").Once()
+								printerMock.On(reflection.GetFunctionName(printerMock.PrintTreeListItems), []string{"cert expires soon"}).Once()
+
+								loadMock := &mockObject{}
+								loadMock.On(reflection.GetFunctionName(loadMock.load)).Return(loadedStatus, nil)
+
+								sut := status.NewUserFriendlyPrinter(runtimeConfig, false, printerMock, loadMock.load)
+
+								err := sut.Print()
+
+								Expect(err).ToNot(HaveOccurred())
+
+								printerMock.AssertExpectations(GinkgoT())
+							})
+						})
+
+						When("notes exist", func() {
+							BeforeEach(func() {
+								runtimeConfig = config.NewK2sRuntimeConfig(nil, config.NewK2sInstallConfig(definitions.SetupNameBuildOnlyEnv, false, "test-version", false, false), nil)
+								loadedStatus.RunningState.Notes = []string{"host service 'kubelet' was restarted 1 time(s)"}
+							})
+
+							It("prints the notes without issues", func() {
+								printerMock := &mockObject{}
+								printerMock.On(reflection.GetFunctionName(printerMock.StartSpinner), mock.Anything).Return(spinnerMock, nil)
+								printerMock.On(reflection.GetFunctionName(printerMock.PrintHeader), mock.Anything)
+								printerMock.On(reflection.GetFunctionName(printerMock.PrintCyanFg), mock.Anything).Return("")
+								printerMock.On(reflection.GetFunctionName(printerMock.Println), mock.Anything)
+								printerMock.On(reflection.GetFunctionName(printerMock.PrintSuccess), "The system is running").Once()
+								printerMock.On(reflection.GetFunctionName(printerMock.PrintInfoln), "Notes:").Once()
+								printerMock.On(reflection.GetFunctionName(printerMock.PrintTreeListItems), []string{"host service 'kubelet' was restarted 1 time(s)"}).Once()
+
+								loadMock := &mockObject{}
+								loadMock.On(reflection.GetFunctionName(loadMock.load)).Return(loadedStatus, nil)
+
+								sut := status.NewUserFriendlyPrinter(runtimeConfig, false, printerMock, loadMock.load)
+
+								err := sut.Print()
+
+								Expect(err).ToNot(HaveOccurred())
+
+								printerMock.AssertExpectations(GinkgoT())
+								printerMock.AssertNotCalled(GinkgoT(), reflection.GetFunctionName(printerMock.PrintWarning), mock.Anything)
+							})
+						})
+
 						When("setup is build-only", func() {
 							BeforeEach(func() {
 								runtimeConfig = config.NewK2sRuntimeConfig(nil, config.NewK2sInstallConfig(definitions.SetupNameBuildOnlyEnv, false, "test-version", false, false), nil)
@@ -717,6 +843,50 @@ var _ = Describe("status pkg", func() {
 								})
 							})
 
+							When("node resources are known", func() {
+								BeforeEach(func() {
+									loadedStatus.Nodes[0].Resources = &noderesources.Resources{
+										Allocatable: noderesources.Amounts{CpuMillis: 4000, MemoryBytes: 8 << 30},
+										Requested:   noderesources.Amounts{CpuMillis: 1350, MemoryBytes: 2 << 30},
+										Usage:       &noderesources.Amounts{CpuMillis: 500, MemoryBytes: 4 << 30},
+										Pressure:    []string{"MemoryPressure", "DiskPressure"},
+									}
+								})
+
+								It("prints Nodes status table with resource columns and a pressure warning", func() {
+									expectedTable := [][]string{
+										{"STATUS", "NAME", "ROLE", "AGE", "VERSION", "CPUs", "RAM", "DISK", "CPU REQUESTED", "RAM REQUESTED", "CPU USED", "RAM USED", "PRESSURE"},
+										{"good-green", "n1", "good-one", "new", "k1", "3", "4GiB", "5TiB", "1350m/4 (33%)", "2GiB/8GiB (25%)", "500m (12%)", "4GiB (50%)", "pressure-red"},
+										{"bad-red", "n2", "bad-one", "old", "k99", "6", "7GiB", "8TiB", "-", "-", "-", "-", "-"},
+									}
+
+									printerMock := &mockObject{}
+									printerMock.On(reflection.GetFunctionName(printerMock.StartSpinner), mock.Anything).Return(spinnerMock, nil)
+									printerMock.On(reflection.GetFunctionName(printerMock.PrintHeader), mock.Anything)
+									printerMock.On(reflection.GetFunctionName(printerMock.PrintCyanFg), mock.Anything).Return("")
+									printerMock.On(reflection.GetFunctionName(printerMock.Println), mock.Anything)
+									printerMock.On(reflection.GetFunctionName(printerMock.PrintSuccess), mock.Anything)
+									printerMock.On(reflection.GetFunctionName(printerMock.PrintGreenFg), "good").Return("good-green")
+									printerMock.On(reflection.GetFunctionName(printerMock.PrintRedFg), "bad").Return("bad-red")
+									printerMock.On(reflection.GetFunctionName(printerMock.PrintRedFg), "MemoryPressure,DiskPressure").Return("pressure-red")
+									printerMock.On(reflection.GetFunctionName(printerMock.PrintTableWithHeaders), expectedTable).Once()
+									printerMock.On(reflection.GetFunctionName(printerMock.PrintWarning), "Some nodes are not ready")
+									printerMock.On(reflection.GetFunctionName(printerMock.PrintWarning), "Some nodes are under resource pressure:").Once()
+									printerMock.On(reflection.GetFunctionName(printerMock.PrintTreeListItems), []string{"n1: MemoryPressure, DiskPressure"}).Once()
+
+									loadMock := &mockObject{}
+									loadMock.On(reflection.GetFunctionName(loadMock.load)).Return(loadedStatus, nil)
+
+									sut := status.NewUserFriendlyPrinter(runtimeConfig, false, printerMock, loadMock.load)
+
+									err := sut.Print()
+
+									Expect(err).ToNot(HaveOccurred())
+
+									printerMock.AssertExpectations(GinkgoT())
+								})
+							})
+
 							When("all Nodes are ready", func() {
 								BeforeEach(func() {
 									loadedStatus.Nodes = []status.Node{
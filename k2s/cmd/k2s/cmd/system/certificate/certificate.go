@@ -11,6 +11,7 @@ var CertificateCmd = &cobra.Command{
 }
 
 func init() {
+	CertificateCmd.AddCommand(statusCmd)
 	CertificateCmd.AddCommand(renewCmd)
 	CertificateCmd.AddCommand(autoRotationCmd)
 }
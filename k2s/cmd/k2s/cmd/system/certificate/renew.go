@@ -15,8 +15,10 @@ import (
 
 var (
 	example = `
-# Trigger certificate renewal only when certificates are expired
+# Trigger certificate renewal only when certificates expire within the next 30 days
 k2s system certificate renew
+# Trigger certificate renewal only when certificates expire within the next 90 days
+k2s system certificate renew --threshold 90d
 # Trigger certificate renewal always
 k2s system certificate renew --force
 k2s system certificate renew -f
@@ -26,8 +28,9 @@ k2s system certificate renew -f
 		Use:   "renew",
 		Short: "Renews Kubernetes certificates (control-plane node only)",
 		Long: `
-Determines if Kubernetes certificates have expired and renews them.
+Determines if Kubernetes certificates are missing or expire within the threshold and renews them.
 With the --force option, the certificate renewal is performed irrespective of their expiration status.
+Use 'k2s system certificate status' to inspect the certificates and their expiry.
 		`,
 		Example: example,
 		RunE:    renewCertificates,
@@ -36,6 +39,7 @@ With the --force option, the certificate renewal is performed irrespective of th
 
 func init() {
 	renewCmd.Flags().BoolP("force", "f", false, "force renewal of certificates")
+	renewCmd.Flags().String(thresholdFlagName, defaultThreshold, "renew certificates expiring within this period, e.g. '30d' or '12h'")
 	renewCmd.Flags().SortFlags = false
 	renewCmd.Flags().PrintDefaults()
 }
@@ -49,6 +53,11 @@ func renewCertificates(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	threshold, err := parseThreshold(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
 	showLogs, err := strconv.ParseBool(cmd.Flags().Lookup(common.OutputFlagName).Value.String())
 	if err != nil {
 		return err
@@ -58,6 +67,7 @@ func renewCertificates(cmd *cobra.Command, args []string) error {
 
 	if err := context.Providers().System.CertificateRenew(provider.SystemCertRenewConfig{
 		Force:      force,
+		Threshold:  threshold,
 		ShowOutput: showLogs,
 	}); err != nil {
 		return err
@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package certificate
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/status"
+	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/certificates"
+	"github.com/siemens-healthineers/k2s/internal/core/config"
+	"github.com/siemens-healthineers/k2s/internal/json"
+	kstrings "github.com/siemens-healthineers/k2s/internal/primitives/strings"
+	"github.com/siemens-healthineers/k2s/internal/provider"
+	"github.com/siemens-healthineers/k2s/internal/terminal"
+)
+
+type statusOptions struct {
+	outputOption string
+	threshold    time.Duration
+}
+
+type certificateStatus struct {
+	ThresholdDays int                        `json:"thresholdDays"`
+	Expiring      int                        `json:"expiring"`
+	Certificates  []certificates.Certificate `json:"certificates"`
+	Warnings      []string                   `json:"warnings"`
+}
+
+const (
+	outputFlagName    = "output"
+	thresholdFlagName = "threshold"
+	wideOption        = "wide"
+	jsonOption        = "json"
+
+	defaultThreshold = "30d"
+)
+
+var (
+	statusExample = `
+# List all certificates K2s depends on
+k2s system certificate status
+
+# List all certificates including their subject alternative names
+k2s system certificate status -o wide
+
+# List all certificates as JSON and fail if any expires within the next 60 days
+k2s system certificate status -o json --threshold 60d
+	`
+
+	statusCmd = &cobra.Command{
+		Use:   "status",
+		Short: "Reports all certificates K2s depends on and their expiry",
+		Long: `
+Lists the certificates K2s depends on with subject, issuer and days left:
+control-plane certificates, kubelet client and serving certificates of the nodes,
+the clusterip-webhook serving certificate and CA, the registry addon TLS certificate
+and the client certificates of K2s users.
+
+The command fails if any certificate expires within the threshold, so that it can be used in scripts.
+Certificates that could not be inspected are reported as warnings.
+		`,
+		Example: statusExample,
+		RunE:    showCertificateStatus,
+	}
+)
+
+func init() {
+	statusCmd.Flags().StringP(outputFlagName, "o", "", "Output format modifier. Currently supported: 'wide' for subject alternative names and 'json' for output as JSON structure")
+	statusCmd.Flags().String(thresholdFlagName, defaultThreshold, "Remaining validity below which certificates are reported as expiring, e.g. '30d' or '12h'")
+	statusCmd.Flags().SortFlags = false
+	statusCmd.Flags().PrintDefaults()
+}
+
+func showCertificateStatus(cmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(cmd.CommandPath())
+
+	options, err := parseStatusOptions(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	context := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	runtimeConfig, err := config.ReadRuntimeConfig(context.Config().Host().K2sSetupConfigDir())
+	if err != nil {
+		if errors.Is(err, cconfig.ErrSystemInCorruptedState) {
+			return common.CreateSystemInCorruptedStateCmdFailure()
+		}
+		if errors.Is(err, cconfig.ErrSystemNotInstalled) {
+			return common.CreateSystemNotInstalledCmdFailure()
+		}
+		return err
+	}
+
+	if runtime.GOOS != "linux" {
+		if err := context.EnsureK2sK8sContext(runtimeConfig.ClusterConfig().Name()); err != nil {
+			return err
+		}
+	}
+
+	systemStatus, err := status.LoadStatus(context)
+	if err != nil {
+		return fmt.Errorf("could not determine system status: %w", err)
+	}
+	if !systemStatus.RunningState.IsRunning {
+		return common.CreateSystemNotRunningCmdFailure()
+	}
+
+	printer := terminal.NewTerminalPrinter()
+
+	spinner, err := common.StartSpinner(printer)
+	if err != nil {
+		return err
+	}
+
+	result, err := context.Providers().System.CertificateStatus(provider.SystemCertStatusConfig{
+		ControlPlaneName:      strings.ToLower(runtimeConfig.ControlPlaneConfig().Hostname()),
+		ControlPlaneIpAddress: context.Config().ControlPlane().IpAddress(),
+		SshPrivateKeyPath:     context.Config().Host().SshConfig().CurrentPrivateKeyPath(),
+	})
+
+	common.StopSpinner(spinner)
+
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expiring := certificates.ExpiringWithin(result.Certificates, options.threshold, now)
+
+	if options.outputOption == jsonOption {
+		bytes, err := json.MarshalIndent(certificateStatus{
+			ThresholdDays: certificates.ThresholdDays(options.threshold),
+			Expiring:      len(expiring),
+			Certificates:  result.Certificates,
+			Warnings:      result.Warnings,
+		})
+		if err != nil {
+			return err
+		}
+		printer.Println(string(bytes))
+	} else {
+		printer.PrintHeader("K2s CERTIFICATES")
+		printer.PrintTableWithHeaders(buildCertificatesTable(result.Certificates, options.outputOption == wideOption, options.threshold, now, printer))
+
+		if len(result.Warnings) > 0 {
+			printer.PrintWarning("Some certificates could not be inspected:")
+			printer.PrintTreeListItems(result.Warnings)
+		}
+	}
+
+	if len(expiring) > 0 {
+		failure := newCertificatesExpiringFailure(len(expiring), options.threshold)
+		failure.SuppressCliOutput = options.outputOption == jsonOption
+		return failure
+	}
+
+	if options.outputOption != jsonOption {
+		printer.PrintSuccess(fmt.Sprintf("All certificates are valid for more than %d days", certificates.ThresholdDays(options.threshold)))
+	}
+
+	cmdSession.Finish()
+
+	return nil
+}
+
+func parseStatusOptions(flags *pflag.FlagSet) (*statusOptions, error) {
+	outputOption, err := flags.GetString(outputFlagName)
+	if err != nil {
+		return nil, err
+	}
+	if outputOption != "" && outputOption != wideOption && outputOption != jsonOption {
+		return nil, fmt.Errorf("parameter '%s' not supported for flag 'o'", outputOption)
+	}
+
+	threshold, err := parseThreshold(flags)
+	if err != nil {
+		return nil, err
+	}
+	return &statusOptions{outputOption: outputOption, threshold: threshold}, nil
+}
+
+func parseThreshold(flags *pflag.FlagSet) (time.Duration, error) {
+	value, err := flags.GetString(thresholdFlagName)
+	if err != nil {
+		return 0, err
+	}
+	threshold, err := kstrings.ParseAge(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for flag '%s': %w", thresholdFlagName, err)
+	}
+	return threshold, nil
+}
+
+type colorPrinter interface {
+	PrintRedFg(text string) string
+	PrintGreenFg(text string) string
+}
+
+func buildCertificatesTable(certs []certificates.Certificate, showSANs bool, threshold time.Duration, now time.Time, printer colorPrinter) [][]string {
+	headers := []string{"SOURCE", "NODE", "NAME", "SUBJECT", "ISSUER", "EXPIRES", "DAYS LEFT"}
+	if showSANs {
+		headers = append(headers, "SANs")
+	}
+
+	table := [][]string{headers}
+	for _, cert := range certs {
+		daysLeft := strconv.Itoa(cert.DaysLeft)
+		if cert.ExpiresWithin(threshold, now) {
+			daysLeft = printer.PrintRedFg(daysLeft)
+		} else {
+			daysLeft = printer.PrintGreenFg(daysLeft)
+		}
+
+		row := []string{string(cert.Source), orDash(cert.Node), cert.Name, cert.Subject, cert.Issuer, cert.NotAfter.Local().Format(time.DateOnly), daysLeft}
+		if showSANs {
+			row = append(row, orDash(strings.Join(cert.SANs, ", ")))
+		}
+		table = append(table, row)
+	}
+	return table
+}
+
+func newCertificatesExpiringFailure(count int, threshold time.Duration) *common.CmdFailure {
+	return &common.CmdFailure{
+		Severity: common.SeverityWarning,
+		Code:     "certificates-expiring",
+		Message: fmt.Sprintf("%d certificate(s) expire within %d days, run 'k2s system certificate renew' for cluster certificates and 'k2s system users renew' for user certificates",
+			count, certificates.ThresholdDays(threshold)),
+	}
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
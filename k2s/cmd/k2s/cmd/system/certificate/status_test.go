@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package certificate
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"github.com/siemens-healthineers/k2s/internal/core/certificates"
+)
+
+type plainPrinter struct{}
+
+func (plainPrinter) PrintRedFg(text string) string   { return "red:" + text }
+func (plainPrinter) PrintGreenFg(text string) string { return "green:" + text }
+
+var _ = Describe("status command", Label("unit"), func() {
+	newFlags := func() *pflag.FlagSet {
+		flags := pflag.NewFlagSet("status", pflag.ContinueOnError)
+		flags.StringP(outputFlagName, "o", "", "")
+		flags.String(thresholdFlagName, defaultThreshold, "")
+		return flags
+	}
+
+	Describe("parseStatusOptions", func() {
+		It("defaults to a 30 days threshold", func() {
+			options, err := parseStatusOptions(newFlags())
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options.outputOption).To(BeEmpty())
+			Expect(options.threshold).To(Equal(certificates.DefaultThreshold))
+		})
+
+		It("parses output option and threshold", func() {
+			flags := newFlags()
+			Expect(flags.Set(outputFlagName, jsonOption)).To(Succeed())
+			Expect(flags.Set(thresholdFlagName, "60d")).To(Succeed())
+
+			options, err := parseStatusOptions(flags)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options.outputOption).To(Equal(jsonOption))
+			Expect(options.threshold).To(Equal(60 * 24 * time.Hour))
+		})
+
+		It("rejects unsupported output options", func() {
+			flags := newFlags()
+			Expect(flags.Set(outputFlagName, "yaml")).To(Succeed())
+
+			_, err := parseStatusOptions(flags)
+
+			Expect(err).To(MatchError("parameter 'yaml' not supported for flag 'o'"))
+		})
+
+		It("rejects invalid thresholds", func() {
+			flags := newFlags()
+			Expect(flags.Set(thresholdFlagName, "soon")).To(Succeed())
+
+			_, err := parseStatusOptions(flags)
+
+			Expect(err).To(MatchError(ContainSubstring("invalid value for flag 'threshold'")))
+		})
+	})
+
+	Describe("buildCertificatesTable", func() {
+		now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+		certs := []certificates.Certificate{
+			{Source: certificates.SourceKubelet, Node: "master", Name: "kubelet serving", Subject: "CN=master", Issuer: "CN=master-ca", SANs: []string{"master", "172.19.1.100"}, NotAfter: now.Add(10 * 24 * time.Hour), DaysLeft: 10},
+			{Source: certificates.SourceUser, Name: "k2s-johndoe", Subject: "CN=k2s-johndoe", Issuer: "CN=kubernetes", NotAfter: now.Add(100 * 24 * time.Hour), DaysLeft: 100},
+		}
+
+		It("highlights certificates expiring within the threshold", func() {
+			table := buildCertificatesTable(certs, false, certificates.DefaultThreshold, now, plainPrinter{})
+
+			Expect(table).To(HaveLen(3))
+			Expect(table[0]).To(Equal([]string{"SOURCE", "NODE", "NAME", "SUBJECT", "ISSUER", "EXPIRES", "DAYS LEFT"}))
+			Expect(table[1][0:5]).To(Equal([]string{"kubelet", "master", "kubelet serving", "CN=master", "CN=master-ca"}))
+			Expect(table[1][6]).To(Equal("red:10"))
+			Expect(table[2][1]).To(Equal("-"))
+			Expect(table[2][6]).To(Equal("green:100"))
+		})
+
+		It("adds SANs in wide mode", func() {
+			table := buildCertificatesTable(certs, true, certificates.DefaultThreshold, now, plainPrinter{})
+
+			Expect(table[0]).To(HaveLen(8))
+			Expect(table[1][7]).To(Equal("master, 172.19.1.100"))
+			Expect(table[2][7]).To(Equal("-"))
+		})
+	})
+})
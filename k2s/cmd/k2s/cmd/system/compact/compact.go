@@ -26,10 +26,13 @@ var (
 
 var CompactCmd = &cobra.Command{
 	Use:   "compact",
-	Short: "Compact VHDX disk to reclaim unused space (control-plane node only)",
+	Short: "Compact VM disk to reclaim unused space (control-plane node only, Windows worker VM on Linux hosts)",
 	Long: `Compacts the KubeMaster VHDX file (the control-plane node disk) to reclaim disk space freed by deleted images and files.
 This affects only the control-plane node; worker nodes and the local host are not touched.
 
+On native Linux hosts, the control plane runs without a VM; the QCOW2 disk of the Windows worker VM is compacted instead.
+The VM is trimmed, stopped, its disk is rewritten without unused space and the VM is started again.
+
 Supported setups: k2s (standard) and Linux-only.
 Not supported: WSL-based and build-only setups (no Hyper-V VHDX present).`,
 	Example: `      # Compact VHDX with automatic cluster restart
@@ -4,13 +4,21 @@
 package dump
 
 import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/config"
+	"github.com/siemens-healthineers/k2s/internal/logging"
 	"github.com/siemens-healthineers/k2s/internal/provider"
+	"github.com/siemens-healthineers/k2s/internal/terminal"
 )
 
 var (
@@ -32,7 +40,7 @@ var (
 var DumpCmd = &cobra.Command{
 	Use:     "dump",
 	Short:   "Dump system status",
-	Long:    "Dump system status to target folder",
+	Long:    "Dump cluster resources, events, node logs, K2s config files and logs into a single archive with secrets redacted",
 	RunE:    dumpSystemStatus,
 	Example: dumpCommandExample,
 }
@@ -74,14 +82,38 @@ func dumpSystemStatus(cmd *cobra.Command, args []string) error {
 
 	context := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
 
-	if err := context.Providers().System.Dump(provider.SystemDumpConfig{
-		SkipOpenDump: skipOpenDumpFlag,
-		ShowOutput:   outputFlag,
-		Nodes:        nodeSelector,
-	}); err != nil {
+	controlPlaneName := ""
+	runtimeConfig, err := config.ReadRuntimeConfig(context.Config().Host().K2sSetupConfigDir())
+	switch {
+	case errors.Is(err, cconfig.ErrSystemNotInstalled):
+		return common.CreateSystemNotInstalledCmdFailure()
+	case err != nil:
+		// a corrupted system is what the dump is meant to diagnose
+		slog.Warn("Could not read runtime config, collecting what is available", "error", err)
+	default:
+		controlPlaneName = strings.ToLower(runtimeConfig.ControlPlaneConfig().Hostname())
+		if runtime.GOOS != "linux" {
+			if err := context.EnsureK2sK8sContext(runtimeConfig.ClusterConfig().Name()); err != nil {
+				slog.Warn("Could not switch to K2s K8s context", "error", err)
+			}
+		}
+	}
+
+	archivePath, err := context.Providers().System.Dump(provider.SystemDumpConfig{
+		LogsDir:               logging.RootLogDir(),
+		ControlPlaneName:      controlPlaneName,
+		ControlPlaneIpAddress: context.Config().ControlPlane().IpAddress(),
+		SshPrivateKeyPath:     context.Config().Host().SshConfig().CurrentPrivateKeyPath(),
+		SkipOpenDump:          skipOpenDumpFlag,
+		ShowOutput:            outputFlag,
+		Nodes:                 nodeSelector,
+	})
+	if err != nil {
 		return err
 	}
 
+	terminal.NewTerminalPrinter().PrintSuccess(fmt.Sprintf("Dump written to '%s'", archivePath))
+
 	cmdSession.Finish()
 
 	return nil
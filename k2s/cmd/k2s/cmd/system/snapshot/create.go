@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package snapshot
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/status"
+	"github.com/siemens-healthineers/k2s/internal/provider"
+	"github.com/siemens-healthineers/k2s/internal/terminal"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a snapshot of the running cluster",
+	Long: `Creates a snapshot of the running cluster: the etcd database of the control plane and the disk of the Windows worker VM.
+A running Windows worker VM is restarted, since its disk can only be snapshotted while the VM is stopped.`,
+	Example: `
+  # Create a snapshot named after the current time
+  k2s system snapshot create
+
+  # Create a snapshot before an upgrade
+  k2s system snapshot create --name before-upgrade
+`,
+	RunE: createSnapshot,
+}
+
+func init() {
+	createCmd.Flags().StringP(nameFlagName, nameFlagShort, "", "Name of the snapshot (default: snapshot-<date>-<time>)")
+	createCmd.Flags().SortFlags = false
+	createCmd.Flags().PrintDefaults()
+}
+
+func createSnapshot(cmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(cmd.CommandPath())
+
+	showOutput, err := strconv.ParseBool(cmd.Flags().Lookup(common.OutputFlagName).Value.String())
+	if err != nil {
+		return err
+	}
+	name, err := cmd.Flags().GetString(nameFlagName)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		name = defaultSnapshotName(time.Now())
+	}
+
+	context := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	if err := ensureInstalled(context); err != nil {
+		return err
+	}
+
+	systemStatus, err := status.LoadStatus(context)
+	if err != nil {
+		return fmt.Errorf("could not determine system status: %w", err)
+	}
+	if !systemStatus.RunningState.IsRunning {
+		return common.CreateSystemNotRunningCmdFailure()
+	}
+
+	snapshot, err := context.Providers().System.SnapshotCreate(provider.SystemSnapshotConfig{
+		Name:       name,
+		ShowOutput: showOutput,
+	})
+	if err != nil {
+		return err
+	}
+
+	terminal.NewTerminalPrinter().PrintSuccess(fmt.Sprintf("Snapshot '%s' created", snapshot.Name))
+
+	cmdSession.Finish()
+
+	return nil
+}
+
+func defaultSnapshotName(now time.Time) string {
+	return "snapshot-" + now.Format("20060102-150405")
+}
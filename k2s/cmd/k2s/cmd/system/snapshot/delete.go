@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package snapshot
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/internal/provider"
+	"github.com/siemens-healthineers/k2s/internal/terminal"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a snapshot",
+	Example: `
+  # Delete the snapshot taken before an upgrade
+  k2s system snapshot delete --name before-upgrade
+`,
+	RunE: deleteSnapshot,
+}
+
+func init() {
+	deleteCmd.Flags().StringP(nameFlagName, nameFlagShort, "", "Name of the snapshot")
+	deleteCmd.MarkFlagRequired(nameFlagName)
+	deleteCmd.Flags().SortFlags = false
+	deleteCmd.Flags().PrintDefaults()
+}
+
+func deleteSnapshot(cmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(cmd.CommandPath())
+
+	showOutput, err := strconv.ParseBool(cmd.Flags().Lookup(common.OutputFlagName).Value.String())
+	if err != nil {
+		return err
+	}
+	name, err := cmd.Flags().GetString(nameFlagName)
+	if err != nil {
+		return err
+	}
+
+	context := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	if err := ensureInstalled(context); err != nil {
+		return err
+	}
+
+	if err := context.Providers().System.SnapshotDelete(provider.SystemSnapshotConfig{
+		Name:       name,
+		ShowOutput: showOutput,
+	}); err != nil {
+		return err
+	}
+
+	terminal.NewTerminalPrinter().PrintSuccess(fmt.Sprintf("Snapshot '%s' deleted", name))
+
+	cmdSession.Finish()
+
+	return nil
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package snapshot
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/internal/provider"
+	"github.com/siemens-healthineers/k2s/internal/terminal"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the snapshots of the cluster",
+	RunE:  listSnapshots,
+}
+
+func listSnapshots(cmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(cmd.CommandPath())
+
+	context := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	if err := ensureInstalled(context); err != nil {
+		return err
+	}
+
+	snapshots, err := context.Providers().System.SnapshotList()
+	if err != nil {
+		return err
+	}
+
+	printer := terminal.NewTerminalPrinter()
+	if len(snapshots) == 0 {
+		printer.PrintInfoln("No snapshots found")
+	} else {
+		printer.PrintHeader("K2s SNAPSHOTS")
+		printer.PrintTableWithHeaders(buildSnapshotsTable(snapshots))
+	}
+
+	cmdSession.Finish()
+
+	return nil
+}
+
+func buildSnapshotsTable(snapshots []provider.SystemSnapshot) [][]string {
+	table := [][]string{{"NAME", "CREATED", "CONTENTS"}}
+	for _, snapshot := range snapshots {
+		var contents []string
+		if snapshot.Etcd {
+			contents = append(contents, "etcd")
+		}
+		contents = append(contents, slices.Sorted(slices.Values(snapshot.VMs))...)
+
+		table = append(table, []string{snapshot.Name, snapshot.CreatedAt.Local().Format(time.DateTime), strings.Join(contents, ", ")})
+	}
+	return table
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package snapshot
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/internal/provider"
+	"github.com/siemens-healthineers/k2s/internal/terminal"
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Roll the cluster back to a snapshot",
+	Long: `Rolls the cluster back to a snapshot. The Windows worker VM, kubelet and CRI-O are stopped while the etcd database
+and the disk of the Windows worker VM are reset; the cluster is started afterwards.
+All changes to the cluster since the snapshot was created are lost, hence the restore asks for confirmation.`,
+	Example: `
+  # Roll the cluster back to the snapshot taken before an upgrade
+  k2s system snapshot restore --name before-upgrade
+
+  # Skip the confirmation prompt
+  k2s system snapshot restore --name before-upgrade --yes
+`,
+	RunE: restoreSnapshot,
+}
+
+func init() {
+	restoreCmd.Flags().StringP(nameFlagName, nameFlagShort, "", "Name of the snapshot")
+	restoreCmd.MarkFlagRequired(nameFlagName)
+	restoreCmd.Flags().BoolP(yesFlagName, yesFlagShort, false, "Skip the confirmation prompt")
+	restoreCmd.Flags().SortFlags = false
+	restoreCmd.Flags().PrintDefaults()
+}
+
+func restoreSnapshot(cmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(cmd.CommandPath())
+
+	showOutput, err := strconv.ParseBool(cmd.Flags().Lookup(common.OutputFlagName).Value.String())
+	if err != nil {
+		return err
+	}
+	name, err := cmd.Flags().GetString(nameFlagName)
+	if err != nil {
+		return err
+	}
+	yes, err := cmd.Flags().GetBool(yesFlagName)
+	if err != nil {
+		return err
+	}
+
+	context := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	if err := ensureInstalled(context); err != nil {
+		return err
+	}
+
+	restored, err := context.Providers().System.SnapshotRestore(provider.SystemSnapshotConfig{
+		Name:       name,
+		Yes:        yes,
+		ShowOutput: showOutput,
+	})
+	if err != nil {
+		return err
+	}
+
+	printer := terminal.NewTerminalPrinter()
+	if restored {
+		printer.PrintSuccess(fmt.Sprintf("Snapshot '%s' restored", name))
+	} else {
+		printer.PrintInfoln("Restore cancelled by user")
+	}
+
+	cmdSession.Finish()
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package snapshot
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	cconfig "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/config"
+)
+
+const (
+	nameFlagName  = "name"
+	nameFlagShort = "n"
+	yesFlagName   = "yes"
+	yesFlagShort  = "y"
+)
+
+var SnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Manage snapshots of the cluster for fast rollback (Linux hosts only)",
+	Long: `Manages snapshots of the cluster on native Linux hosts. A snapshot contains the etcd database of the control plane
+and the disk of the Windows worker VM, so that the cluster can be rolled back after risky changes like upgrades or addon experiments.`,
+}
+
+func init() {
+	SnapshotCmd.AddCommand(createCmd)
+	SnapshotCmd.AddCommand(listCmd)
+	SnapshotCmd.AddCommand(restoreCmd)
+	SnapshotCmd.AddCommand(deleteCmd)
+}
+
+func ensureInstalled(context *common.CmdContext) error {
+	_, err := config.ReadRuntimeConfig(context.Config().Host().K2sSetupConfigDir())
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, cconfig.ErrSystemInCorruptedState) {
+		return common.CreateSystemInCorruptedStateCmdFailure()
+	}
+	if errors.Is(err, cconfig.ErrSystemNotInstalled) {
+		return common.CreateSystemNotInstalledCmdFailure()
+	}
+	return err
+}
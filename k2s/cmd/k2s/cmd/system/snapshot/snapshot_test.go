@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package snapshot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/provider"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSnapshot(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "snapshot Unit Tests", Label("unit", "ci"))
+}
+
+var _ = Describe("snapshot", func() {
+	Describe("defaultSnapshotName", func() {
+		It("names the snapshot after the given time", func() {
+			now := time.Date(2026, 10, 18, 9, 5, 7, 0, time.Local)
+
+			Expect(defaultSnapshotName(now)).To(Equal("snapshot-20261018-090507"))
+		})
+	})
+
+	Describe("buildSnapshotsTable", func() {
+		It("lists name, local creation time and contents of the snapshots", func() {
+			created := time.Date(2026, 10, 18, 9, 5, 7, 0, time.Local)
+
+			table := buildSnapshotsTable([]provider.SystemSnapshot{
+				{Name: "before-upgrade", CreatedAt: created.UTC(), Etcd: true, VMs: []string{"k2s-win-worker"}},
+				{Name: "linux-only", CreatedAt: created.Add(time.Hour), Etcd: true},
+			})
+
+			Expect(table).To(Equal([][]string{
+				{"NAME", "CREATED", "CONTENTS"},
+				{"before-upgrade", "2026-10-18 09:05:07", "etcd, k2s-win-worker"},
+				{"linux-only", "2026-10-18 10:05:07", "etcd"},
+			}))
+		})
+	})
+
+	Describe("commands", func() {
+		It("require the snapshot name for restore and delete", func() {
+			for _, cmd := range []string{"restore", "delete"} {
+				subCmd, _, err := SnapshotCmd.Find([]string{cmd})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(subCmd.Flags().Lookup(nameFlagName).Annotations).To(HaveKey("cobra_annotation_bash_completion_one_required_flag"), cmd)
+			}
+		})
+
+		It("offer to skip the confirmation of restore", func() {
+			subCmd, _, err := SnapshotCmd.Find([]string{"restore"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(subCmd.Flags().Lookup(yesFlagName)).ToNot(BeNil())
+			Expect(subCmd.Flags().ShorthandLookup(yesFlagShort)).ToNot(BeNil())
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/system/proxy"
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/system/reset"
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/system/restore"
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/system/snapshot"
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/system/upgrade"
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/system/users"
 	"github.com/spf13/cobra"
@@ -33,4 +34,5 @@ func init() {
 	SystemCmd.AddCommand(backup.SystemBackupCmd)
 	SystemCmd.AddCommand(restore.SystemRestoreCmd)
 	SystemCmd.AddCommand(compact.CompactCmd)
+	SystemCmd.AddCommand(snapshot.SnapshotCmd)
 }
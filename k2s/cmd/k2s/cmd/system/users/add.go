@@ -6,36 +6,61 @@ package users
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/status"
 	config_contract "github.com/siemens-healthineers/k2s/internal/contracts/config"
 	users_contract "github.com/siemens-healthineers/k2s/internal/contracts/users"
 	"github.com/siemens-healthineers/k2s/internal/core/config"
+	kstrings "github.com/siemens-healthineers/k2s/internal/primitives/strings"
 	"github.com/siemens-healthineers/k2s/internal/users"
 	"github.com/spf13/cobra"
 )
 
 type UsersManagement interface {
-	AddUserByName(name string) error
-	AddUserById(id string) error
+	AddUserByName(name string, access users_contract.Access, certValidity time.Duration) error
+	AddUserById(id string, access users_contract.Access, certValidity time.Duration) error
 }
 
 const (
-	userNameFlag = "username"
-	userIdFlag   = "id"
+	userNameFlag  = "username"
+	userIdFlag    = "id"
+	roleFlag      = "role"
+	namespaceFlag = "namespace"
+	validityFlag  = "validity"
 )
 
 func newAddCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add",
 		Short: "Grants a Windows user access to K2s (host only)",
-		RunE:  run,
+		Long: `Grants a Windows user access to K2s (host only).
+Without a role, the user is authenticated by Kubernetes, but has no permissions until bound manually. With a role, the role's permissions are bound to the group of the user's current certificate:
+  viewer     read-only access ('view')
+  developer  read/write access to workloads, no access to RBAC and namespaces ('edit')
+  admin      full access ('cluster-admin', or 'admin' when limited to a namespace)`,
+		Example: `
+  # Grant a user read-only access to the whole cluster
+  k2s system users add -u johndoe --role viewer
+
+  # Grant a user read/write access to the namespace 'team-a' only
+  k2s system users add -u johndoe --role developer --namespace team-a
+
+  # Grant a user read-only access with a client certificate valid for 90 days
+  k2s system users add -u johndoe --role viewer --validity 90d
+`,
+		RunE: run,
 	}
 
 	cmd.Flags().StringP(userNameFlag, "u", "", "Windows user name, e.g. 'johndoe' or 'johnsdomain\\johndoe'")
 	cmd.Flags().StringP(userIdFlag, "i", "", "Windows user id, e.g. 'S-1-2-34-567898765-4321234567-8987654321-234567'")
 
+	cmd.Flags().String(roleFlag, "", "Role granted to the user, one of: "+strings.Join(users_contract.RoleNames(), ", "))
+	cmd.Flags().StringP(namespaceFlag, "n", "", "Namespace the role is limited to; the role applies cluster-wide if not set")
+	cmd.Flags().String(validityFlag, "", "Validity of the user's client certificate, e.g. '90d' or '12h' (default 365d)")
+
 	cmd.MarkFlagsMutuallyExclusive(userNameFlag, userIdFlag)
 	cmd.MarkFlagsOneRequired(userNameFlag, userIdFlag)
 
@@ -58,6 +83,16 @@ func run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	access, err := parseAccess(cmd)
+	if err != nil {
+		return err
+	}
+
+	certValidity, err := parseCertValidity(cmd)
+	if err != nil {
+		return err
+	}
+
 	ctx := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
 	k2sConfig := ctx.Config()
 
@@ -78,9 +113,9 @@ func run(cmd *cobra.Command, args []string) error {
 	addUserIntegration := users.NewAddUserIntegration(k2sConfig, runtimeConfig, users.PlatformUsersProvider(), users.PlatformACLProvider())
 
 	if userName != "" {
-		err = addUserIntegration.AddByName(userName)
+		err = addUserIntegration.AddByName(userName, access, certValidity)
 	} else {
-		err = addUserIntegration.AddById(userId)
+		err = addUserIntegration.AddById(userId, access, certValidity)
 	}
 	if err != nil {
 		if userNotFoundErr, ok := errors.AsType[users_contract.ErrUserNotFound](err); ok {
@@ -94,6 +129,43 @@ func run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func parseAccess(cmd *cobra.Command) (users_contract.Access, error) {
+	roleValue, err := cmd.Flags().GetString(roleFlag)
+	if err != nil {
+		return users_contract.Access{}, err
+	}
+
+	role, err := users_contract.ParseRole(roleValue)
+	if err != nil {
+		return users_contract.Access{}, err
+	}
+
+	namespace, err := cmd.Flags().GetString(namespaceFlag)
+	if err != nil {
+		return users_contract.Access{}, err
+	}
+
+	if namespace != "" && role == users_contract.RoleNone {
+		return users_contract.Access{}, fmt.Errorf("flag '%s' requires flag '%s'", namespaceFlag, roleFlag)
+	}
+
+	return users_contract.Access{Role: role, Namespace: namespace}, nil
+}
+
+// parseCertValidity returns the requested validity of the user's client certificate; 0 means the default validity.
+func parseCertValidity(cmd *cobra.Command) (time.Duration, error) {
+	validity, err := cmd.Flags().GetString(validityFlag)
+	if err != nil || validity == "" {
+		return 0, err
+	}
+
+	certValidity, err := kstrings.ParseAge(validity)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for flag '%s': %w", validityFlag, err)
+	}
+	return certValidity, nil
+}
+
 func loadSetupConfig(configDir string) (*config_contract.K2sRuntimeConfig, error) {
 	setupConfig, err := config.ReadRuntimeConfig(configDir)
 	if err == nil {
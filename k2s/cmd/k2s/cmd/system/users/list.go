@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package users
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
+	"github.com/siemens-healthineers/k2s/internal/terminal"
+	"github.com/siemens-healthineers/k2s/internal/users"
+	"github.com/spf13/cobra"
+)
+
+var (
+	usersTableHeaders   = []string{"OS User", "K2s User", "Role", "Cert Expiry", "SSH Key Fingerprint"}
+	revokedTableHeaders = []string{"K2s User", "Cert Serial", "Cert Expiry", "Revoked At"}
+)
+
+func newListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Lists the Windows users granted access to K2s (host only)",
+		RunE:  runList,
+	}
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(cmd.CommandPath())
+
+	ctx := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	k2sConfig := ctx.Config()
+
+	if _, err := loadSetupConfig(k2sConfig.Host().K2sSetupConfigDir()); err != nil {
+		return err
+	}
+
+	registeredUsers, err := users.ListUsers(k2sConfig)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+
+	printer := terminal.NewTerminalPrinter()
+	now := time.Now()
+
+	if len(registeredUsers.Users) == 0 {
+		printer.PrintInfoln("No users added to K2s")
+	} else {
+		printer.PrintHeader("K2s Users")
+		printer.PrintTableWithHeaders(buildUsersTable(registeredUsers.Users, now))
+	}
+
+	if len(registeredUsers.Revoked) > 0 {
+		printer.PrintHeader("Revoked Certificates")
+		printer.PrintTableWithHeaders(buildRevokedTable(registeredUsers.Revoked))
+	}
+
+	cmdSession.Finish()
+
+	return nil
+}
+
+func buildUsersTable(entries []registry.UserEntry, now time.Time) [][]string {
+	table := [][]string{usersTableHeaders}
+	for _, entry := range entries {
+		table = append(table, []string{entry.OSUserName, entry.K2sUserName, entry.Access().String(), formatExpiry(entry.CertExpiry, now), entry.SSHKeyFingerprint})
+	}
+	return table
+}
+
+func buildRevokedTable(revoked []registry.RevokedCert) [][]string {
+	table := [][]string{revokedTableHeaders}
+	for _, cert := range revoked {
+		table = append(table, []string{cert.K2sUserName, cert.Serial, formatDate(cert.Expiry), formatDate(cert.RevokedAt)})
+	}
+	return table
+}
+
+func formatExpiry(expiry time.Time, now time.Time) string {
+	if expiry.IsZero() {
+		return "-"
+	}
+	if !expiry.After(now) {
+		return formatDate(expiry) + " (expired)"
+	}
+	return fmt.Sprintf("%s (%d days)", formatDate(expiry), int(expiry.Sub(now).Hours()/24))
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format(time.DateOnly)
+}
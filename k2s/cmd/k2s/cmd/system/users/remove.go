@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package users
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/status"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
+	"github.com/siemens-healthineers/k2s/internal/terminal"
+	"github.com/siemens-healthineers/k2s/internal/users"
+	"github.com/spf13/cobra"
+)
+
+func newRemoveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Revokes the K2s access of a Windows user (host only)",
+		Long: `Revokes the K2s access of a Windows user:
+- removes the user's public SSH key from the control-plane and deletes the user's K2s SSH key pair
+- removes the user from all Kubernetes RBAC bindings, making the user's client certificate unusable
+- deletes the user's context and credentials from the user's kubeconfig
+- records the serial of the user's client certificate as revoked`,
+		RunE: runRemove,
+	}
+
+	cmd.Flags().StringP(userNameFlag, "u", "", "Windows user name or K2s user name, e.g. 'johndoe' or 'k2s-johnsdomain-johndoe'")
+	cmd.Flags().StringP(userIdFlag, "i", "", "Windows user id, e.g. 'S-1-2-34-567898765-4321234567-8987654321-234567'")
+
+	cmd.MarkFlagsMutuallyExclusive(userNameFlag, userIdFlag)
+	cmd.MarkFlagsOneRequired(userNameFlag, userIdFlag)
+
+	cmd.Flags().SortFlags = false
+	cmd.Flags().PrintDefaults()
+
+	return cmd
+}
+
+func runRemove(cmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(cmd.CommandPath())
+
+	userName, err := cmd.Flags().GetString(userNameFlag)
+	if err != nil {
+		return err
+	}
+
+	userId, err := cmd.Flags().GetString(userIdFlag)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	k2sConfig := ctx.Config()
+
+	runtimeConfig, err := loadSetupConfig(k2sConfig.Host().K2sSetupConfigDir())
+	if err != nil {
+		return err
+	}
+
+	systemStatus, err := status.LoadStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("could not determine system status: %w", err)
+	}
+
+	if !systemStatus.RunningState.IsRunning {
+		return common.CreateSystemNotRunningCmdFailure()
+	}
+
+	nameOrId := userName
+	if nameOrId == "" {
+		nameOrId = userId
+	}
+
+	removeUserIntegration := users.NewRemoveUserIntegration(k2sConfig, runtimeConfig, users.PlatformUsersProvider(), users.PlatformACLProvider())
+
+	removedUser, err := removeUserIntegration.Remove(nameOrId)
+	if err != nil {
+		if errors.Is(err, registry.ErrUserNotRegistered) {
+			return newUserNotFoundFailure(err)
+		}
+		return fmt.Errorf("failed to remove user: %w", err)
+	}
+
+	terminal.NewTerminalPrinter().PrintSuccess(fmt.Sprintf("Access of user '%s' (%s) revoked, certificate serial '%s' recorded as revoked", removedUser.OSUserName, removedUser.K2sUserName, removedUser.CertSerial))
+
+	cmdSession.Finish()
+
+	return nil
+}
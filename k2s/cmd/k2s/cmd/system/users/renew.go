@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package users
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/status"
+	core_users "github.com/siemens-healthineers/k2s/internal/core/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
+	kstrings "github.com/siemens-healthineers/k2s/internal/primitives/strings"
+	"github.com/siemens-healthineers/k2s/internal/terminal"
+	"github.com/siemens-healthineers/k2s/internal/users"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	allFlag    = "all"
+	withinFlag = "within"
+)
+
+var renewedTableHeaders = []string{"OS User", "K2s User", "Cert Serial", "Cert Expiry"}
+
+func newRenewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew",
+		Short: "Renews the client certificates of K2s users (host only)",
+		Long: `Re-issues the client certificates of K2s users with the users' roles and updates the users' kubeconfig credentials.
+Existing contexts are kept. The previous certificates are recorded as revoked.`,
+		Example: `
+  # Renew the certificate of a single user
+  k2s system users renew -u johndoe
+
+  # Renew all certificates expiring within the next 30 days
+  k2s system users renew --all --within 30d
+`,
+		RunE: runRenew,
+	}
+
+	cmd.Flags().Bool(allFlag, false, "Renew the certificates of all users")
+	cmd.Flags().StringP(userNameFlag, "u", "", "Windows user name or K2s user name, e.g. 'johndoe' or 'k2s-johnsdomain-johndoe'")
+	cmd.Flags().String(withinFlag, "", "Renew only certificates expiring within the given period, e.g. '30d'")
+	cmd.Flags().String(validityFlag, "", "Validity of the renewed certificates, e.g. '90d' or '12h' (default 365d)")
+
+	cmd.MarkFlagsMutuallyExclusive(allFlag, userNameFlag)
+	cmd.MarkFlagsOneRequired(allFlag, userNameFlag)
+
+	cmd.Flags().SortFlags = false
+	cmd.Flags().PrintDefaults()
+
+	return cmd
+}
+
+func runRenew(cmd *cobra.Command, args []string) error {
+	cmdSession := common.StartCmdSession(cmd.CommandPath())
+
+	options, err := parseRenewOptions(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context().Value(common.ContextKeyCmdContext).(*common.CmdContext)
+	k2sConfig := ctx.Config()
+
+	runtimeConfig, err := loadSetupConfig(k2sConfig.Host().K2sSetupConfigDir())
+	if err != nil {
+		return err
+	}
+
+	systemStatus, err := status.LoadStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("could not determine system status: %w", err)
+	}
+
+	if !systemStatus.RunningState.IsRunning {
+		return common.CreateSystemNotRunningCmdFailure()
+	}
+
+	renewUserIntegration := users.NewRenewUserIntegration(k2sConfig, runtimeConfig, users.PlatformACLProvider())
+
+	renewed, renewErr := renewUserIntegration.Renew(options)
+	if errors.Is(renewErr, registry.ErrUserNotRegistered) {
+		return newUserNotFoundFailure(renewErr)
+	}
+
+	printer := terminal.NewTerminalPrinter()
+
+	if len(renewed) == 0 {
+		printer.PrintInfoln("No user certificates renewed")
+	} else {
+		printer.PrintHeader("Renewed Certificates")
+		printer.PrintTableWithHeaders(buildRenewedTable(renewed, time.Now()))
+	}
+
+	if renewErr != nil {
+		return fmt.Errorf("failed to renew user certificates: %w", renewErr)
+	}
+
+	cmdSession.Finish()
+
+	return nil
+}
+
+func parseRenewOptions(flags *pflag.FlagSet) (core_users.RenewOptions, error) {
+	var options core_users.RenewOptions
+
+	userName, err := flags.GetString(userNameFlag)
+	if err != nil {
+		return options, err
+	}
+	options.NameOrId = userName
+
+	within, err := flags.GetString(withinFlag)
+	if err != nil {
+		return options, err
+	}
+	if within != "" {
+		if options.Within, err = kstrings.ParseAge(within); err != nil {
+			return options, fmt.Errorf("invalid value for flag '%s': %w", withinFlag, err)
+		}
+	}
+
+	validity, err := flags.GetString(validityFlag)
+	if err != nil {
+		return options, err
+	}
+	if validity != "" {
+		if options.CertValidity, err = kstrings.ParseAge(validity); err != nil {
+			return options, fmt.Errorf("invalid value for flag '%s': %w", validityFlag, err)
+		}
+	}
+	return options, nil
+}
+
+func buildRenewedTable(entries []registry.UserEntry, now time.Time) [][]string {
+	table := [][]string{renewedTableHeaders}
+	for _, entry := range entries {
+		table = append(table, []string{entry.OSUserName, entry.K2sUserName, entry.CertSerial, formatExpiry(entry.CertExpiry, now)})
+	}
+	return table
+}
@@ -14,6 +14,9 @@ func NewCommand() *cobra.Command {
 	}
 
 	cmd.AddCommand(newAddCommand())
+	cmd.AddCommand(newListCommand())
+	cmd.AddCommand(newRemoveCommand())
+	cmd.AddCommand(newRenewCommand())
 
 	return cmd
 }
@@ -7,8 +7,12 @@ import (
 	"errors"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/cmd/common"
+	users_contract "github.com/siemens-healthineers/k2s/internal/contracts/users"
+	core_users "github.com/siemens-healthineers/k2s/internal/core/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -38,4 +42,112 @@ var _ = Describe("users pkg", func() {
 			})
 		})
 	})
+
+	Describe("list cmd", func() {
+		now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.Local)
+
+		Describe("buildUsersTable", func() {
+			It("shows users with certificate expiry and SSH key fingerprint", func() {
+				entries := []registry.UserEntry{
+					{OSUserName: "john", K2sUserName: "k2s-john", Role: users_contract.RoleDeveloper, Namespace: "team", CertExpiry: now.AddDate(0, 0, 30), SSHKeyFingerprint: "SHA256:abc"},
+					{OSUserName: "jane", K2sUserName: "k2s-jane", CertExpiry: now.AddDate(0, 0, -1)},
+				}
+
+				table := buildUsersTable(entries, now)
+
+				Expect(table).To(Equal([][]string{
+					usersTableHeaders,
+					{"john", "k2s-john", "developer (namespace 'team')", "2026-07-01 (30 days)", "SHA256:abc"},
+					{"jane", "k2s-jane", "-", "2026-05-31 (expired)", ""},
+				}))
+			})
+		})
+	})
+
+	Describe("parseAccess", func() {
+		DescribeTable("returns the requested access", func(args []string, expected users_contract.Access) {
+			cmd := newAddCommand()
+			Expect(cmd.ParseFlags(args)).To(Succeed())
+
+			access, err := parseAccess(cmd)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(access).To(Equal(expected))
+		},
+			Entry("no role", []string{}, users_contract.Access{}),
+			Entry("cluster-wide role", []string{"--role", "Viewer"}, users_contract.Access{Role: users_contract.RoleViewer}),
+			Entry("namespaced role", []string{"--role", "developer", "-n", "team"}, users_contract.Access{Role: users_contract.RoleDeveloper, Namespace: "team"}),
+		)
+
+		It("returns an error on unknown roles", func() {
+			cmd := newAddCommand()
+			Expect(cmd.ParseFlags([]string{"--role", "root"})).To(Succeed())
+
+			_, err := parseAccess(cmd)
+
+			Expect(err).To(MatchError(ContainSubstring("invalid role 'root', supported roles: viewer, developer, admin")))
+		})
+
+		It("returns an error if a namespace is given without role", func() {
+			cmd := newAddCommand()
+			Expect(cmd.ParseFlags([]string{"-n", "team"})).To(Succeed())
+
+			_, err := parseAccess(cmd)
+
+			Expect(err).To(MatchError(ContainSubstring("flag 'namespace' requires flag 'role'")))
+		})
+	})
+
+	Describe("parseCertValidity", func() {
+		It("returns 0 for the default validity", func() {
+			cmd := newAddCommand()
+
+			validity, err := parseCertValidity(cmd)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validity).To(BeZero())
+		})
+
+		It("returns the requested validity", func() {
+			cmd := newAddCommand()
+			Expect(cmd.ParseFlags([]string{"--validity", "90d"})).To(Succeed())
+
+			validity, err := parseCertValidity(cmd)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validity).To(Equal(90 * 24 * time.Hour))
+		})
+
+		It("returns an error on invalid certificate validity", func() {
+			cmd := newAddCommand()
+			Expect(cmd.ParseFlags([]string{"--validity", "a year"})).To(Succeed())
+
+			_, err := parseCertValidity(cmd)
+
+			Expect(err).To(MatchError(ContainSubstring("invalid value for flag 'validity'")))
+		})
+	})
+
+	Describe("renew cmd", func() {
+		Describe("parseRenewOptions", func() {
+			It("parses the user selection, period and validity", func() {
+				cmd := newRenewCommand()
+				Expect(cmd.ParseFlags([]string{"-u", "john", "--within", "30d", "--validity", "90d"})).To(Succeed())
+
+				options, err := parseRenewOptions(cmd.Flags())
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(options).To(Equal(core_users.RenewOptions{NameOrId: "john", Within: 30 * 24 * time.Hour, CertValidity: 90 * 24 * time.Hour}))
+			})
+
+			It("returns an error on invalid period", func() {
+				cmd := newRenewCommand()
+				Expect(cmd.ParseFlags([]string{"--all", "--within", "soon"})).To(Succeed())
+
+				_, err := parseRenewOptions(cmd.Flags())
+
+				Expect(err).To(MatchError(ContainSubstring("invalid value for flag 'within'")))
+			})
+		})
+	})
 })
@@ -51,6 +51,9 @@ func main() {
 	exitCode = cli.ExitCodeFailure
 
 	if cmdFailure, ok := errors.AsType[*common.CmdFailure](err); ok {
+		if cmdFailure.ExitCode != cli.ExitCodeSuccess {
+			exitCode = cmdFailure.ExitCode
+		}
 		handleCmdFailure(cmdFailure.Severity, cmdFailure.Code, cmdFailure.Message, cmdFailure.SuppressCliOutput)
 		return
 	}
@@ -25,6 +25,7 @@ type K2sClusterConfig struct {
 	registries         []Registry
 	controlPlaneConfig *K2sControlPlaneConfig
 	enabledAddons      []Addon
+	windowsWorker      *K2sWindowsWorkerConfig
 }
 
 type K2sInstallConfig struct {
@@ -39,6 +40,13 @@ type K2sControlPlaneConfig struct {
 	hostname string
 }
 
+// K2sWindowsWorkerConfig describes the Windows worker VM of a native Linux host installation.
+type K2sWindowsWorkerConfig struct {
+	nodeName  string
+	vmName    string
+	ipAddress string
+}
+
 var (
 	ErrSystemNotInstalled     = errors.New("system-not-installed")
 	ErrSystemInCorruptedState = errors.New("system-in-corrupted-state")
@@ -52,12 +60,13 @@ func NewK2sRuntimeConfig(clusterConfig *K2sClusterConfig, installConfig *K2sInst
 	}
 }
 
-func NewK2sClusterConfig(name string, registries []Registry, controlPlaneConfig *K2sControlPlaneConfig, enabledAddons []Addon) *K2sClusterConfig {
+func NewK2sClusterConfig(name string, registries []Registry, controlPlaneConfig *K2sControlPlaneConfig, enabledAddons []Addon, windowsWorker *K2sWindowsWorkerConfig) *K2sClusterConfig {
 	return &K2sClusterConfig{
 		name:               name,
 		registries:         registries,
 		controlPlaneConfig: controlPlaneConfig,
 		enabledAddons:      enabledAddons,
+		windowsWorker:      windowsWorker,
 	}
 }
 
@@ -77,6 +86,14 @@ func NewK2sControlPlaneConfig(hostname string) *K2sControlPlaneConfig {
 	}
 }
 
+func NewK2sWindowsWorkerConfig(nodeName, vmName, ipAddress string) *K2sWindowsWorkerConfig {
+	return &K2sWindowsWorkerConfig{
+		nodeName:  nodeName,
+		vmName:    vmName,
+		ipAddress: ipAddress,
+	}
+}
+
 func (c *K2sRuntimeConfig) ClusterConfig() *K2sClusterConfig {
 	return c.clusterConfig
 }
@@ -105,6 +122,11 @@ func (c *K2sClusterConfig) EnabledAddons() []Addon {
 	return c.enabledAddons
 }
 
+// WindowsWorker returns the Windows worker VM of a native Linux host installation, nil if there is none.
+func (c *K2sClusterConfig) WindowsWorker() *K2sWindowsWorkerConfig {
+	return c.windowsWorker
+}
+
 func (c *K2sInstallConfig) SetupName() string {
 	return c.setupName
 }
@@ -128,3 +150,15 @@ func (c *K2sInstallConfig) WslEnabled() bool {
 func (c *K2sControlPlaneConfig) Hostname() string {
 	return c.hostname
 }
+
+func (c *K2sWindowsWorkerConfig) NodeName() string {
+	return c.nodeName
+}
+
+func (c *K2sWindowsWorkerConfig) VMName() string {
+	return c.vmName
+}
+
+func (c *K2sWindowsWorkerConfig) IpAddress() string {
+	return c.ipAddress
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package users
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Role is a predefined set of Kubernetes permissions granted to a K2s user.
+type Role string
+
+// Access is the role of a K2s user, either cluster-wide or limited to a namespace.
+type Access struct {
+	Role      Role
+	Namespace string
+}
+
+const (
+	// RoleNone grants no Kubernetes permissions; permissions must be bound manually
+	RoleNone      Role = ""
+	RoleViewer    Role = "viewer"
+	RoleDeveloper Role = "developer"
+	RoleAdmin     Role = "admin"
+)
+
+var roles = []Role{RoleViewer, RoleDeveloper, RoleAdmin}
+
+// Roles returns the predefined roles.
+func Roles() []Role {
+	return slices.Clone(roles)
+}
+
+func ParseRole(value string) (Role, error) {
+	if value == "" {
+		return RoleNone, nil
+	}
+
+	role := Role(strings.ToLower(value))
+	if !slices.Contains(roles, role) {
+		return RoleNone, fmt.Errorf("invalid role '%s', supported roles: %s", value, strings.Join(RoleNames(), ", "))
+	}
+	return role, nil
+}
+
+// RoleNames returns the names of the predefined roles.
+func RoleNames() []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, string(role))
+	}
+	return names
+}
+
+func (a Access) String() string {
+	if a.Role == RoleNone {
+		return "-"
+	}
+	if a.Namespace == "" {
+		return string(a.Role)
+	}
+	return fmt.Sprintf("%s (namespace '%s')", a.Role, a.Namespace)
+}
@@ -72,11 +72,45 @@ type Implementation struct {
 	ExportDirectoryName string
 	Commands            *map[string]AddonCmd `yaml:"commands"`
 	OfflineUsage        OfflineUsage         `yaml:"offline_usage"`
+	Namespaces          []string             `yaml:"namespaces"`
+	Checks              []HealthCheck        `yaml:"checks"`
+}
+
+// HealthCheck is run by 'k2s doctor' while the implementation is enabled.
+type HealthCheck struct {
+	ID          string           `yaml:"id"`
+	Description string           `yaml:"description"`
+	Severity    string           `yaml:"severity"`
+	Remediation string           `yaml:"remediation"`
+	Pods        *PodsHealthCheck `yaml:"pods"`
+}
+
+// PodsHealthCheck checks all pods matching the label selector to be running and ready.
+type PodsHealthCheck struct {
+	Namespace string `yaml:"namespace"`
+	Selector  string `yaml:"selector"`
 }
 
 type AddonCmd struct {
-	Cli    *CliConfig   `yaml:"cli"`
-	Script ScriptConfig `yaml:"script"`
+	Cli    *CliConfig    `yaml:"cli"`
+	Script ScriptConfig  `yaml:"script"`
+	DryRun *DryRunConfig `yaml:"dryRun"`
+}
+
+// DryRunConfig declares the manifests a command applies (or deletes), so that
+// they can be rendered and diffed against the live cluster without running the
+// command's script.
+type DryRunConfig struct {
+	Manifests []DryRunManifest `yaml:"manifests"`
+}
+
+// DryRunManifest is a single manifest source of a command. Path is relative to
+// the addon directory and may point to a kustomization directory, a directory
+// of YAML files or a single YAML file. When maps CLI flag names to values; the
+// manifest is only selected if all listed flags have the given values.
+type DryRunManifest struct {
+	Path string            `yaml:"path"`
+	When map[string]string `yaml:"when"`
 }
 
 type CliConfig struct {
@@ -197,6 +231,31 @@ func (example CliExample) String() string {
 	return comment + fmt.Sprintf("  %s\n", example.Cmd)
 }
 
+// SelectManifests returns the manifest paths matching the given flag values.
+// flagValue must return the effective (i.e. default or user-provided) value of
+// a CLI flag; unknown flags never match.
+func (c *DryRunConfig) SelectManifests(flagValue func(name string) (string, bool)) []string {
+	if c == nil {
+		return nil
+	}
+
+	var paths []string
+	for _, manifest := range c.Manifests {
+		matches := true
+		for flagName, expected := range manifest.When {
+			actual, found := flagValue(flagName)
+			if !found || actual != expected {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			paths = append(paths, manifest.Path)
+		}
+	}
+	return paths
+}
+
 func (c *Constraints) String() (string, error) {
 	if c == nil {
 		return "", nil
@@ -444,6 +444,41 @@ var _ = Describe("addons", func() {
 		})
 	})
 
+	Describe("DryRunConfig", func() {
+		Describe("SelectManifests", func() {
+			flagValues := map[string]string{"omitGrafana": "true", "ingress": "none"}
+			flagValue := func(name string) (string, bool) {
+				value, found := flagValues[name]
+				return value, found
+			}
+
+			When("dry-run config is nil", func() {
+				It("returns no paths", func() {
+					var input *DryRunConfig
+
+					result := input.SelectManifests(flagValue)
+
+					Expect(result).To(BeEmpty())
+				})
+			})
+
+			When("manifests have conditions", func() {
+				It("returns unconditional and matching paths in declaration order", func() {
+					input := &DryRunConfig{Manifests: []DryRunManifest{
+						{Path: "manifests/namespace.yaml"},
+						{Path: "manifests/monitoring", When: map[string]string{"omitGrafana": "false"}},
+						{Path: "manifests/monitoring-no-grafana", When: map[string]string{"omitGrafana": "true", "ingress": "none"}},
+						{Path: "manifests/unknown", When: map[string]string{"unknown": "true"}},
+					}}
+
+					result := input.SelectManifests(flagValue)
+
+					Expect(result).To(Equal([]string{"manifests/namespace.yaml", "manifests/monitoring-no-grafana"}))
+				})
+			})
+		})
+	})
+
 	Describe("loadAndValidate", func() {
 		When("walkDir returns an error", func() {
 			It("returns this error", func() {
@@ -0,0 +1,414 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+// Package health computes the health of an addon from the objects declared in
+// its manifests and their live counterparts in the cluster. It is platform-agnostic,
+// so that all addon providers report the same, stable status schema.
+package health
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type State string
+
+const (
+	Healthy     State = "Healthy"
+	Progressing State = "Progressing"
+	Degraded    State = "Degraded"
+	Failed      State = "Failed"
+)
+
+// Report is the health of a single addon. Its JSON representation is part of the
+// 'k2s addons status -o json' output and must only be extended, never changed.
+type Report struct {
+	State      State       `json:"state"`
+	Components []Component `json:"components"`
+	Ingresses  []Ingress   `json:"ingresses,omitempty"`
+}
+
+// Component is the health of a single workload (Deployment, DaemonSet or StatefulSet).
+type Component struct {
+	Kind            string           `json:"kind"`
+	Name            string           `json:"name"`
+	Namespace       string           `json:"namespace"`
+	State           State            `json:"state"`
+	DesiredReplicas int              `json:"desiredReplicas"`
+	ReadyReplicas   int              `json:"readyReplicas"`
+	UpdatedReplicas int              `json:"updatedReplicas"`
+	ImagePullErrors []ImagePullError `json:"imagePullErrors,omitempty"`
+	Message         string           `json:"message,omitempty"`
+}
+
+// ImagePullError describes a container of a component's Pod which cannot pull its image.
+type ImagePullError struct {
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+	Image     string `json:"image"`
+	Reason    string `json:"reason"`
+	Message   string `json:"message,omitempty"`
+}
+
+// Ingress is the reachability of a single Ingress declared by the addon.
+type Ingress struct {
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	Hosts     []string `json:"hosts,omitempty"`
+	Address   string   `json:"address,omitempty"`
+	Reachable bool     `json:"reachable"`
+	Message   string   `json:"message,omitempty"`
+}
+
+// ManifestObject references an object declared in the addon's manifests. Optional
+// objects stem from manifest variants selected by CLI flags and are only evaluated
+// if they exist in the cluster.
+type ManifestObject struct {
+	Kind      string
+	Name      string
+	Namespace string
+	Optional  bool
+}
+
+// ClusterObject is the subset of a live object (as returned by 'kubectl get -o json')
+// needed to evaluate Deployments, DaemonSets, StatefulSets, Ingresses and Pods.
+type ClusterObject struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name       string            `json:"name"`
+		Namespace  string            `json:"namespace"`
+		Generation int64             `json:"generation"`
+		Labels     map[string]string `json:"labels"`
+	} `json:"metadata"`
+	Spec struct {
+		Replicas *int `json:"replicas"`
+		Selector *struct {
+			MatchLabels map[string]string `json:"matchLabels"`
+		} `json:"selector"`
+		Rules []struct {
+			Host string `json:"host"`
+		} `json:"rules"`
+	} `json:"spec"`
+	Status struct {
+		ObservedGeneration     int64 `json:"observedGeneration"`
+		ReadyReplicas          int   `json:"readyReplicas"`
+		UpdatedReplicas        int   `json:"updatedReplicas"`
+		DesiredNumberScheduled int   `json:"desiredNumberScheduled"`
+		NumberReady            int   `json:"numberReady"`
+		UpdatedNumberScheduled int   `json:"updatedNumberScheduled"`
+		Conditions             []struct {
+			Type    string `json:"type"`
+			Status  string `json:"status"`
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"conditions"`
+		LoadBalancer struct {
+			Ingress []struct {
+				IP       string `json:"ip"`
+				Hostname string `json:"hostname"`
+			} `json:"ingress"`
+		} `json:"loadBalancer"`
+		ContainerStatuses     []containerStatus `json:"containerStatuses"`
+		InitContainerStatuses []containerStatus `json:"initContainerStatuses"`
+	} `json:"status"`
+}
+
+// ClusterObjectList is the result of 'kubectl get <kinds> -o json'.
+type ClusterObjectList struct {
+	Items []ClusterObject `json:"items"`
+}
+
+// IngressProbe checks whether an Ingress is reachable at the given address.
+type IngressProbe func(host, address string) error
+
+type containerStatus struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+	State struct {
+		Waiting *struct {
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"waiting"`
+	} `json:"state"`
+}
+
+const defaultNamespace = "default"
+
+var (
+	workloadKinds        = []string{"Deployment", "DaemonSet", "StatefulSet"}
+	imagePullErrorReason = []string{"ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull"}
+)
+
+// ParseManifestObjects extracts the workloads and Ingresses from rendered multi-document YAML.
+func ParseManifestObjects(rendered []byte, optional bool) ([]ManifestObject, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(rendered))
+
+	var objects []ManifestObject
+	for {
+		var doc struct {
+			Kind     string `yaml:"kind"`
+			Metadata struct {
+				Name      string `yaml:"name"`
+				Namespace string `yaml:"namespace"`
+			} `yaml:"metadata"`
+		}
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse manifests: %w", err)
+		}
+
+		if !slices.Contains(workloadKinds, doc.Kind) && doc.Kind != "Ingress" {
+			continue
+		}
+
+		namespace := doc.Metadata.Namespace
+		if namespace == "" {
+			namespace = defaultNamespace
+		}
+
+		objects = append(objects, ManifestObject{
+			Kind:      doc.Kind,
+			Name:      doc.Metadata.Name,
+			Namespace: namespace,
+			Optional:  optional,
+		})
+	}
+}
+
+// Merge combines manifest objects of several sources; an object is only optional
+// if every source declaring it is optional.
+func Merge(objects []ManifestObject) []ManifestObject {
+	var merged []ManifestObject
+	for _, object := range objects {
+		index := slices.IndexFunc(merged, func(m ManifestObject) bool {
+			return m.Kind == object.Kind && m.Name == object.Name && m.Namespace == object.Namespace
+		})
+		if index < 0 {
+			merged = append(merged, object)
+			continue
+		}
+		merged[index].Optional = merged[index].Optional && object.Optional
+	}
+	return merged
+}
+
+// Namespaces returns the distinct namespaces of the given manifest objects.
+func Namespaces(objects []ManifestObject) []string {
+	var namespaces []string
+	for _, object := range objects {
+		if !slices.Contains(namespaces, object.Namespace) {
+			namespaces = append(namespaces, object.Namespace)
+		}
+	}
+	return namespaces
+}
+
+// Evaluate computes the addon health from the declared objects and the live cluster objects.
+func Evaluate(declared []ManifestObject, live []ClusterObject, probe IngressProbe) *Report {
+	report := &Report{Components: []Component{}}
+
+	for _, object := range declared {
+		liveObject, found := findObject(live, object)
+		if !found && object.Optional {
+			continue
+		}
+
+		if object.Kind == "Ingress" {
+			report.Ingresses = append(report.Ingresses, evaluateIngress(object, liveObject, found, probe))
+			continue
+		}
+
+		if !found {
+			report.Components = append(report.Components, Component{
+				Kind:      object.Kind,
+				Name:      object.Name,
+				Namespace: object.Namespace,
+				State:     Failed,
+				Message:   fmt.Sprintf("%s not found in the cluster", object.Kind),
+			})
+			continue
+		}
+
+		report.Components = append(report.Components, EvaluateComponent(liveObject, findImagePullErrors(live, liveObject)))
+	}
+
+	report.State = Aggregate(report.Components, report.Ingresses)
+	return report
+}
+
+// EvaluateComponent computes the health of a single live workload.
+func EvaluateComponent(workload ClusterObject, pullErrors []ImagePullError) Component {
+	component := Component{
+		Kind:            workload.Kind,
+		Name:            workload.Metadata.Name,
+		Namespace:       workload.Metadata.Namespace,
+		ImagePullErrors: pullErrors,
+	}
+
+	switch workload.Kind {
+	case "DaemonSet":
+		component.DesiredReplicas = workload.Status.DesiredNumberScheduled
+		component.ReadyReplicas = workload.Status.NumberReady
+		component.UpdatedReplicas = workload.Status.UpdatedNumberScheduled
+	default:
+		component.DesiredReplicas = 1
+		if workload.Spec.Replicas != nil {
+			component.DesiredReplicas = *workload.Spec.Replicas
+		}
+		component.ReadyReplicas = workload.Status.ReadyReplicas
+		component.UpdatedReplicas = workload.Status.UpdatedReplicas
+	}
+
+	switch {
+	case len(pullErrors) > 0:
+		component.State = Failed
+		component.Message = fmt.Sprintf("image pull failed for '%s'", pullErrors[0].Image)
+	case progressDeadlineExceeded(workload):
+		component.State = Failed
+		component.Message = "rollout exceeded its progress deadline"
+	case component.ReadyReplicas >= component.DesiredReplicas && component.UpdatedReplicas >= component.DesiredReplicas:
+		component.State = Healthy
+	case workload.Status.ObservedGeneration < workload.Metadata.Generation || component.UpdatedReplicas < component.DesiredReplicas:
+		component.State = Progressing
+		component.Message = fmt.Sprintf("rollout in progress (%d/%d updated)", component.UpdatedReplicas, component.DesiredReplicas)
+	case component.ReadyReplicas == 0:
+		component.State = Failed
+		component.Message = "no replicas ready"
+	default:
+		component.State = Degraded
+		component.Message = fmt.Sprintf("%d/%d replicas ready", component.ReadyReplicas, component.DesiredReplicas)
+	}
+
+	return component
+}
+
+// Aggregate computes the overall addon state. Failed components make the addon
+// Failed if nothing else is healthy, Degraded otherwise; unreachable Ingresses
+// degrade an otherwise healthy addon.
+func Aggregate(components []Component, ingresses []Ingress) State {
+	counts := map[State]int{}
+	for _, component := range components {
+		counts[component.State]++
+	}
+
+	switch {
+	case counts[Failed] > 0 && counts[Failed] == len(components):
+		return Failed
+	case counts[Failed] > 0 || counts[Degraded] > 0:
+		return Degraded
+	case counts[Progressing] > 0:
+		return Progressing
+	}
+
+	for _, ingress := range ingresses {
+		if !ingress.Reachable {
+			return Degraded
+		}
+	}
+	return Healthy
+}
+
+func evaluateIngress(object ManifestObject, live ClusterObject, found bool, probe IngressProbe) Ingress {
+	ingress := Ingress{Name: object.Name, Namespace: object.Namespace}
+	if !found {
+		ingress.Message = "Ingress not found in the cluster"
+		return ingress
+	}
+
+	for _, rule := range live.Spec.Rules {
+		if rule.Host != "" {
+			ingress.Hosts = append(ingress.Hosts, rule.Host)
+		}
+	}
+
+	for _, lb := range live.Status.LoadBalancer.Ingress {
+		ingress.Address = lb.IP
+		if ingress.Address == "" {
+			ingress.Address = lb.Hostname
+		}
+		if ingress.Address != "" {
+			break
+		}
+	}
+
+	if ingress.Address == "" {
+		ingress.Message = "no address assigned by the ingress controller"
+		return ingress
+	}
+
+	host := ""
+	if len(ingress.Hosts) > 0 {
+		host = ingress.Hosts[0]
+	}
+	if err := probe(host, ingress.Address); err != nil {
+		ingress.Message = err.Error()
+		return ingress
+	}
+
+	ingress.Reachable = true
+	return ingress
+}
+
+func findObject(live []ClusterObject, object ManifestObject) (ClusterObject, bool) {
+	for _, candidate := range live {
+		if candidate.Kind == object.Kind && candidate.Metadata.Name == object.Name && candidate.Metadata.Namespace == object.Namespace {
+			return candidate, true
+		}
+	}
+	return ClusterObject{}, false
+}
+
+func findImagePullErrors(live []ClusterObject, workload ClusterObject) []ImagePullError {
+	if workload.Spec.Selector == nil || len(workload.Spec.Selector.MatchLabels) == 0 {
+		return nil
+	}
+
+	var pullErrors []ImagePullError
+	for _, pod := range live {
+		if pod.Kind != "Pod" || pod.Metadata.Namespace != workload.Metadata.Namespace || !matchesLabels(pod.Metadata.Labels, workload.Spec.Selector.MatchLabels) {
+			continue
+		}
+
+		statuses := append(append([]containerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			if status.State.Waiting == nil || !slices.Contains(imagePullErrorReason, status.State.Waiting.Reason) {
+				continue
+			}
+			pullErrors = append(pullErrors, ImagePullError{
+				Pod:       pod.Metadata.Name,
+				Container: status.Name,
+				Image:     status.Image,
+				Reason:    status.State.Waiting.Reason,
+				Message:   strings.TrimSpace(status.State.Waiting.Message),
+			})
+		}
+	}
+	return pullErrors
+}
+
+func matchesLabels(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func progressDeadlineExceeded(workload ClusterObject) bool {
+	for _, condition := range workload.Status.Conditions {
+		if condition.Type == "Progressing" && condition.Reason == "ProgressDeadlineExceeded" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package health_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/siemens-healthineers/k2s/internal/core/addons/health"
+)
+
+func TestHealth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "health Unit Tests", Label("unit", "ci", "addons", "health"))
+}
+
+func parseObject(raw string) health.ClusterObject {
+	var object health.ClusterObject
+	Expect(json.Unmarshal([]byte(raw), &object)).To(Succeed())
+	return object
+}
+
+func reachable(string, string) error { return nil }
+
+const healthyDeployment = `{
+	"kind": "Deployment",
+	"metadata": {"name": "grafana", "namespace": "monitoring", "generation": 2},
+	"spec": {"replicas": 2, "selector": {"matchLabels": {"app": "grafana"}}},
+	"status": {"observedGeneration": 2, "readyReplicas": 2, "updatedReplicas": 2}
+}`
+
+var _ = Describe("health", func() {
+	Describe("ParseManifestObjects", func() {
+		It("extracts workloads and ingresses from multi-document YAML", func() {
+			rendered := []byte(`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: monitoring
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: grafana
+  namespace: monitoring
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: node-exporter
+---
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: grafana
+  namespace: monitoring
+`)
+
+			objects, err := health.ParseManifestObjects(rendered, true)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objects).To(ConsistOf(
+				health.ManifestObject{Kind: "Deployment", Name: "grafana", Namespace: "monitoring", Optional: true},
+				health.ManifestObject{Kind: "DaemonSet", Name: "node-exporter", Namespace: "default", Optional: true},
+				health.ManifestObject{Kind: "Ingress", Name: "grafana", Namespace: "monitoring", Optional: true},
+			))
+		})
+
+		It("returns an error on invalid YAML", func() {
+			_, err := health.ParseManifestObjects([]byte("kind: [invalid"), false)
+
+			Expect(err).To(MatchError(ContainSubstring("failed to parse manifests")))
+		})
+	})
+
+	Describe("Merge", func() {
+		It("keeps an object required if any source requires it", func() {
+			merged := health.Merge([]health.ManifestObject{
+				{Kind: "Deployment", Name: "a", Namespace: "ns", Optional: true},
+				{Kind: "Deployment", Name: "a", Namespace: "ns", Optional: false},
+				{Kind: "Deployment", Name: "b", Namespace: "ns", Optional: true},
+			})
+
+			Expect(merged).To(Equal([]health.ManifestObject{
+				{Kind: "Deployment", Name: "a", Namespace: "ns", Optional: false},
+				{Kind: "Deployment", Name: "b", Namespace: "ns", Optional: true},
+			}))
+		})
+	})
+
+	Describe("EvaluateComponent", func() {
+		It("is healthy if all replicas are ready and updated", func() {
+			component := health.EvaluateComponent(parseObject(healthyDeployment), nil)
+
+			Expect(component.State).To(Equal(health.Healthy))
+			Expect(component.DesiredReplicas).To(Equal(2))
+			Expect(component.ReadyReplicas).To(Equal(2))
+		})
+
+		It("is progressing during a rollout", func() {
+			component := health.EvaluateComponent(parseObject(`{
+				"kind": "Deployment",
+				"metadata": {"name": "grafana", "namespace": "monitoring", "generation": 3},
+				"spec": {"replicas": 2},
+				"status": {"observedGeneration": 3, "readyReplicas": 2, "updatedReplicas": 1}
+			}`), nil)
+
+			Expect(component.State).To(Equal(health.Progressing))
+		})
+
+		It("is failed if the progress deadline was exceeded", func() {
+			component := health.EvaluateComponent(parseObject(`{
+				"kind": "Deployment",
+				"metadata": {"name": "grafana", "namespace": "monitoring"},
+				"spec": {"replicas": 1},
+				"status": {"updatedReplicas": 1, "conditions": [{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"}]}
+			}`), nil)
+
+			Expect(component.State).To(Equal(health.Failed))
+		})
+
+		It("is degraded if only some replicas are ready", func() {
+			component := health.EvaluateComponent(parseObject(`{
+				"kind": "DaemonSet",
+				"metadata": {"name": "node-exporter", "namespace": "monitoring"},
+				"status": {"desiredNumberScheduled": 3, "numberReady": 2, "updatedNumberScheduled": 3}
+			}`), nil)
+
+			Expect(component.State).To(Equal(health.Degraded))
+			Expect(component.Message).To(Equal("2/3 replicas ready"))
+		})
+
+		It("is failed if no replica is ready", func() {
+			component := health.EvaluateComponent(parseObject(`{
+				"kind": "StatefulSet",
+				"metadata": {"name": "prometheus", "namespace": "monitoring"},
+				"spec": {"replicas": 1},
+				"status": {"readyReplicas": 0, "updatedReplicas": 1}
+			}`), nil)
+
+			Expect(component.State).To(Equal(health.Failed))
+		})
+	})
+
+	Describe("Evaluate", func() {
+		It("reports image pull errors of the workload's Pods", func() {
+			pod := parseObject(`{
+				"kind": "Pod",
+				"metadata": {"name": "grafana-abc", "namespace": "monitoring", "labels": {"app": "grafana"}},
+				"status": {"containerStatuses": [{"name": "grafana", "image": "grafana:bad", "state": {"waiting": {"reason": "ImagePullBackOff", "message": "back-off"}}}]}
+			}`)
+			declared := []health.ManifestObject{{Kind: "Deployment", Name: "grafana", Namespace: "monitoring"}}
+
+			report := health.Evaluate(declared, []health.ClusterObject{parseObject(healthyDeployment), pod}, reachable)
+
+			Expect(report.State).To(Equal(health.Failed))
+			Expect(report.Components).To(HaveLen(1))
+			Expect(report.Components[0].ImagePullErrors).To(ConsistOf(health.ImagePullError{
+				Pod: "grafana-abc", Container: "grafana", Image: "grafana:bad", Reason: "ImagePullBackOff", Message: "back-off",
+			}))
+		})
+
+		It("fails missing required objects and skips missing optional objects", func() {
+			declared := []health.ManifestObject{
+				{Kind: "Deployment", Name: "grafana", Namespace: "monitoring"},
+				{Kind: "Deployment", Name: "missing", Namespace: "monitoring"},
+				{Kind: "Deployment", Name: "variant", Namespace: "monitoring", Optional: true},
+			}
+
+			report := health.Evaluate(declared, []health.ClusterObject{parseObject(healthyDeployment)}, reachable)
+
+			Expect(report.State).To(Equal(health.Degraded))
+			Expect(report.Components).To(HaveLen(2))
+			Expect(report.Components[1].State).To(Equal(health.Failed))
+		})
+
+		It("is degraded if an ingress is not reachable", func() {
+			ingress := parseObject(`{
+				"kind": "Ingress",
+				"metadata": {"name": "grafana", "namespace": "monitoring"},
+				"spec": {"rules": [{"host": "k2s.cluster.local"}]},
+				"status": {"loadBalancer": {"ingress": [{"ip": "172.19.1.100"}]}}
+			}`)
+			declared := []health.ManifestObject{
+				{Kind: "Deployment", Name: "grafana", Namespace: "monitoring"},
+				{Kind: "Ingress", Name: "grafana", Namespace: "monitoring"},
+			}
+			var probedHost, probedAddress string
+			probe := func(host, address string) error {
+				probedHost, probedAddress = host, address
+				return errors.New("connection refused")
+			}
+
+			report := health.Evaluate(declared, []health.ClusterObject{parseObject(healthyDeployment), ingress}, probe)
+
+			Expect(report.State).To(Equal(health.Degraded))
+			Expect(probedHost).To(Equal("k2s.cluster.local"))
+			Expect(probedAddress).To(Equal("172.19.1.100"))
+			Expect(report.Ingresses).To(ConsistOf(health.Ingress{
+				Name: "grafana", Namespace: "monitoring", Hosts: []string{"k2s.cluster.local"}, Address: "172.19.1.100", Message: "connection refused",
+			}))
+		})
+
+		It("is healthy if all components are healthy", func() {
+			declared := []health.ManifestObject{{Kind: "Deployment", Name: "grafana", Namespace: "monitoring"}}
+
+			report := health.Evaluate(declared, []health.ClusterObject{parseObject(healthyDeployment)}, reachable)
+
+			Expect(report.State).To(Equal(health.Healthy))
+		})
+	})
+})
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package certificates
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+// Source denotes the K2s component a certificate belongs to.
+type Source string
+
+const (
+	SourceControlPlane Source = "control-plane"
+	SourceKubelet      Source = "kubelet"
+	SourceWebhook      Source = "webhook"
+	SourceRegistry     Source = "registry"
+	SourceUser         Source = "user"
+
+	// DefaultThreshold is the remaining validity below which certificates are reported as expiring and get renewed
+	DefaultThreshold = 30 * 24 * time.Hour
+)
+
+// Certificate describes a certificate K2s depends on.
+type Certificate struct {
+	Source   Source    `json:"source"`
+	Node     string    `json:"node,omitempty"`
+	Name     string    `json:"name"`
+	Subject  string    `json:"subject"`
+	Issuer   string    `json:"issuer"`
+	SANs     []string  `json:"sans,omitempty"`
+	NotAfter time.Time `json:"notAfter"`
+	DaysLeft int       `json:"daysLeft"`
+}
+
+// Describe maps the parsed certificate to its description; days left are negative when already expired.
+func Describe(source Source, node, name string, cert *x509.Certificate, now time.Time) Certificate {
+	var sans []string
+	sans = append(sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	sans = append(sans, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+
+	return Certificate{
+		Source:   source,
+		Node:     node,
+		Name:     name,
+		Subject:  cert.Subject.String(),
+		Issuer:   cert.Issuer.String(),
+		SANs:     sans,
+		NotAfter: cert.NotAfter,
+		DaysLeft: int(math.Floor(cert.NotAfter.Sub(now).Hours() / 24)),
+	}
+}
+
+// ParsePEM returns all certificates contained in the PEM data, skipping other blocks like private keys.
+func ParsePEM(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM-encoded certificate found")
+	}
+	return certs, nil
+}
+
+// ExpiresWithin determines whether the certificate expires within the given period from now on.
+func (c *Certificate) ExpiresWithin(period time.Duration, now time.Time) bool {
+	return c.NotAfter.Before(now.Add(period))
+}
+
+// ExpiringWithin returns the certificates expiring within the given period from now on.
+// ThresholdDays converts the threshold to whole days, rounded up so that a threshold of less than a day does not turn
+// into zero days and disable the check.
+func ThresholdDays(threshold time.Duration) int {
+	return int(math.Ceil(threshold.Hours() / 24))
+}
+
+func ExpiringWithin(certs []Certificate, period time.Duration, now time.Time) []Certificate {
+	var expiring []Certificate
+	for _, cert := range certs {
+		if cert.ExpiresWithin(period, now) {
+			expiring = append(expiring, cert)
+		}
+	}
+	return expiring
+}
+
+// SortByExpiry sorts the certificates ascending by expiry, the most urgent first.
+func SortByExpiry(certs []Certificate) {
+	slices.SortStableFunc(certs, func(a, b Certificate) int {
+		return a.NotAfter.Compare(b.NotAfter)
+	})
+}
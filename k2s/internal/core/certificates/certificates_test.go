@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package certificates_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"log/slog"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/core/certificates"
+)
+
+func TestPkg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "certificates pkg Unit Tests", Label("unit", "ci", "certificates"))
+}
+
+var _ = BeforeSuite(func() {
+	slog.SetDefault(slog.New(logr.ToSlogHandler(GinkgoLogr)))
+})
+
+var now = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+var _ = Describe("Describe", func() {
+	It("maps subject, issuer, SANs and days left", func() {
+		cert := createCert("kube-apiserver", now.Add(10*24*time.Hour+time.Hour))
+
+		result := certificates.Describe(certificates.SourceControlPlane, "master", "apiserver.crt", cert, now)
+
+		Expect(result.Source).To(Equal(certificates.SourceControlPlane))
+		Expect(result.Node).To(Equal("master"))
+		Expect(result.Name).To(Equal("apiserver.crt"))
+		Expect(result.Subject).To(Equal("CN=kube-apiserver,O=k2s"))
+		Expect(result.Issuer).To(Equal("CN=kube-apiserver,O=k2s"))
+		Expect(result.SANs).To(ConsistOf("kubernetes.default", "172.19.1.100"))
+		Expect(result.DaysLeft).To(Equal(10))
+	})
+
+	When("certificate is expired", func() {
+		It("returns negative days left", func() {
+			cert := createCert("expired", now.Add(-36*time.Hour))
+
+			result := certificates.Describe(certificates.SourceUser, "", "expired", cert, now)
+
+			Expect(result.DaysLeft).To(Equal(-2))
+		})
+	})
+})
+
+var _ = Describe("ParsePEM", func() {
+	It("returns all certificates skipping other blocks", func() {
+		data := append(encodeCert(createCert("first", now)), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")})...)
+		data = append(data, encodeCert(createCert("second", now))...)
+
+		certs, err := certificates.ParsePEM(data)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(certs).To(HaveLen(2))
+		Expect(certs[0].Subject.CommonName).To(Equal("first"))
+		Expect(certs[1].Subject.CommonName).To(Equal("second"))
+	})
+
+	When("no certificate is contained", func() {
+		It("returns an error", func() {
+			certs, err := certificates.ParsePEM([]byte("garbage"))
+
+			Expect(err).To(MatchError(ContainSubstring("no PEM-encoded certificate found")))
+			Expect(certs).To(BeNil())
+		})
+	})
+})
+
+var _ = Describe("ExpiringWithin", func() {
+	It("returns certificates expiring within the period including expired ones", func() {
+		certs := []certificates.Certificate{
+			{Name: "expired", NotAfter: now.Add(-time.Hour)},
+			{Name: "soon", NotAfter: now.Add(29 * 24 * time.Hour)},
+			{Name: "later", NotAfter: now.Add(31 * 24 * time.Hour)},
+		}
+
+		expiring := certificates.ExpiringWithin(certs, certificates.DefaultThreshold, now)
+
+		Expect(expiring).To(HaveLen(2))
+		Expect(expiring[0].Name).To(Equal("expired"))
+		Expect(expiring[1].Name).To(Equal("soon"))
+	})
+})
+
+var _ = Describe("ThresholdDays", func() {
+	DescribeTable("rounds up to whole days",
+		func(threshold time.Duration, expected int) {
+			Expect(certificates.ThresholdDays(threshold)).To(Equal(expected))
+		},
+		Entry("zero", time.Duration(0), 0),
+		Entry("less than a day", 12*time.Hour, 1),
+		Entry("whole days", 30*24*time.Hour, 30),
+		Entry("partial day", 36*time.Hour, 2),
+	)
+})
+
+var _ = Describe("Inventory", func() {
+	var sut *certificates.Inventory
+
+	BeforeEach(func() {
+		sut = certificates.NewInventory(now)
+	})
+
+	Describe("AddDump", func() {
+		It("classifies files and decodes embedded kubeconfig certificates", func() {
+			kubeconfig := "users:\n- name: kubernetes-admin\n  user:\n    client-certificate-data: " +
+				base64.StdEncoding.EncodeToString(encodeCert(createCert("kubernetes-admin", now.Add(300*24*time.Hour)))) +
+				"\n    client-key-data: a2V5\n"
+
+			output := "### /etc/kubernetes/pki/apiserver.crt\n" + string(encodeCert(createCert("kube-apiserver", now.Add(200*24*time.Hour)))) + "\n" +
+				"### /etc/kubernetes/pki/etcd/server.crt\n" + string(encodeCert(createCert("etcd", now.Add(100*24*time.Hour)))) + "\n" +
+				"### /etc/kubernetes/admin.conf\n" + kubeconfig + "\n" +
+				"### /var/lib/kubelet/pki/kubelet-client-current.pem\n" + string(encodeCert(createCert("system:node:master", now.Add(20*24*time.Hour)))) + "\n"
+
+			sut.AddDump("master", output)
+
+			certs := sut.Certificates()
+
+			Expect(sut.Warnings()).To(BeEmpty())
+			Expect(certs).To(HaveLen(4))
+			Expect(certs[0].Source).To(Equal(certificates.SourceKubelet))
+			Expect(certs[0].Name).To(Equal("kubelet-client-current.pem"))
+			Expect(certs[1].Source).To(Equal(certificates.SourceControlPlane))
+			Expect(certs[1].Name).To(Equal("etcd/server.crt"))
+			Expect(certs[2].Name).To(Equal("apiserver.crt"))
+			Expect(certs[3].Name).To(Equal("admin.conf"))
+			Expect(certs[3].Subject).To(ContainSubstring("CN=kubernetes-admin"))
+			for _, cert := range certs {
+				Expect(cert.Node).To(Equal("master"))
+			}
+		})
+
+		When("a file cannot be parsed", func() {
+			It("records a warning and continues", func() {
+				output := "### /etc/kubernetes/pki/broken.crt\nnot a cert\n" +
+					"### /etc/kubernetes/pki/ca.crt\n" + string(encodeCert(createCert("kubernetes", now.Add(time.Hour)))) + "\n"
+
+				sut.AddDump("master", output)
+
+				Expect(sut.Certificates()).To(HaveLen(1))
+				Expect(sut.Warnings()).To(ConsistOf(ContainSubstring("'broken.crt' on node 'master'")))
+			})
+		})
+	})
+
+	Describe("AddBase64PEM", func() {
+		When("data is not base64-encoded", func() {
+			It("records a warning", func() {
+				sut.AddBase64PEM(certificates.SourceWebhook, "", "clusterip-webhook CA", "%%%")
+
+				Expect(sut.Certificates()).To(BeEmpty())
+				Expect(sut.Warnings()).To(ConsistOf(ContainSubstring("Could not decode certificate 'clusterip-webhook CA'")))
+			})
+		})
+
+		It("adds the decoded certificates", func() {
+			sut.AddBase64PEM(certificates.SourceRegistry, "", "k2s-registry-local-tls", base64.StdEncoding.EncodeToString(encodeCert(createCert("k2s.registry.local", now.Add(90*24*time.Hour)))))
+
+			Expect(sut.Certificates()).To(ConsistOf(HaveField("Source", certificates.SourceRegistry)))
+		})
+	})
+})
+
+var _ = Describe("DumpScript", func() {
+	It("prints each existing file preceded by a marker", func() {
+		script := certificates.DumpScript("/etc/kubernetes/pki/*.crt", "/etc/kubernetes/admin.conf")
+
+		Expect(script).To(ContainSubstring(`for f in /etc/kubernetes/pki/*.crt /etc/kubernetes/admin.conf; do`))
+		Expect(script).To(ContainSubstring(`echo "### $f"`))
+	})
+})
+
+var _ = Describe("kubeadm expiration", func() {
+	const output = `{
+  "kind": "CertificateExpirationInfo",
+  "apiVersion": "output.kubeadm.k8s.io/v1alpha3",
+  "certificates": [
+    {"name": "admin.conf", "expirationDate": "2026-06-20T12:00:00Z", "residualTime": 1641600000000000, "externallyManaged": false, "missing": false},
+    {"name": "apiserver", "expirationDate": "2027-01-01T12:00:00Z", "residualTime": 1641600000000000, "externallyManaged": false, "missing": false},
+    {"name": "front-proxy-client", "expirationDate": "0001-01-01T00:00:00Z", "residualTime": 0, "externallyManaged": false, "missing": true},
+    {"name": "apiserver-etcd-client", "expirationDate": "2026-06-02T12:00:00Z", "residualTime": 0, "externallyManaged": true, "missing": false}
+  ],
+  "certificateAuthorities": [
+    {"name": "ca", "expirationDate": "2026-06-02T12:00:00Z", "residualTime": 0, "externallyManaged": false, "missing": false}
+  ]
+}`
+
+	It("returns missing and expiring certificates except externally managed ones and CAs", func() {
+		certs, err := certificates.ParseKubeadmExpiration([]byte(output))
+		Expect(err).ToNot(HaveOccurred())
+
+		due := certificates.DueForRenewal(certs, certificates.DefaultThreshold, now)
+
+		Expect(due).To(ConsistOf("admin.conf", "front-proxy-client"))
+	})
+
+	When("threshold is zero", func() {
+		It("returns only missing and expired certificates", func() {
+			certs, err := certificates.ParseKubeadmExpiration([]byte(output))
+			Expect(err).ToNot(HaveOccurred())
+
+			due := certificates.DueForRenewal(certs, 0, now)
+
+			Expect(due).To(ConsistOf("front-proxy-client"))
+		})
+	})
+
+	When("output is invalid", func() {
+		It("returns an error", func() {
+			certs, err := certificates.ParseKubeadmExpiration([]byte("oops"))
+
+			Expect(err).To(MatchError(ContainSubstring("failed to parse kubeadm certificate expiration info")))
+			Expect(certs).To(BeNil())
+		})
+	})
+})
+
+func createCert(commonName string, notAfter time.Time) *x509.Certificate {
+	GinkgoHelper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"k2s"}},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     []string{"kubernetes.default"},
+		IPAddresses:  []net.IP{net.ParseIP("172.19.1.100")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+	return cert
+}
+
+func encodeCert(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
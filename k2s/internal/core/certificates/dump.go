@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package certificates
+
+import (
+	"fmt"
+	"strings"
+)
+
+type dumpedFile struct {
+	path    string
+	content string
+}
+
+const (
+	dumpMarker = "### "
+
+	kubernetesDir = "/etc/kubernetes/"
+	kubeletPkiDir = "/var/lib/kubelet/pki/"
+)
+
+// LinuxNodePaths are the certificate and kubeconfig files of a Linux control-plane node; missing files are skipped.
+var LinuxNodePaths = []string{
+	kubernetesDir + "pki/*.crt",
+	kubernetesDir + "pki/etcd/*.crt",
+	kubernetesDir + "admin.conf",
+	kubernetesDir + "super-admin.conf",
+	kubernetesDir + "controller-manager.conf",
+	kubernetesDir + "scheduler.conf",
+	kubeletPkiDir + "kubelet-client-current.pem",
+}
+
+// DumpScript creates a shell script printing the content of all existing files matching the given paths,
+// each preceded by a marker line containing the file path. Files are read with sudo unless running as root.
+func DumpScript(paths ...string) string {
+	var script strings.Builder
+	script.WriteString(`S=""; [ "$(id -u)" -eq 0 ] || S="sudo -n"; `)
+	fmt.Fprintf(&script, `for f in %s; do $S test -f "$f" || continue; echo "%s$f"; $S cat "$f" 2>/dev/null; echo; done`, strings.Join(paths, " "), dumpMarker)
+	return script.String()
+}
+
+func parseDump(output string) []dumpedFile {
+	var files []dumpedFile
+	var current *dumpedFile
+	for line := range strings.Lines(output) {
+		if path, found := strings.CutPrefix(line, dumpMarker); found {
+			files = append(files, dumpedFile{path: strings.TrimSpace(path)})
+			current = &files[len(files)-1]
+			continue
+		}
+		if current != nil {
+			current.content += line
+		}
+	}
+	return files
+}
+
+func classify(path string) (Source, string) {
+	if name, found := strings.CutPrefix(path, kubeletPkiDir); found {
+		return SourceKubelet, name
+	}
+	if name, found := strings.CutPrefix(path, kubernetesDir+"pki/"); found {
+		return SourceControlPlane, name
+	}
+	return SourceControlPlane, strings.TrimPrefix(path, kubernetesDir)
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package certificates
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// Inventory collects the certificates of all K2s components. Certificates that cannot be read are
+// recorded as warnings instead of failing the whole inventory.
+type Inventory struct {
+	now          time.Time
+	certificates []Certificate
+	warnings     []string
+}
+
+const kubeconfigCertKey = "client-certificate-data:"
+
+func NewInventory(now time.Time) *Inventory {
+	return &Inventory{now: now}
+}
+
+// AddCertificate adds an already parsed certificate, e.g. presented by a TLS endpoint.
+func (i *Inventory) AddCertificate(source Source, node, name string, cert *x509.Certificate) {
+	i.certificates = append(i.certificates, Describe(source, node, name, cert, i.now))
+}
+
+// AddPEM adds all certificates contained in the PEM data.
+func (i *Inventory) AddPEM(source Source, node, name string, data []byte) {
+	certs, err := ParsePEM(data)
+	if err != nil {
+		i.Warn("Could not parse certificate '%s'%s: %v", name, onNode(node), err)
+		return
+	}
+	for _, cert := range certs {
+		i.AddCertificate(source, node, name, cert)
+	}
+}
+
+// AddBase64PEM adds all certificates contained in the base64-encoded PEM data, e.g. of a Secret or caBundle.
+func (i *Inventory) AddBase64PEM(source Source, node, name string, data string) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data))
+	if err != nil {
+		i.Warn("Could not decode certificate '%s'%s: %v", name, onNode(node), err)
+		return
+	}
+	i.AddPEM(source, node, name, decoded)
+}
+
+// AddKubeconfig adds the embedded client certificates of the kubeconfig; certificates referenced by file path are skipped.
+func (i *Inventory) AddKubeconfig(source Source, node, name string, data []byte) {
+	for line := range strings.Lines(string(data)) {
+		_, value, found := strings.Cut(line, kubeconfigCertKey)
+		if !found {
+			continue
+		}
+		i.AddBase64PEM(source, node, name, value)
+	}
+}
+
+// AddDump adds the certificates contained in the output of the script created with DumpScript.
+func (i *Inventory) AddDump(node string, output string) {
+	for _, file := range parseDump(output) {
+		source, name := classify(file.path)
+		if strings.HasSuffix(file.path, ".conf") {
+			i.AddKubeconfig(source, node, name, []byte(file.content))
+			continue
+		}
+		i.AddPEM(source, node, name, []byte(file.content))
+	}
+}
+
+// Warn records a certificate that could not be inspected.
+func (i *Inventory) Warn(format string, args ...any) {
+	warning := fmt.Sprintf(format, args...)
+	slog.Warn("[Certificates] " + warning)
+	i.warnings = append(i.warnings, warning)
+}
+
+// Certificates returns the collected certificates, the most urgent first.
+func (i *Inventory) Certificates() []Certificate {
+	SortByExpiry(i.certificates)
+	return i.certificates
+}
+
+func (i *Inventory) Warnings() []string {
+	return i.warnings
+}
+
+func onNode(node string) string {
+	if node == "" {
+		return ""
+	}
+	return fmt.Sprintf(" on node '%s'", node)
+}
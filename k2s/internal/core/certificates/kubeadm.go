@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package certificates
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// KubeadmCertificate is a certificate entry of 'kubeadm certs check-expiration -o json'.
+type KubeadmCertificate struct {
+	Name              string    `json:"name"`
+	ExpirationDate    time.Time `json:"expirationDate"`
+	ExternallyManaged bool      `json:"externallyManaged"`
+	Missing           bool      `json:"missing"`
+}
+
+// ParseKubeadmExpiration parses the output of 'kubeadm certs check-expiration -o json'. CAs are omitted,
+// since they are not renewed by kubeadm.
+func ParseKubeadmExpiration(data []byte) ([]KubeadmCertificate, error) {
+	var info struct {
+		Certificates []KubeadmCertificate `json:"certificates"`
+	}
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, fmt.Errorf("failed to parse kubeadm certificate expiration info: %w", err)
+	}
+	return info.Certificates, nil
+}
+
+// DueForRenewal returns the names of the kubeadm-managed certificates that are missing or expire within the threshold.
+func DueForRenewal(certs []KubeadmCertificate, threshold time.Duration, now time.Time) []string {
+	var due []string
+	for _, cert := range certs {
+		if cert.ExternallyManaged {
+			continue
+		}
+		if cert.Missing || cert.ExpirationDate.Before(now.Add(threshold)) {
+			due = append(due, cert.Name)
+		}
+	}
+	return due
+}
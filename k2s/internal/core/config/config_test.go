@@ -165,6 +165,7 @@ var _ = Describe("config pkg", func() {
 				Expect(config.InstallConfig().Version()).To(Equal(inputConfig["Version"]))
 				Expect(config.ClusterConfig().Name()).To(Equal(inputConfig["ClusterName"]))
 				Expect(config.ControlPlaneConfig().Hostname()).To(Equal(inputConfig["ControlPlaneNodeHostname"]))
+				Expect(config.ClusterConfig().WindowsWorker()).To(BeNil())
 			})
 		})
 
@@ -290,4 +291,39 @@ var _ = Describe("config pkg", func() {
 			})
 		})
 	})
+
+	Describe("SetWindowsWorker", func() {
+		When("config file does not exist", func() {
+			It("returns not-exist error", func() {
+				err := config.SetWindowsWorker(GinkgoT().TempDir(), "win-node", "k2s-win-worker", "172.19.1.101")
+
+				Expect(err).To(MatchError(os.ErrNotExist))
+			})
+		})
+
+		When("config file exists", func() {
+			var dir string
+
+			BeforeEach(func() {
+				dir = GinkgoT().TempDir()
+				Expect(config.WriteRuntimeConfig(dir, "k2s", false, "test-version", "my-cluster", "my-host", false)).To(Succeed())
+			})
+
+			It("records the Windows worker without modifying the other values", func() {
+				Expect(config.SetWindowsWorker(dir, "win-node", "k2s-win-worker", "172.19.1.101")).To(Succeed())
+
+				runtimeConfig, err := config.ReadRuntimeConfig(dir)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(runtimeConfig.InstallConfig().SetupName()).To(Equal("k2s"))
+				Expect(runtimeConfig.ClusterConfig().Name()).To(Equal("my-cluster"))
+
+				worker := runtimeConfig.ClusterConfig().WindowsWorker()
+				Expect(worker).ToNot(BeNil())
+				Expect(worker.NodeName()).To(Equal("win-node"))
+				Expect(worker.VMName()).To(Equal("k2s-win-worker"))
+				Expect(worker.IpAddress()).To(Equal("172.19.1.101"))
+			})
+		})
+	})
 })
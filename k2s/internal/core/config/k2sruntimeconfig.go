@@ -15,6 +15,8 @@ import (
 	"github.com/siemens-healthineers/k2s/internal/json"
 )
 
+const windowsWorkerKey = "WindowsWorker"
+
 type config struct {
 	SetupName                string               `json:"SetupType"`
 	Registries               []contracts.Registry `json:"Registries"`
@@ -25,6 +27,13 @@ type config struct {
 	ClusterName              string               `json:"ClusterName"`
 	WslEnabled               bool                 `json:"WSL"`
 	EnabledAddons            []addon              `json:"EnabledAddons"`
+	WindowsWorker            *windowsWorker       `json:"WindowsWorker,omitempty"`
+}
+
+type windowsWorker struct {
+	NodeName  string `json:"NodeName"`
+	VMName    string `json:"VMName"`
+	IpAddress string `json:"IpAddress"`
 }
 
 type addon struct {
@@ -51,7 +60,7 @@ func ReadRuntimeConfig(configDir string) (*contracts.K2sRuntimeConfig, error) {
 	}
 
 	controlPlaneConfig := contracts.NewK2sControlPlaneConfig(config.ControlPlaneNodeHostname)
-	clusterConfig := contracts.NewK2sClusterConfig(config.ClusterName, config.Registries, controlPlaneConfig, mapAddons(config.EnabledAddons))
+	clusterConfig := contracts.NewK2sClusterConfig(config.ClusterName, config.Registries, controlPlaneConfig, mapAddons(config.EnabledAddons), mapWindowsWorker(config.WindowsWorker))
 	installConfig := contracts.NewK2sInstallConfig(config.SetupName, config.LinuxOnly, config.Version, config.Corrupted, config.WslEnabled)
 
 	k2sRuntimeConfig := contracts.NewK2sRuntimeConfig(clusterConfig, installConfig, controlPlaneConfig)
@@ -81,6 +90,32 @@ func MarkSetupAsCorrupted(configDir string) error {
 	return json.ToFile(configPath, config)
 }
 
+// SetWindowsWorker records the Windows worker VM of a native Linux host installation in the setup config without
+// modifying the other values.
+func SetWindowsWorker(configDir string, nodeName string, vmName string, ipAddress string) error {
+	configPath := filepath.Join(configDir, definitions.K2sRuntimeConfigFileName)
+
+	config, err := json.FromFile[map[string]any](configPath)
+	if err != nil {
+		return fmt.Errorf("error occurred while loading setup config file: %w", err)
+	}
+
+	(*config)[windowsWorkerKey] = windowsWorker{
+		NodeName:  nodeName,
+		VMName:    vmName,
+		IpAddress: ipAddress,
+	}
+
+	return json.ToFile(configPath, config)
+}
+
+func mapWindowsWorker(worker *windowsWorker) *contracts.K2sWindowsWorkerConfig {
+	if worker == nil {
+		return nil
+	}
+	return contracts.NewK2sWindowsWorkerConfig(worker.NodeName, worker.VMName, worker.IpAddress)
+}
+
 func mapAddons(inputAddons []addon) (addons []contracts.Addon) {
 	for _, addon := range inputAddons {
 		addons = append(addons, contracts.Addon{
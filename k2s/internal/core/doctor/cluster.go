@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package doctor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PodsCheck declares a check for all pods matching the label selector to be running and ready,
+// e.g. for system components like Flannel or checks contributed by addons.
+type PodsCheck struct {
+	ID          string
+	Description string
+	Severity    Severity
+	Remediation string
+	Namespace   string
+	Selector    string
+}
+
+type podList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Spec struct {
+			NodeName string `json:"nodeName"`
+		} `json:"spec"`
+		Status struct {
+			Phase      string `json:"phase"`
+			Conditions []struct {
+				Type   string `json:"type"`
+				Status string `json:"status"`
+			} `json:"conditions"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+type nodeList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Spec struct {
+			PodCIDR string `json:"podCIDR"`
+		} `json:"spec"`
+		Status struct {
+			NodeInfo struct {
+				OperatingSystem string `json:"operatingSystem"`
+			} `json:"nodeInfo"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+// WindowsPodSubnet is the pod subnet of a Windows node.
+type WindowsPodSubnet struct {
+	Node   string
+	Subnet string
+}
+
+// EvaluatePods returns an error listing the pods of the 'kubectl get pods -o json' output that are not running
+// and ready, or if there are no pods at all.
+func EvaluatePods(podListJson []byte) error {
+	var pods podList
+	if err := json.Unmarshal(podListJson, &pods); err != nil {
+		return fmt.Errorf("failed to parse pod list: %w", err)
+	}
+	if len(pods.Items) == 0 {
+		return errors.New("no pods found")
+	}
+
+	var notReady []string
+	for _, pod := range pods.Items {
+		ready := pod.Status.Phase == "Running"
+		if ready {
+			ready = false
+			for _, condition := range pod.Status.Conditions {
+				if condition.Type == "Ready" {
+					ready = condition.Status == "True"
+				}
+			}
+		}
+		if !ready {
+			notReady = append(notReady, fmt.Sprintf("%s (%s, node %s)", pod.Metadata.Name, pod.Status.Phase, orUnknown(pod.Spec.NodeName)))
+		}
+	}
+
+	if len(notReady) > 0 {
+		return fmt.Errorf("%d of %d pods not ready: %s", len(notReady), len(pods.Items), strings.Join(notReady, ", "))
+	}
+	return nil
+}
+
+// WindowsPodSubnets returns the pod subnets of the Windows nodes of the 'kubectl get nodes -o json' output.
+func WindowsPodSubnets(nodeListJson []byte) ([]WindowsPodSubnet, error) {
+	var nodes nodeList
+	if err := json.Unmarshal(nodeListJson, &nodes); err != nil {
+		return nil, fmt.Errorf("failed to parse node list: %w", err)
+	}
+
+	var subnets []WindowsPodSubnet
+	for _, node := range nodes.Items {
+		if node.Status.NodeInfo.OperatingSystem != "windows" {
+			continue
+		}
+		if node.Spec.PodCIDR == "" {
+			return nil, fmt.Errorf("Windows node '%s' has no pod subnet assigned", node.Metadata.Name)
+		}
+		subnets = append(subnets, WindowsPodSubnet{Node: node.Metadata.Name, Subnet: node.Spec.PodCIDR})
+	}
+	return subnets, nil
+}
+
+// ParseDiskUsage returns the used capacity in percent from the 'df -P <path>' output.
+func ParseDiskUsage(dfOutput string) (int, error) {
+	lines := strings.Split(strings.TrimSpace(dfOutput), "\n")
+	if len(lines) < 2 {
+		return 0, fmt.Errorf("unexpected disk usage output: '%s'", dfOutput)
+	}
+
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 5 {
+		return 0, fmt.Errorf("unexpected disk usage output: '%s'", dfOutput)
+	}
+
+	usage, err := strconv.Atoi(strings.TrimSuffix(fields[4], "%"))
+	if err != nil {
+		return 0, fmt.Errorf("unexpected disk usage '%s': %w", fields[4], err)
+	}
+	return usage, nil
+}
+
+func orUnknown(value string) string {
+	if value == "" {
+		return "unknown"
+	}
+	return value
+}
@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package doctor
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+)
+
+// Severity denotes the impact of a failed check.
+type Severity string
+
+// Status is the outcome of a check.
+type Status string
+
+// Check is a health check; Run returns nil if the check passed, a skip error (see Skip) if the check
+// does not apply, or an error describing the problem. A check is skipped if one of the checks it depends on
+// did not pass, e.g. cluster checks if the API server is not reachable.
+type Check struct {
+	ID          string
+	Description string
+	Severity    Severity
+	Remediation string
+	DependsOn   []string
+	Run         func() error
+}
+
+// Result is the outcome of a single check.
+type Result struct {
+	ID          string   `json:"id"`
+	Description string   `json:"description"`
+	Severity    Severity `json:"severity"`
+	Status      Status   `json:"status"`
+	Message     string   `json:"message,omitempty"`
+	Remediation string   `json:"remediation,omitempty"`
+}
+
+// Report holds the results of all checks run.
+type Report struct {
+	Results []Result `json:"results"`
+}
+
+// Registry holds the checks in registration order; check IDs are unique.
+type Registry struct {
+	checks []Check
+}
+
+type skipError struct {
+	reason string
+}
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityWarning  Severity = "warning"
+	SeverityInfo     Severity = "info"
+
+	StatusPassed  Status = "passed"
+	StatusFailed  Status = "failed"
+	StatusSkipped Status = "skipped"
+)
+
+var severities = []Severity{SeverityCritical, SeverityWarning, SeverityInfo}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Skip returns the error a check returns if it does not apply, e.g. to Linux-only setups.
+func Skip(reason string) error {
+	return &skipError{reason: reason}
+}
+
+func (e *skipError) Error() string {
+	return e.reason
+}
+
+// ParseSeverity returns the severity for the given name.
+func ParseSeverity(name string) (Severity, error) {
+	severity := Severity(name)
+	if !slices.Contains(severities, severity) {
+		return "", fmt.Errorf("invalid severity '%s', valid values are %v", name, severities)
+	}
+	return severity, nil
+}
+
+// Register adds the checks to the registry.
+func (r *Registry) Register(checks ...Check) error {
+	for _, check := range checks {
+		if check.ID == "" {
+			return errors.New("check ID must not be empty")
+		}
+		if check.Run == nil {
+			return fmt.Errorf("check '%s' has no run function", check.ID)
+		}
+		if _, err := ParseSeverity(string(check.Severity)); err != nil {
+			return fmt.Errorf("check '%s': %w", check.ID, err)
+		}
+		if r.find(check.ID) != nil {
+			return fmt.Errorf("check '%s' already registered", check.ID)
+		}
+		for _, dependency := range check.DependsOn {
+			if r.find(dependency) == nil {
+				return fmt.Errorf("check '%s' depends on unknown check '%s'", check.ID, dependency)
+			}
+		}
+		r.checks = append(r.checks, check)
+	}
+	return nil
+}
+
+// Checks returns the registered checks in registration order.
+func (r *Registry) Checks() []Check {
+	return slices.Clone(r.checks)
+}
+
+// Run runs the checks with the given IDs or all checks if no IDs are given. Dependencies of the selected checks
+// are not run implicitly.
+func (r *Registry) Run(ids ...string) (*Report, error) {
+	checks := r.checks
+	if len(ids) > 0 {
+		checks = nil
+		for _, id := range ids {
+			check := r.find(id)
+			if check == nil {
+				return nil, fmt.Errorf("unknown check '%s'", id)
+			}
+			checks = append(checks, *check)
+		}
+	}
+
+	report := &Report{}
+	statuses := map[string]Status{}
+	for _, check := range checks {
+		result := runAfterDependencies(check, statuses)
+		statuses[check.ID] = result.Status
+		report.Results = append(report.Results, result)
+	}
+	return report, nil
+}
+
+// Failed returns the failed checks of the given severity.
+func (r *Report) Failed(severity Severity) []Result {
+	var failed []Result
+	for _, result := range r.Results {
+		if result.Status == StatusFailed && result.Severity == severity {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+func (r *Registry) find(id string) *Check {
+	for i := range r.checks {
+		if r.checks[i].ID == id {
+			return &r.checks[i]
+		}
+	}
+	return nil
+}
+
+func runAfterDependencies(check Check, statuses map[string]Status) Result {
+	for _, dependency := range check.DependsOn {
+		if status, found := statuses[dependency]; found && status != StatusPassed {
+			return Result{
+				ID:          check.ID,
+				Description: check.Description,
+				Severity:    check.Severity,
+				Status:      StatusSkipped,
+				Message:     fmt.Sprintf("check '%s' did not pass", dependency),
+			}
+		}
+	}
+	return run(check)
+}
+
+func run(check Check) Result {
+	slog.Debug("[Doctor] Running check", "id", check.ID)
+
+	result := Result{
+		ID:          check.ID,
+		Description: check.Description,
+		Severity:    check.Severity,
+		Status:      StatusPassed,
+	}
+
+	err := check.Run()
+	if err == nil {
+		return result
+	}
+
+	result.Message = err.Error()
+	if _, isSkipped := errors.AsType[*skipError](err); isSkipped {
+		result.Status = StatusSkipped
+		return result
+	}
+
+	slog.Debug("[Doctor] Check failed", "id", check.ID, "error", err)
+
+	result.Status = StatusFailed
+	result.Remediation = check.Remediation
+	return result
+}
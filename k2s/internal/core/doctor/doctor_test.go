@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package doctor_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/core/doctor"
+)
+
+func TestPkg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "doctor pkg Unit Tests", Label("unit", "ci", "doctor"))
+}
+
+var _ = BeforeSuite(func() {
+	slog.SetDefault(slog.New(logr.ToSlogHandler(GinkgoLogr)))
+})
+
+func newCheck(id string, severity doctor.Severity, err error, dependsOn ...string) doctor.Check {
+	return doctor.Check{
+		ID:          id,
+		Description: id + " works",
+		Severity:    severity,
+		Remediation: "fix " + id,
+		DependsOn:   dependsOn,
+		Run:         func() error { return err },
+	}
+}
+
+var _ = Describe("Registry", func() {
+	Describe("Register", func() {
+		DescribeTable("rejects invalid checks", func(check doctor.Check, expectedErr string) {
+			sut := doctor.NewRegistry()
+			Expect(sut.Register(newCheck("existing", doctor.SeverityInfo, nil))).To(Succeed())
+
+			err := sut.Register(check)
+
+			Expect(err).To(MatchError(expectedErr))
+		},
+			Entry("empty ID", newCheck("", doctor.SeverityInfo, nil), "check ID must not be empty"),
+			Entry("duplicate ID", newCheck("existing", doctor.SeverityInfo, nil), "check 'existing' already registered"),
+			Entry("invalid severity", newCheck("new", "fatal", nil), "check 'new': invalid severity 'fatal', valid values are [critical warning info]"),
+			Entry("unknown dependency", newCheck("new", doctor.SeverityInfo, nil, "unknown"), "check 'new' depends on unknown check 'unknown'"),
+			Entry("missing run function", doctor.Check{ID: "new", Severity: doctor.SeverityInfo}, "check 'new' has no run function"),
+		)
+
+		It("keeps registration order", func() {
+			sut := doctor.NewRegistry()
+
+			Expect(sut.Register(newCheck("b", doctor.SeverityInfo, nil), newCheck("a", doctor.SeverityInfo, nil))).To(Succeed())
+
+			Expect(sut.Checks()).To(HaveExactElements(HaveField("ID", "b"), HaveField("ID", "a")))
+		})
+	})
+
+	Describe("Run", func() {
+		var sut *doctor.Registry
+
+		BeforeEach(func() {
+			sut = doctor.NewRegistry()
+			Expect(sut.Register(
+				newCheck("api-server", doctor.SeverityCritical, errors.New("connection refused")),
+				newCheck("dns", doctor.SeverityCritical, nil, "api-server"),
+				newCheck("routes", doctor.SeverityCritical, doctor.Skip("Linux-only setup")),
+				newCheck("disk", doctor.SeverityWarning, nil),
+			)).To(Succeed())
+		})
+
+		It("runs all checks and skips checks whose dependencies did not pass", func() {
+			report, err := sut.Run()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Results).To(HaveExactElements(
+				doctor.Result{ID: "api-server", Description: "api-server works", Severity: doctor.SeverityCritical, Status: doctor.StatusFailed, Message: "connection refused", Remediation: "fix api-server"},
+				doctor.Result{ID: "dns", Description: "dns works", Severity: doctor.SeverityCritical, Status: doctor.StatusSkipped, Message: "check 'api-server' did not pass"},
+				doctor.Result{ID: "routes", Description: "routes works", Severity: doctor.SeverityCritical, Status: doctor.StatusSkipped, Message: "Linux-only setup"},
+				doctor.Result{ID: "disk", Description: "disk works", Severity: doctor.SeverityWarning, Status: doctor.StatusPassed},
+			))
+			Expect(report.Failed(doctor.SeverityCritical)).To(HaveExactElements(HaveField("ID", "api-server")))
+			Expect(report.Failed(doctor.SeverityWarning)).To(BeEmpty())
+		})
+
+		It("runs selected checks only, ignoring dependencies not selected", func() {
+			report, err := sut.Run("disk", "dns")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Results).To(HaveExactElements(
+				HaveField("ID", "disk"),
+				SatisfyAll(HaveField("ID", "dns"), HaveField("Status", doctor.StatusPassed)),
+			))
+		})
+
+		When("check is unknown", func() {
+			It("returns error", func() {
+				report, err := sut.Run("unknown")
+
+				Expect(err).To(MatchError("unknown check 'unknown'"))
+				Expect(report).To(BeNil())
+			})
+		})
+	})
+})
+
+var _ = Describe("EvaluatePods", func() {
+	It("passes if all pods are running and ready", func() {
+		pods := `{"items":[{"metadata":{"name":"flannel-1"},"status":{"phase":"Running","conditions":[{"type":"Ready","status":"True"}]}}]}`
+
+		Expect(doctor.EvaluatePods([]byte(pods))).To(Succeed())
+	})
+
+	It("lists pods not running or not ready", func() {
+		pods := `{"items":[
+			{"metadata":{"name":"ready"},"spec":{"nodeName":"n1"},"status":{"phase":"Running","conditions":[{"type":"Ready","status":"True"}]}},
+			{"metadata":{"name":"not-ready"},"spec":{"nodeName":"n1"},"status":{"phase":"Running","conditions":[{"type":"Ready","status":"False"}]}},
+			{"metadata":{"name":"pending"},"status":{"phase":"Pending"}}]}`
+
+		err := doctor.EvaluatePods([]byte(pods))
+
+		Expect(err).To(MatchError("2 of 3 pods not ready: not-ready (Running, node n1), pending (Pending, node unknown)"))
+	})
+
+	It("fails if there are no pods", func() {
+		Expect(doctor.EvaluatePods([]byte(`{"items":[]}`))).To(MatchError("no pods found"))
+	})
+})
+
+var _ = Describe("WindowsPodSubnets", func() {
+	It("returns pod subnets of Windows nodes only", func() {
+		nodes := `{"items":[
+			{"metadata":{"name":"kubemaster"},"spec":{"podCIDR":"172.20.0.0/24"},"status":{"nodeInfo":{"operatingSystem":"linux"}}},
+			{"metadata":{"name":"winnode"},"spec":{"podCIDR":"172.20.1.0/24"},"status":{"nodeInfo":{"operatingSystem":"windows"}}}]}`
+
+		subnets, err := doctor.WindowsPodSubnets([]byte(nodes))
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(subnets).To(ConsistOf(doctor.WindowsPodSubnet{Node: "winnode", Subnet: "172.20.1.0/24"}))
+	})
+
+	It("fails if a Windows node has no pod subnet", func() {
+		nodes := `{"items":[{"metadata":{"name":"winnode"},"spec":{},"status":{"nodeInfo":{"operatingSystem":"windows"}}}]}`
+
+		_, err := doctor.WindowsPodSubnets([]byte(nodes))
+
+		Expect(err).To(MatchError("Windows node 'winnode' has no pod subnet assigned"))
+	})
+})
+
+var _ = Describe("ParseDiskUsage", func() {
+	It("returns the used capacity in percent", func() {
+		output := "Filesystem     1024-blocks     Used Available Capacity Mounted on\n/dev/sda1         50620216 44545796   3469160      93% /\n"
+
+		usage, err := doctor.ParseDiskUsage(output)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(usage).To(Equal(93))
+	})
+
+	It("fails on unexpected output", func() {
+		_, err := doctor.ParseDiskUsage("df: /: No such file or directory")
+
+		Expect(err).To(MatchError(ContainSubstring("unexpected disk usage output")))
+	})
+})
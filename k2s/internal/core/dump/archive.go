@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package dump
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Source denotes where an archive entry was collected from.
+type Source string
+
+// IndexEntry describes a file of the dump archive or a collection step that failed.
+type IndexEntry struct {
+	Path       string `json:"path"`
+	Source     Source `json:"source"`
+	Node       string `json:"node,omitempty"`
+	Command    string `json:"command,omitempty"`
+	Size       int    `json:"size"`
+	Redactions int    `json:"redactions"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Index is written to the dump archive as 'index.json' describing its content.
+type Index struct {
+	CreatedAt time.Time    `json:"createdAt"`
+	Host      string       `json:"host"`
+	Nodes     []string     `json:"nodes"`
+	Entries   []IndexEntry `json:"entries"`
+}
+
+const (
+	SourceCluster Source = "cluster"
+	SourceNode    Source = "node"
+	SourceConfig  Source = "config"
+	SourceLog     Source = "log"
+
+	IndexFileName = "index.json"
+)
+
+type archive struct {
+	file   *os.File
+	writer *zip.Writer
+	index  Index
+}
+
+func createArchive(filePath string, index Index) (*archive, error) {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dump archive: %w", err)
+	}
+	return &archive{file: file, writer: zip.NewWriter(file), index: index}, nil
+}
+
+// add writes the content to the archive and records it in the index; a failed collection is recorded with its error only.
+func (a *archive) add(entry IndexEntry, content []byte, collectErr error) error {
+	if collectErr != nil {
+		entry.Error = collectErr.Error()
+		a.index.Entries = append(a.index.Entries, entry)
+		return nil
+	}
+
+	writer, err := a.writer.CreateHeader(&zip.FileHeader{Name: entry.Path, Method: zip.Deflate, Modified: a.index.CreatedAt})
+	if err != nil {
+		return fmt.Errorf("failed to add '%s' to dump archive: %w", entry.Path, err)
+	}
+	if _, err := writer.Write(content); err != nil {
+		return fmt.Errorf("failed to write '%s' to dump archive: %w", entry.Path, err)
+	}
+
+	entry.Size = len(content)
+	a.index.Entries = append(a.index.Entries, entry)
+	return nil
+}
+
+// close writes the index and finalizes the archive.
+func (a *archive) close() error {
+	indexJson, err := json.MarshalIndent(a.index, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal dump index: %w", err)
+	}
+
+	writer, err := a.writer.CreateHeader(&zip.FileHeader{Name: IndexFileName, Method: zip.Deflate, Modified: a.index.CreatedAt})
+	if err == nil {
+		_, err = writer.Write(indexJson)
+	}
+	if err != nil {
+		a.writer.Close()
+		a.file.Close()
+		return fmt.Errorf("failed to write dump index: %w", err)
+	}
+
+	if err := a.writer.Close(); err != nil {
+		a.file.Close()
+		return fmt.Errorf("failed to finalize dump archive: %w", err)
+	}
+	return a.file.Close()
+}
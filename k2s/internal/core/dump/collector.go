@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package dump
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type kubectl interface {
+	ExecWithOutput(args ...string) (string, error)
+}
+
+type nodeShell interface {
+	Exec(node Node, command string) (string, error)
+}
+
+// File is a K2s config or log file or directory to include in the dump.
+type File struct {
+	Source     Source
+	ArchiveDir string
+	Path       string
+}
+
+// Options select what to collect and where to write the dump archive to.
+type Options struct {
+	TargetDir string
+	Host      string
+	Nodes     []Node
+	Files     []File
+}
+
+// Collector gathers cluster resources, node diagnostics and K2s files into a single redacted archive.
+type Collector struct {
+	kubectl kubectl
+	shell   nodeShell
+	now     func() time.Time
+}
+
+// clusterResources are dumped as JSON; Secret data is redacted.
+var clusterResources = []string{
+	"nodes", "namespaces", "pods", "deployments", "daemonsets", "statefulsets", "replicasets", "jobs", "cronjobs",
+	"services", "endpoints", "ingresses", "networkpolicies", "configmaps", "secrets", "serviceaccounts",
+	"persistentvolumes", "persistentvolumeclaims", "storageclasses",
+	"mutatingwebhookconfigurations", "validatingwebhookconfigurations", "certificatesigningrequests",
+}
+
+func NewCollector(kubectl kubectl, shell nodeShell, now func() time.Time) *Collector {
+	return &Collector{
+		kubectl: kubectl,
+		shell:   shell,
+		now:     now,
+	}
+}
+
+// Collect writes the dump archive named 'k2s-dump-<host>-<timestamp>.zip' to the target dir and returns its path.
+// Collection steps that fail are recorded in the archive index instead of aborting the dump.
+func (c *Collector) Collect(options Options) (string, error) {
+	createdAt := c.now()
+
+	if err := os.MkdirAll(options.TargetDir, os.ModePerm); err != nil {
+		return "", fmt.Errorf("failed to create dump target dir: %w", err)
+	}
+
+	archivePath := filepath.Join(options.TargetDir, fmt.Sprintf("k2s-dump-%s-%s.zip", options.Host, createdAt.Format("20060102T150405")))
+
+	index := Index{CreatedAt: createdAt, Host: options.Host}
+	for _, node := range options.Nodes {
+		index.Nodes = append(index.Nodes, node.Name)
+	}
+
+	archive, err := createArchive(archivePath, index)
+	if err != nil {
+		return "", err
+	}
+
+	err = errors.Join(
+		c.collectClusterResources(archive),
+		c.collectNodes(archive, options.Nodes),
+		c.collectFiles(archive, options.Files),
+	)
+	if err != nil {
+		archive.close()
+		os.Remove(archivePath)
+		return "", err
+	}
+
+	if err := archive.close(); err != nil {
+		os.Remove(archivePath)
+		return "", err
+	}
+	return archivePath, nil
+}
+
+func (c *Collector) collectClusterResources(archive *archive) error {
+	slog.Info("[Dump] Collecting cluster resources")
+
+	for _, resource := range clusterResources {
+		args := []string{"get", resource, "-A", "-o", "json"}
+		entry := IndexEntry{Path: "cluster/" + resource + ".json", Source: SourceCluster, Command: "kubectl " + strings.Join(args, " ")}
+
+		output, err := c.kubectl.ExecWithOutput(args...)
+		if err != nil {
+			if err := archive.add(entry, nil, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		redacted, count, err := RedactResources([]byte(output))
+		entry.Redactions = count
+		if err := archive.add(entry, redacted, err); err != nil {
+			return err
+		}
+	}
+
+	textOutputs := []struct {
+		fileName string
+		args     []string
+	}{
+		{fileName: "events.txt", args: []string{"get", "events", "-A", "-o", "wide", "--sort-by=.lastTimestamp"}},
+		{fileName: "describe-nodes.txt", args: []string{"describe", "nodes"}},
+		{fileName: "version.txt", args: []string{"version"}},
+	}
+	for _, text := range textOutputs {
+		entry := IndexEntry{Path: "cluster/" + text.fileName, Source: SourceCluster, Command: "kubectl " + strings.Join(text.args, " ")}
+		output, err := c.kubectl.ExecWithOutput(text.args...)
+		if err := c.addText(archive, entry, output, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Collector) collectNodes(archive *archive, nodes []Node) error {
+	for _, node := range nodes {
+		slog.Info("[Dump] Collecting node diagnostics", "node", node.Name)
+
+		commands := LinuxNodeCommands
+		if node.Windows {
+			commands = WindowsNodeCommands
+		}
+
+		for _, command := range commands {
+			entry := IndexEntry{Path: path.Join("nodes", node.Name, command.FileName), Source: SourceNode, Node: node.Name, Command: command.Line}
+			output, err := c.shell.Exec(node, command.Line)
+			if err := c.addText(archive, entry, output, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Collector) collectFiles(archive *archive, files []File) error {
+	for _, file := range files {
+		slog.Info("[Dump] Collecting files", "path", file.Path)
+
+		err := filepath.WalkDir(file.Path, func(filePath string, dirEntry fs.DirEntry, walkErr error) error {
+			if walkErr != nil {
+				if errors.Is(walkErr, fs.ErrNotExist) {
+					slog.Debug("[Dump] File not found, skipping", "path", filePath)
+					return nil
+				}
+				return archive.add(IndexEntry{Path: path.Join(file.ArchiveDir, filepath.Base(filePath)), Source: file.Source}, nil, walkErr)
+			}
+			if dirEntry.IsDir() || !dirEntry.Type().IsRegular() || strings.HasSuffix(filePath, ".zip") {
+				return nil
+			}
+
+			archiveName := filepath.Base(filePath)
+			if rel, err := filepath.Rel(file.Path, filePath); err == nil && rel != "." {
+				archiveName = filepath.ToSlash(rel)
+			}
+
+			content, err := os.ReadFile(filePath)
+			return c.addText(archive, IndexEntry{Path: path.Join(file.ArchiveDir, archiveName), Source: file.Source}, string(content), err)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Collector) addText(archive *archive, entry IndexEntry, text string, collectErr error) error {
+	if collectErr != nil {
+		return archive.add(entry, nil, collectErr)
+	}
+	redacted, count := RedactText(text)
+	entry.Redactions = count
+	return archive.add(entry, []byte(redacted), nil)
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package dump
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Node is a cluster node to collect system information and logs from.
+type Node struct {
+	Name      string
+	IpAddress string
+	Windows   bool
+}
+
+// NodeCommand is a diagnostics command whose output is written to the named file in the node's dump folder.
+type NodeCommand struct {
+	FileName string
+	Line     string
+}
+
+const controlPlaneLabel = "node-role.kubernetes.io/control-plane"
+
+// LinuxNodeCommands collect system information and logs of Linux nodes.
+var LinuxNodeCommands = []NodeCommand{
+	{FileName: "system.txt", Line: "uname -a; cat /etc/os-release; uptime; free -h; df -hT"},
+	{FileName: "services.txt", Line: "LC_ALL=C SYSTEMD_COLORS=0 systemctl list-units --type=service --all --no-pager --full"},
+	{FileName: "processes.txt", Line: "ps -eo pid,user,%cpu,%mem,rss,cmd --sort=-%mem"},
+	{FileName: "kubelet.log", Line: "sudo -n journalctl -u kubelet --no-pager -n 5000"},
+	{FileName: "container-runtime.log", Line: "sudo -n journalctl -u crio -u containerd --no-pager -n 5000"},
+	{FileName: "kernel.log", Line: "sudo -n dmesg -T | tail -n 2000"},
+}
+
+// WindowsNodeCommands collect system information and logs of Windows nodes; the lines are PowerShell commands.
+var WindowsNodeCommands = []NodeCommand{
+	{FileName: "system.txt", Line: "Get-ComputerInfo -Property OsName,OsVersion,OsBuildNumber,CsNumberOfLogicalProcessors,OsTotalVisibleMemorySize,OsFreePhysicalMemory | Format-List; Get-Volume | Format-Table -AutoSize"},
+	{FileName: "services.txt", Line: "Get-Service kubelet,kubeproxy,flanneld,containerd,httpproxy -ErrorAction SilentlyContinue | Format-Table -AutoSize"},
+	{FileName: "processes.txt", Line: "Get-Process | Sort-Object WorkingSet64 -Descending | Select-Object -First 100 Id,ProcessName,CPU,WorkingSet64 | Format-Table -AutoSize | Out-String -Width 512"},
+	{FileName: "network.txt", Line: "ipconfig /allcompartments; Get-NetConnectionProfile | Format-Table -AutoSize"},
+	{FileName: "system-events.log", Line: "Get-WinEvent -LogName System -MaxEvents 500 | Format-Table TimeCreated,Id,LevelDisplayName,ProviderName,Message -AutoSize -Wrap | Out-String -Width 512"},
+}
+
+// SelectNodes returns the control-plane nodes and the nodes with the given names from the 'kubectl get nodes -o json' output.
+func SelectNodes(nodeList []byte, names []string) ([]Node, error) {
+	var nodes struct {
+		Items []struct {
+			Metadata struct {
+				Name   string            `json:"name"`
+				Labels map[string]string `json:"labels"`
+			} `json:"metadata"`
+			Status struct {
+				Addresses []struct {
+					Type    string `json:"type"`
+					Address string `json:"address"`
+				} `json:"addresses"`
+				NodeInfo struct {
+					OperatingSystem string `json:"operatingSystem"`
+				} `json:"nodeInfo"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(nodeList, &nodes); err != nil {
+		return nil, fmt.Errorf("failed to parse node list: %w", err)
+	}
+
+	var selected []Node
+	var found []string
+	for _, item := range nodes.Items {
+		_, isControlPlane := item.Metadata.Labels[controlPlaneLabel]
+		isNamed := slices.ContainsFunc(names, func(name string) bool { return strings.EqualFold(name, item.Metadata.Name) })
+		if !isControlPlane && !isNamed {
+			continue
+		}
+		if isNamed {
+			found = append(found, strings.ToLower(item.Metadata.Name))
+		}
+
+		node := Node{Name: item.Metadata.Name, Windows: item.Status.NodeInfo.OperatingSystem == "windows"}
+		for _, address := range item.Status.Addresses {
+			if address.Type == "InternalIP" {
+				node.IpAddress = address.Address
+				break
+			}
+		}
+		selected = append(selected, node)
+	}
+
+	for _, name := range names {
+		if !slices.Contains(found, strings.ToLower(name)) {
+			return nil, fmt.Errorf("node '%s' not found in cluster", name)
+		}
+	}
+	return selected, nil
+}
+
+// ParseNodeNames splits the comma-separated node names.
+func ParseNodeNames(nodes string) []string {
+	var names []string
+	for name := range strings.SplitSeq(nodes, ",") {
+		if name = strings.TrimSpace(name); name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
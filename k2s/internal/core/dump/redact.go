@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package dump
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Redacted replaces secret values in the dump.
+const Redacted = "REDACTED"
+
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+var (
+	// secretKey matches key names holding secrets, e.g. 'password', 'clientSecret', 'token', 'client-key-data' or Docker's 'auth'.
+	secretKey = `[\w.-]*(?i:password|passwd|secret|token|key-data|credentials?|apikey|api[_-]key|private[_-]?key|authorization)|auth`
+
+	// blockIndicator matches the header of a YAML block scalar, e.g. '|', '>-' or '|2 # comment'
+	blockIndicator = `[|>][-+1-9]*(?:[ \t]+#[^\r\n]*)?[ \t]*`
+
+	jsonSecretPattern      = regexp.MustCompile(`("(?:` + secretKey + `)"\s*:\s*)"((?:[^"\\]|\\.)*)"`)
+	yamlSecretPattern      = regexp.MustCompile(`(?m)^(\s*-?\s*(?:` + secretKey + `)\s*:[ \t]*)([^\s#][^\r\n]*)$`)
+	yamlBlockSecretPattern = regexp.MustCompile(`^(\s*-?\s*)(?:` + secretKey + `)\s*:[ \t]*` + blockIndicator + `$`)
+	blockIndicatorPattern  = regexp.MustCompile(`^` + blockIndicator + `$`)
+	envSecretPattern       = regexp.MustCompile(`(\b(?:` + secretKey + `)=)([^\s&"']+)`)
+	urlCredentialsPattern  = regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.-]*://[^/\s:@"']+:)([^/\s@"']+)@`)
+	bearerPattern          = regexp.MustCompile(`(?i)(\bBearer\s+)([A-Za-z0-9._~+/=-]+)`)
+
+	// values that do not carry secrets, e.g. 'automountServiceAccountToken: false'
+	plainValues = []string{"", "true", "false", "null", "~", "''", `""`, "[]", "{}", Redacted}
+)
+
+// RedactText redacts secrets in free text like logs, config files or command output: values of secret keys
+// in JSON, YAML and key=value notation, passwords in URLs and bearer tokens. It returns the number of redactions.
+func RedactText(text string) (string, int) {
+	text, count := redactYamlBlockScalars(text)
+
+	// each pattern captures the prefix to keep and the secret value
+	replace := func(pattern *regexp.Regexp, replacement func(prefix string) string) {
+		text = pattern.ReplaceAllStringFunc(text, func(match string) string {
+			groups := pattern.FindStringSubmatch(match)
+			if isPlainValue(strings.TrimSpace(groups[2])) {
+				return match
+			}
+			count++
+			return replacement(groups[1])
+		})
+	}
+
+	replace(jsonSecretPattern, func(prefix string) string { return prefix + `"` + Redacted + `"` })
+	replace(yamlSecretPattern, func(prefix string) string { return prefix + Redacted })
+	replace(envSecretPattern, func(prefix string) string { return prefix + Redacted })
+	replace(urlCredentialsPattern, func(prefix string) string { return prefix + Redacted + "@" })
+	replace(bearerPattern, func(prefix string) string { return prefix + Redacted })
+
+	return text, count
+}
+
+// RedactResources redacts the data of Secrets contained in the Kubernetes resource or resource list JSON,
+// and afterwards secrets in any other resource via RedactText. It returns the number of redactions.
+func RedactResources(data []byte) ([]byte, int, error) {
+	var resources any
+	if err := json.Unmarshal(data, &resources); err != nil {
+		return nil, 0, err
+	}
+
+	count := redactSecrets(resources)
+
+	redacted, err := json.MarshalIndent(resources, "", "  ")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	text, textCount := RedactText(string(redacted))
+	return []byte(text), count + textCount, nil
+}
+
+// redactYamlBlockScalars replaces the content lines of YAML block scalars of secret keys, e.g. 'password: |'
+// followed by indented lines, by a single redacted line.
+func redactYamlBlockScalars(text string) (string, int) {
+	lines := strings.SplitAfter(text, "\n")
+
+	var result strings.Builder
+	count := 0
+	for i := 0; i < len(lines); i++ {
+		result.WriteString(lines[i])
+
+		match := yamlBlockSecretPattern.FindStringSubmatch(strings.TrimRight(lines[i], "\r\n"))
+		if match == nil {
+			continue
+		}
+
+		// the content is indented deeper than the key; blank lines within the content belong to it
+		keyIndent := len(match[1])
+		last := i
+		for j := i + 1; j < len(lines); j++ {
+			line := strings.TrimRight(lines[j], "\r\n")
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			if indentOf(line) <= keyIndent {
+				break
+			}
+			last = j
+		}
+		if last == i {
+			continue
+		}
+
+		content := lines[i+1 : last+1]
+		firstLine := content[slices.IndexFunc(content, func(line string) bool { return strings.TrimSpace(line) != "" })]
+		lineEnd := lines[last][len(strings.TrimRight(lines[last], "\r\n")):]
+
+		result.WriteString(firstLine[:indentOf(firstLine)] + Redacted + lineEnd)
+		count++
+		i = last
+	}
+	return result.String(), count
+}
+
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
+
+func redactSecrets(resource any) int {
+	object, ok := resource.(map[string]any)
+	if !ok {
+		return 0
+	}
+
+	count := 0
+	if items, ok := object["items"].([]any); ok {
+		for _, item := range items {
+			count += redactSecrets(item)
+		}
+	}
+
+	if object["kind"] != "Secret" {
+		return count
+	}
+
+	for _, field := range []string{"data", "stringData"} {
+		values, ok := object[field].(map[string]any)
+		if !ok {
+			continue
+		}
+		for key := range values {
+			values[key] = Redacted
+			count++
+		}
+	}
+
+	if metadata, ok := object["metadata"].(map[string]any); ok {
+		if annotations, ok := metadata["annotations"].(map[string]any); ok {
+			if _, found := annotations[lastAppliedAnnotation]; found {
+				annotations[lastAppliedAnnotation] = Redacted
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func isPlainValue(value string) bool {
+	// the content of block scalars is redacted separately
+	if blockIndicatorPattern.MatchString(value) {
+		return true
+	}
+	for _, plain := range plainValues {
+		if strings.EqualFold(value, plain) {
+			return true
+		}
+	}
+	return false
+}
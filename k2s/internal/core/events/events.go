@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package events
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Group aggregates the warning events of an involved object; Reason and Message are taken from the latest event.
+type Group struct {
+	Namespace string    `json:"namespace"`
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+	Count     int       `json:"count"`
+	LastSeen  time.Time `json:"lastSeen"`
+}
+
+type eventList struct {
+	Items []event `json:"items"`
+}
+
+type event struct {
+	Metadata struct {
+		Namespace         string    `json:"namespace"`
+		CreationTimestamp time.Time `json:"creationTimestamp"`
+	} `json:"metadata"`
+	InvolvedObject struct {
+		Kind string `json:"kind"`
+		Name string `json:"name"`
+	} `json:"involvedObject"`
+	Type           string     `json:"type"`
+	Reason         string     `json:"reason"`
+	Message        string     `json:"message"`
+	Count          int        `json:"count"`
+	FirstTimestamp *time.Time `json:"firstTimestamp"`
+	LastTimestamp  *time.Time `json:"lastTimestamp"`
+	EventTime      *time.Time `json:"eventTime"`
+	Series         *struct {
+		Count            int        `json:"count"`
+		LastObservedTime *time.Time `json:"lastObservedTime"`
+	} `json:"series"`
+}
+
+const (
+	warningType = "Warning"
+
+	// DefaultWindow is the period in which warning events are considered recent.
+	DefaultWindow = time.Hour
+)
+
+// SystemNamespaces are the namespaces of the K2s system components.
+var SystemNamespaces = []string{"kube-system", "kube-flannel"}
+
+// GroupWarnings groups the warning events of the given namespaces seen since the given time by involved object,
+// based on the output of 'kubectl get events -A -o json'. The groups are sorted by last seen, latest first.
+func GroupWarnings(eventsJson []byte, namespaces []string, since time.Time) ([]Group, error) {
+	var list eventList
+	if err := json.Unmarshal(eventsJson, &list); err != nil {
+		return nil, fmt.Errorf("could not unmarshal events: %w", err)
+	}
+
+	groups := []Group{}
+	indices := map[string]int{}
+	for _, e := range list.Items {
+		if e.Type != warningType || !slices.Contains(namespaces, e.Metadata.Namespace) {
+			continue
+		}
+
+		lastSeen := e.lastSeen()
+		if lastSeen.Before(since) {
+			continue
+		}
+
+		key := e.Metadata.Namespace + "/" + e.InvolvedObject.Kind + "/" + e.InvolvedObject.Name
+		index, found := indices[key]
+		if !found {
+			indices[key] = len(groups)
+			groups = append(groups, Group{
+				Namespace: e.Metadata.Namespace,
+				Kind:      e.InvolvedObject.Kind,
+				Name:      e.InvolvedObject.Name,
+				Reason:    e.Reason,
+				Message:   e.Message,
+				Count:     e.count(),
+				LastSeen:  lastSeen,
+			})
+			continue
+		}
+
+		group := &groups[index]
+		group.Count += e.count()
+		if lastSeen.After(group.LastSeen) {
+			group.Reason = e.Reason
+			group.Message = e.Message
+			group.LastSeen = lastSeen
+		}
+	}
+
+	slices.SortStableFunc(groups, func(a, b Group) int {
+		return cmp.Compare(b.LastSeen.UnixNano(), a.LastSeen.UnixNano())
+	})
+	return groups, nil
+}
+
+// lastSeen considers both the core/v1 and the events.k8s.io/v1 representation of event occurrences.
+func (e event) lastSeen() time.Time {
+	for _, timestamp := range []*time.Time{seriesLastObserved(e), e.LastTimestamp, e.EventTime, e.FirstTimestamp} {
+		if timestamp != nil && !timestamp.IsZero() {
+			return *timestamp
+		}
+	}
+	return e.Metadata.CreationTimestamp
+}
+
+func (e event) count() int {
+	if e.Series != nil && e.Series.Count > 0 {
+		return e.Series.Count
+	}
+	return max(e.Count, 1)
+}
+
+func seriesLastObserved(e event) *time.Time {
+	if e.Series == nil {
+		return nil
+	}
+	return e.Series.LastObservedTime
+}
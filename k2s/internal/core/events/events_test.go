@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package events_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/core/events"
+)
+
+func TestPkg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "events pkg Unit Tests", Label("unit", "ci", "events"))
+}
+
+var _ = BeforeSuite(func() {
+	slog.SetDefault(slog.New(logr.ToSlogHandler(GinkgoLogr)))
+})
+
+var _ = Describe("GroupWarnings", func() {
+	since := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+
+	It("groups recent warnings of the given namespaces by involved object, latest first", func() {
+		eventsJson := `{"items":[
+			{"metadata":{"namespace":"kube-system"},"involvedObject":{"kind":"Pod","name":"coredns-1"},"type":"Warning","reason":"BackOff","message":"Back-off restarting failed container","count":5,"lastTimestamp":"2026-10-18T10:20:00Z"},
+			{"metadata":{"namespace":"kube-system"},"involvedObject":{"kind":"Pod","name":"coredns-1"},"type":"Warning","reason":"Unhealthy","message":"Readiness probe failed","count":2,"lastTimestamp":"2026-10-18T10:10:00Z"},
+			{"metadata":{"namespace":"kube-system"},"involvedObject":{"kind":"Pod","name":"coredns-1"},"type":"Normal","reason":"Pulled","count":1,"lastTimestamp":"2026-10-18T10:30:00Z"},
+			{"metadata":{"namespace":"logging"},"involvedObject":{"kind":"Pod","name":"fluent-bit"},"type":"Warning","reason":"FailedMount","message":"volume not found","eventTime":"2026-10-18T10:40:00.000000Z","series":{"count":3,"lastObservedTime":"2026-10-18T10:45:00.000000Z"}},
+			{"metadata":{"namespace":"kube-flannel"},"involvedObject":{"kind":"Pod","name":"flannel"},"type":"Warning","reason":"BackOff","count":1,"lastTimestamp":"2026-10-18T09:00:00Z"},
+			{"metadata":{"namespace":"default"},"involvedObject":{"kind":"Pod","name":"app"},"type":"Warning","reason":"BackOff","count":1,"lastTimestamp":"2026-10-18T10:50:00Z"}]}`
+
+		groups, err := events.GroupWarnings([]byte(eventsJson), []string{"kube-system", "kube-flannel", "logging"}, since)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(groups).To(Equal([]events.Group{
+			{Namespace: "logging", Kind: "Pod", Name: "fluent-bit", Reason: "FailedMount", Message: "volume not found", Count: 3, LastSeen: time.Date(2026, 10, 18, 10, 45, 0, 0, time.UTC)},
+			{Namespace: "kube-system", Kind: "Pod", Name: "coredns-1", Reason: "BackOff", Message: "Back-off restarting failed container", Count: 7, LastSeen: time.Date(2026, 10, 18, 10, 20, 0, 0, time.UTC)},
+		}))
+	})
+
+	It("returns empty groups if there are no warnings", func() {
+		groups, err := events.GroupWarnings([]byte(`{"items":[]}`), events.SystemNamespaces, since)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(groups).ToNot(BeNil())
+		Expect(groups).To(BeEmpty())
+	})
+
+	It("fails on invalid JSON", func() {
+		_, err := events.GroupWarnings([]byte("{"), events.SystemNamespaces, since)
+
+		Expect(err).To(MatchError(ContainSubstring("could not unmarshal events")))
+	})
+})
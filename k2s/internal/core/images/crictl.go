@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package images
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseCrictlImageCreation returns the creation times by image ID (without 'sha256:' prefix) from the output of
+// 'crictl inspecti -o json'. Depending on the crictl version, the images are a JSON array or consecutive objects.
+func ParseCrictlImageCreation(output []byte) (map[string]time.Time, error) {
+	type imageSpec struct {
+		Created string `json:"created"`
+	}
+	type inspectedImage struct {
+		Status struct {
+			Id string `json:"id"`
+		} `json:"status"`
+		Info struct {
+			ImageSpec *imageSpec `json:"imageSpec"`
+			// some runtimes nest the verbose info once more
+			Info *struct {
+				ImageSpec *imageSpec `json:"imageSpec"`
+			} `json:"info"`
+		} `json:"info"`
+	}
+
+	var inspected []inspectedImage
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	for {
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("parsing crictl image details: %w", err)
+		}
+
+		if trimmed := bytes.TrimSpace(value); len(trimmed) > 0 && trimmed[0] == '[' {
+			var list []inspectedImage
+			if err := json.Unmarshal(value, &list); err != nil {
+				return nil, fmt.Errorf("parsing crictl image details: %w", err)
+			}
+			inspected = append(inspected, list...)
+			continue
+		}
+		var image inspectedImage
+		if err := json.Unmarshal(value, &image); err != nil {
+			return nil, fmt.Errorf("parsing crictl image details: %w", err)
+		}
+		inspected = append(inspected, image)
+	}
+
+	created := map[string]time.Time{}
+	for _, image := range inspected {
+		spec := image.Info.ImageSpec
+		if spec == nil && image.Info.Info != nil {
+			spec = image.Info.Info.ImageSpec
+		}
+		if spec == nil || image.Status.Id == "" {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339Nano, spec.Created); err == nil {
+			created[strings.TrimPrefix(image.Status.Id, "sha256:")] = t
+		}
+	}
+	return created, nil
+}
+
+// ParseCrictlImageLastUse returns the creation time of the most recent container per image from the output of
+// 'crictl ps -a -o json'. The keys are image IDs (without 'sha256:' prefix) or, for runtimes referencing images by
+// digest, repository digests. Only containers not yet garbage-collected by the kubelet are known.
+func ParseCrictlImageLastUse(output []byte) (map[string]time.Time, error) {
+	var result struct {
+		Containers []struct {
+			ImageRef string `json:"imageRef"`
+			Image    struct {
+				Image string `json:"image"`
+			} `json:"image"`
+			CreatedAt string `json:"createdAt"`
+		} `json:"containers"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil, fmt.Errorf("parsing crictl container list: %w", err)
+	}
+
+	lastUse := map[string]time.Time{}
+	for _, container := range result.Containers {
+		nanos, err := strconv.ParseInt(container.CreatedAt, 10, 64)
+		if err != nil {
+			continue
+		}
+		ref := container.ImageRef
+		if ref == "" {
+			ref = container.Image.Image
+		}
+		if _, digest, found := strings.Cut(ref, "@"); found {
+			ref = digest
+		} else {
+			ref = strings.TrimPrefix(ref, "sha256:")
+		}
+		if ref == "" {
+			continue
+		}
+
+		if created := time.Unix(0, nanos).UTC(); created.After(lastUse[ref]) {
+			lastUse[ref] = created
+		}
+	}
+	return lastUse, nil
+}
+
+// LookupImageTime returns the time recorded for the image with the given, possibly truncated ID or with the given
+// digest; zero if there is none.
+func LookupImageTime(times map[string]time.Time, imageId string, digest string) time.Time {
+	if digest != "" {
+		if t, found := times[digest]; found {
+			return t
+		}
+	}
+	imageId = strings.TrimPrefix(imageId, "sha256:")
+	if imageId == "" {
+		return time.Time{}
+	}
+	for id, t := range times {
+		if strings.HasPrefix(id, imageId) {
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package images_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/siemens-healthineers/k2s/internal/core/images"
+)
+
+var _ = Describe("crictl", func() {
+	const (
+		appId   = "4c1d5fbd2e8a7c31f0e6a9b8d7c6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8"
+		toolId  = "9f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a39281706f5e4d3c2b1a0"
+		inspect = `{
+  "status": {"id": "sha256:` + appId + `", "repoTags": ["k2s.registry.local/app:v1"]},
+  "info": {"imageSpec": {"created": "2026-01-10T08:00:00.123456789Z"}}
+}
+{
+  "status": {"id": "sha256:` + toolId + `", "repoTags": ["k2s.registry.local/tool:v1"]},
+  "info": {"info": {"imageSpec": {"created": "2026-05-20T08:00:00Z"}}}
+}`
+		containers = `{"containers": [
+  {"id": "c1", "imageRef": "sha256:` + appId + `", "createdAt": "1768032000000000000"},
+  {"id": "c2", "imageRef": "sha256:` + appId + `", "createdAt": "1771000000000000000"},
+  {"id": "c3", "imageRef": "docker.io/library/nginx@sha256:222", "createdAt": "1777000000000000000"},
+  {"id": "c4", "image": {"image": "sha256:` + toolId + `"}, "createdAt": "invalid"}
+]}`
+	)
+
+	Describe("ParseCrictlImageCreation", func() {
+		It("parses consecutive objects of both info layouts", func() {
+			created, err := images.ParseCrictlImageCreation([]byte(inspect))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(HaveLen(2))
+			Expect(created[appId]).To(Equal(time.Date(2026, 1, 10, 8, 0, 0, 123456789, time.UTC)))
+			Expect(created[toolId]).To(Equal(time.Date(2026, 5, 20, 8, 0, 0, 0, time.UTC)))
+		})
+
+		It("parses a JSON array", func() {
+			created, err := images.ParseCrictlImageCreation([]byte(`[{"status": {"id": "sha256:` + appId + `"}, "info": {"imageSpec": {"created": "2026-01-10T08:00:00Z"}}}]`))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(HaveKeyWithValue(appId, time.Date(2026, 1, 10, 8, 0, 0, 0, time.UTC)))
+		})
+
+		It("fails on invalid output", func() {
+			_, err := images.ParseCrictlImageCreation([]byte(`{"status": `))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ParseCrictlImageLastUse", func() {
+		It("returns the most recent container per image ID or digest", func() {
+			lastUse, err := images.ParseCrictlImageLastUse([]byte(containers))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lastUse).To(Equal(map[string]time.Time{
+				appId:        time.Unix(1771000000, 0).UTC(),
+				"sha256:222": time.Unix(1777000000, 0).UTC(),
+			}))
+		})
+	})
+
+	Describe("LookupImageTime", func() {
+		times := map[string]time.Time{appId: time.Unix(1, 0), "sha256:222": time.Unix(2, 0)}
+
+		DescribeTable("finds the time of an image",
+			func(imageId string, digest string, expected time.Time) {
+				Expect(images.LookupImageTime(times, imageId, digest)).To(Equal(expected))
+			},
+			Entry("by truncated ID", appId[:12], "", time.Unix(1, 0)),
+			Entry("by ID with prefix", "sha256:"+appId, "", time.Unix(1, 0)),
+			Entry("by digest", "unknown", "sha256:222", time.Unix(2, 0)),
+			Entry("none if unknown", "unknown", "sha256:333", time.Time{}),
+			Entry("none without ID", "", "", time.Time{}),
+		)
+	})
+
+	It("lets an old image not used for the given duration be pruned", func() {
+		now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+		created, err := images.ParseCrictlImageCreation([]byte(inspect))
+		Expect(err).ToNot(HaveOccurred())
+		lastUse, err := images.ParseCrictlImageLastUse([]byte(containers))
+		Expect(err).ToNot(HaveOccurred())
+
+		var nodeImages []images.NodeImage
+		for _, id := range []string{appId, toolId} {
+			nodeImages = append(nodeImages, images.NodeImage{
+				ImageId:        id[:12],
+				Repository:     "k2s.registry.local/" + id[:4],
+				Tag:            "v1",
+				Node:           "n1",
+				CompressedSize: 10,
+				Created:        images.LookupImageTime(created, id[:12], ""),
+				LastUsed:       images.LookupImageTime(lastUse, id[:12], ""),
+			})
+		}
+
+		plans := images.PlanPrune(nodeImages, nil, images.PrunePolicy{UnusedFor: 30 * 24 * time.Hour}, now)
+
+		Expect(plans).To(HaveLen(1))
+		Expect(plans[0].Images).To(HaveLen(1), "the tool image was created 12 days ago, the app image last used in February")
+		Expect(plans[0].Images[0].ImageId).To(Equal(appId[:12]))
+	})
+})
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package images
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Filter selects images; all of its conditions must match.
+type Filter struct {
+	conditions []condition
+}
+
+type condition struct {
+	key      string
+	operator string
+	value    string
+	size     int64
+	pattern  *regexp.Regexp
+}
+
+var (
+	// longer operators first, so that '!=' is not parsed as '='
+	filterOperators = []string{"!=", ">=", "<=", "=", ">", "<"}
+	filterKeys      = []string{"reference", "node", "dangling", "unused", "size"}
+)
+
+// ParseFilter parses filter expressions of the form '<key><operator><value>', each expression
+// possibly containing several comma-separated conditions. Supported keys:
+//   - reference: '=' or '!=' with a wildcard pattern ('*', '?') matched against 'repository:tag' and 'repository'
+//   - node: '=' or '!=' with a node name
+//   - dangling, unused: '=' or '!=' with 'true' or 'false'
+//   - size: '=', '!=', '<', '<=', '>', '>=' with a size (e.g. '100MB'), compared to the compressed size
+func ParseFilter(expressions []string) (*Filter, error) {
+	filter := &Filter{}
+
+	for _, expression := range expressions {
+		for part := range strings.SplitSeq(expression, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+
+			c, err := parseCondition(part)
+			if err != nil {
+				return nil, err
+			}
+			filter.conditions = append(filter.conditions, *c)
+		}
+	}
+	return filter, nil
+}
+
+// Apply returns the images matching the filter.
+func (f *Filter) Apply(images []Image) []Image {
+	var result []Image
+	for _, image := range images {
+		if f.Matches(image) {
+			result = append(result, image)
+		}
+	}
+	return result
+}
+
+// Matches returns true if the image satisfies all conditions.
+func (f *Filter) Matches(image Image) bool {
+	for _, c := range f.conditions {
+		if !c.matches(image) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseCondition(expression string) (*condition, error) {
+	for _, operator := range filterOperators {
+		key, value, found := strings.Cut(expression, operator)
+		if !found {
+			continue
+		}
+
+		c := &condition{key: strings.ToLower(strings.TrimSpace(key)), operator: operator, value: strings.TrimSpace(value)}
+
+		if !slices.Contains(filterKeys, c.key) {
+			return nil, fmt.Errorf("unknown filter key '%s' in '%s', supported keys: %s", c.key, expression, strings.Join(filterKeys, ", "))
+		}
+		if c.key != "size" && operator != "=" && operator != "!=" {
+			return nil, fmt.Errorf("operator '%s' not supported for filter key '%s'", operator, c.key)
+		}
+
+		switch c.key {
+		case "dangling", "unused":
+			if _, err := strconv.ParseBool(c.value); err != nil {
+				return nil, fmt.Errorf("invalid value '%s' for filter key '%s', expected 'true' or 'false'", c.value, c.key)
+			}
+		case "reference":
+			c.pattern = wildcardPattern(c.value)
+		case "size":
+			size, err := ParseSize(c.value)
+			if err != nil {
+				return nil, err
+			}
+			c.size = size
+		}
+		return c, nil
+	}
+	return nil, fmt.Errorf("invalid filter '%s', expected '<key><operator><value>'", expression)
+}
+
+func (c condition) matches(image Image) bool {
+	var matched bool
+
+	switch c.key {
+	case "reference":
+		matched = slices.ContainsFunc(image.References, func(reference string) bool {
+			repository := reference
+			if index := strings.LastIndex(reference, ":"); index > strings.LastIndex(reference, "/") {
+				repository = reference[:index]
+			}
+			return c.pattern.MatchString(reference) || c.pattern.MatchString(repository)
+		})
+	case "node":
+		matched = slices.Contains(image.Nodes, c.value)
+	case "dangling":
+		value, _ := strconv.ParseBool(c.value)
+		matched = image.Dangling == value
+	case "unused":
+		value, _ := strconv.ParseBool(c.value)
+		matched = image.Unused == value
+	case "size":
+		return compareSize(image.CompressedSize, c.operator, c.size)
+	}
+
+	if c.operator == "!=" {
+		return !matched
+	}
+	return matched
+}
+
+// wildcardPattern converts a pattern with '*' (any characters, including '/') and '?' (single character) to a regular expression.
+func wildcardPattern(pattern string) *regexp.Regexp {
+	quoted := regexp.QuoteMeta(pattern)
+	quoted = strings.ReplaceAll(quoted, `\*`, ".*")
+	quoted = strings.ReplaceAll(quoted, `\?`, ".")
+	return regexp.MustCompile("^" + quoted + "$")
+}
+
+func compareSize(actual int64, operator string, expected int64) bool {
+	switch operator {
+	case "=":
+		return actual == expected
+	case "!=":
+		return actual != expected
+	case ">":
+		return actual > expected
+	case ">=":
+		return actual >= expected
+	case "<":
+		return actual < expected
+	default:
+		return actual <= expected
+	}
+}
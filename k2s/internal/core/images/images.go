@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+// Package images builds a content-addressed inventory of the container images
+// stored on the cluster nodes.
+package images
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const noneValue = "<none>"
+
+// referencePattern matches image references following the distribution reference grammar:
+// [domain[:port]/]path[/path...][:tag][@digest]
+var referencePattern = regexp.MustCompile(`^(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?)*(?::[0-9]+)?/)?` +
+	`[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*` +
+	`(?::[\w][\w.-]{0,127})?(?:@[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,})?$`)
+
+// NodeImage is a single image as stored on a single node.
+type NodeImage struct {
+	ImageId          string
+	Repository       string
+	Tag              string
+	Digest           string
+	Node             string
+	CompressedSize   int64
+	UncompressedSize int64
+	Created          time.Time // Zero if unknown
+	LastUsed         time.Time // Creation of the most recent container from the image, zero if unknown
+}
+
+// Image is an image aggregated across all nodes holding it. Sizes are in bytes, 0 means unknown.
+type Image struct {
+	Digest           string   `json:"digest,omitempty" yaml:"digest,omitempty"`
+	ImageId          string   `json:"imageid" yaml:"imageid"`
+	References       []string `json:"references" yaml:"references"`
+	Nodes            []string `json:"nodes" yaml:"nodes"`
+	CompressedSize   int64    `json:"compressedsize" yaml:"compressedsize"`
+	UncompressedSize int64    `json:"uncompressedsize" yaml:"uncompressedsize"`
+	Dangling         bool     `json:"dangling" yaml:"dangling"`
+	Unused           bool     `json:"unused" yaml:"unused"`
+}
+
+// Aggregate groups the node images by digest and image ID. A multi-arch digest is shared by the platform
+// variants of an image, e.g. on Linux and Windows nodes, which differ in their image IDs and are kept apart.
+// podImages are the image references and image IDs used by the cluster's Pods; images not referenced by any
+// Pod are marked as unused.
+func Aggregate(nodeImages []NodeImage, podImages []string) []Image {
+	var result []Image
+	indices := map[string]int{}
+
+	for _, nodeImage := range nodeImages {
+		key := nodeImage.Digest + "/" + nodeImage.ImageId
+
+		index, found := indices[key]
+		if !found {
+			index = len(result)
+			indices[key] = index
+			result = append(result, Image{Digest: nodeImage.Digest, ImageId: nodeImage.ImageId})
+		}
+
+		image := &result[index]
+		if reference := Reference(nodeImage.Repository, nodeImage.Tag); reference != "" && !slices.Contains(image.References, reference) {
+			image.References = append(image.References, reference)
+		}
+		if !slices.Contains(image.Nodes, nodeImage.Node) {
+			image.Nodes = append(image.Nodes, nodeImage.Node)
+		}
+		image.CompressedSize = max(image.CompressedSize, nodeImage.CompressedSize)
+		image.UncompressedSize = max(image.UncompressedSize, nodeImage.UncompressedSize)
+	}
+
+	for i := range result {
+		image := &result[i]
+		slices.Sort(image.References)
+		slices.Sort(image.Nodes)
+		if image.References == nil {
+			image.References = []string{}
+		}
+		image.Dangling = len(image.References) == 0
+		image.Unused = !isUsed(*image, podImages)
+	}
+
+	slices.SortStableFunc(result, func(a, b Image) int {
+		return strings.Compare(firstReference(a), firstReference(b))
+	})
+	return result
+}
+
+// Reference returns 'repository:tag' or an empty string for untagged images.
+func Reference(repository, tag string) string {
+	if repository == "" || repository == noneValue {
+		return ""
+	}
+	if tag == "" || tag == noneValue {
+		return repository
+	}
+	return repository + ":" + tag
+}
+
+// ValidateReference returns an error if the reference is not a valid image reference, e.g. contains whitespace
+// or shell metacharacters.
+func ValidateReference(reference string) error {
+	if !referencePattern.MatchString(reference) {
+		return fmt.Errorf("invalid image reference '%s'", reference)
+	}
+	return nil
+}
+
+// NormalizeReference expands short Docker Hub references and implicit tags,
+// e.g. 'nginx' to 'docker.io/library/nginx:latest'. Digests are stripped.
+func NormalizeReference(reference string) string {
+	name, _, _ := strings.Cut(reference, "@")
+
+	firstPart, _, hasSlash := strings.Cut(name, "/")
+	switch {
+	case !hasSlash:
+		name = "docker.io/library/" + name
+	case !strings.ContainsAny(firstPart, ".:") && firstPart != "localhost":
+		name = "docker.io/" + name
+	}
+
+	if lastPart := name[strings.LastIndex(name, "/")+1:]; !strings.Contains(lastPart, ":") {
+		name += ":latest"
+	}
+	return name
+}
+
+func isUsed(image Image, podImages []string) bool {
+	for _, podImage := range podImages {
+		if image.Digest != "" && strings.HasSuffix(podImage, image.Digest) {
+			return true
+		}
+
+		id := strings.TrimPrefix(image.ImageId, "sha256:")
+		if id != "" && strings.HasPrefix(strings.TrimPrefix(podImage, "sha256:"), id) {
+			return true
+		}
+
+		for _, reference := range image.References {
+			if NormalizeReference(reference) == NormalizeReference(podImage) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func firstReference(image Image) string {
+	if len(image.References) == 0 {
+		// untagged images last
+		return "~" + image.ImageId
+	}
+	return image.References[0]
+}
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package images_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/siemens-healthineers/k2s/internal/core/images"
+)
+
+func TestImages(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "images Unit Tests", Label("unit", "ci", "images"))
+}
+
+var _ = Describe("images", func() {
+	Describe("Aggregate", func() {
+		It("merges the same image on several nodes by digest", func() {
+			nodeImages := []images.NodeImage{
+				{ImageId: "aaa", Repository: "docker.io/library/nginx", Tag: "1.27", Digest: "sha256:111", Node: "worker-1", CompressedSize: 100},
+				{ImageId: "aaa", Repository: "docker.io/library/nginx", Tag: "latest", Digest: "sha256:111", Node: "worker-2", CompressedSize: 100, UncompressedSize: 300},
+				{ImageId: "bbb", Repository: "<none>", Tag: "<none>", Node: "winnode", CompressedSize: 50},
+				{ImageId: "bbb", Repository: "<none>", Tag: "<none>", Node: "winnode", CompressedSize: 50},
+			}
+
+			result := images.Aggregate(nodeImages, []string{"nginx:1.27"})
+
+			Expect(result).To(Equal([]images.Image{
+				{
+					Digest:           "sha256:111",
+					ImageId:          "aaa",
+					References:       []string{"docker.io/library/nginx:1.27", "docker.io/library/nginx:latest"},
+					Nodes:            []string{"worker-1", "worker-2"},
+					CompressedSize:   100,
+					UncompressedSize: 300,
+				},
+				{
+					ImageId:        "bbb",
+					References:     []string{},
+					Nodes:          []string{"winnode"},
+					CompressedSize: 50,
+					Dangling:       true,
+					Unused:         true,
+				},
+			}))
+		})
+
+		It("keeps platform variants of a multi-arch image apart", func() {
+			nodeImages := []images.NodeImage{
+				{ImageId: "aaa", Repository: "docker.io/library/pause", Tag: "3.10", Digest: "sha256:111", Node: "kubemaster"},
+				{ImageId: "aaa", Repository: "docker.io/library/pause", Tag: "3.10", Digest: "sha256:111", Node: "worker-1"},
+				{ImageId: "bbb", Repository: "docker.io/library/pause", Tag: "3.10", Digest: "sha256:111", Node: "winnode"},
+			}
+
+			result := images.Aggregate(nodeImages, nil)
+
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].ImageId).To(Equal("aaa"))
+			Expect(result[0].Nodes).To(Equal([]string{"kubemaster", "worker-1"}))
+			Expect(result[1].ImageId).To(Equal("bbb"))
+			Expect(result[1].Nodes).To(Equal([]string{"winnode"}))
+		})
+
+		DescribeTable("detects usage by Pods",
+			func(podImage string, expectedUnused bool) {
+				nodeImages := []images.NodeImage{{ImageId: "0123456789ab", Repository: "docker.io/library/nginx", Tag: "latest", Digest: "sha256:fff", Node: "n"}}
+
+				result := images.Aggregate(nodeImages, []string{podImage})
+
+				Expect(result[0].Unused).To(Equal(expectedUnused))
+			},
+			Entry("short reference with implicit tag", "nginx", false),
+			Entry("digest reference", "docker.io/library/nginx@sha256:fff", false),
+			Entry("image ID", "sha256:0123456789abcdef", false),
+			Entry("other image", "docker.io/library/redis:latest", true),
+		)
+	})
+
+	Describe("NormalizeReference", func() {
+		DescribeTable("expands Docker Hub references",
+			func(reference, expected string) {
+				Expect(images.NormalizeReference(reference)).To(Equal(expected))
+			},
+			Entry("official image", "nginx", "docker.io/library/nginx:latest"),
+			Entry("user image", "user/app:1.0", "docker.io/user/app:1.0"),
+			Entry("registry with port", "k2s.registry.local:30500/app", "k2s.registry.local:30500/app:latest"),
+			Entry("digest", "quay.io/app@sha256:123", "quay.io/app:latest"),
+		)
+	})
+
+	Describe("ValidateReference", func() {
+		DescribeTable("accepts valid references",
+			func(reference string) {
+				Expect(images.ValidateReference(reference)).To(Succeed())
+			},
+			Entry("official image", "nginx"),
+			Entry("registry with port and tag", "k2s.registry.local:30500/team/my-app:v1.0"),
+			Entry("digest", "quay.io/app@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+		)
+
+		DescribeTable("rejects invalid references",
+			func(reference string) {
+				Expect(images.ValidateReference(reference)).To(MatchError("invalid image reference '" + reference + "'"))
+			},
+			Entry("empty", ""),
+			Entry("command substitution", "app:v1$(reboot)"),
+			Entry("command separator", "app:v1; rm -rf /"),
+			Entry("quote", "app'"),
+			Entry("upper-case path", "App"),
+		)
+	})
+
+	Describe("ParseSize", func() {
+		DescribeTable("converts to bytes",
+			func(size string, expected int64) {
+				actual, err := images.ParseSize(size)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(Equal(expected))
+			},
+			Entry("plain bytes", "12345", int64(12345)),
+			Entry("decimal unit", "75.2MB", int64(75_200_000)),
+			Entry("binary unit with space", "1.5 KiB", int64(1536)),
+			Entry("lower-case unit", "2kb", int64(2000)),
+		)
+
+		It("returns an error on unknown units", func() {
+			_, err := images.ParseSize("3 parsecs")
+
+			Expect(err).To(MatchError(ContainSubstring("unknown unit")))
+		})
+	})
+
+	Describe("FormatSize", func() {
+		It("formats with decimal units", func() {
+			Expect(images.FormatSize(75_200_000)).To(Equal("75.2MB"))
+			Expect(images.FormatSize(999)).To(Equal("999B"))
+			Expect(images.FormatSize(0)).To(Equal("-"))
+		})
+	})
+
+	Describe("Filter", func() {
+		inventory := []images.Image{
+			{ImageId: "a", References: []string{"docker.io/library/nginx:latest"}, Nodes: []string{"n1", "n2"}, CompressedSize: 200_000_000},
+			{ImageId: "b", References: []string{"k2s.registry.local/app:v1"}, Nodes: []string{"n1"}, CompressedSize: 10_000_000, Unused: true},
+			{ImageId: "c", References: []string{}, Nodes: []string{"n2"}, Dangling: true, Unused: true},
+		}
+
+		DescribeTable("selects matching images",
+			func(expressions []string, expectedIds []string) {
+				filter, err := images.ParseFilter(expressions)
+				Expect(err).ToNot(HaveOccurred())
+
+				var ids []string
+				for _, image := range filter.Apply(inventory) {
+					ids = append(ids, image.ImageId)
+				}
+				Expect(ids).To(Equal(expectedIds))
+			},
+			Entry("no filter", nil, []string{"a", "b", "c"}),
+			Entry("reference with wildcard", []string{"reference=*nginx*"}, []string{"a"}),
+			Entry("reference without tag", []string{"reference=k2s.registry.local/app"}, []string{"b"}),
+			Entry("node", []string{"node=n2"}, []string{"a", "c"}),
+			Entry("negated node", []string{"node!=n2"}, []string{"b"}),
+			Entry("dangling", []string{"dangling=true"}, []string{"c"}),
+			Entry("size", []string{"size>100MB"}, []string{"a"}),
+			Entry("combined conditions", []string{"unused=true,dangling=false"}, []string{"b"}),
+			Entry("several expressions", []string{"node=n1", "size<=10MB"}, []string{"b"}),
+		)
+
+		DescribeTable("rejects invalid expressions",
+			func(expression, expectedError string) {
+				_, err := images.ParseFilter([]string{expression})
+
+				Expect(err).To(MatchError(ContainSubstring(expectedError)))
+			},
+			Entry("missing operator", "dangling", "invalid filter"),
+			Entry("unknown key", "color=red", "unknown filter key"),
+			Entry("unsupported operator", "node>n1", "operator '>' not supported"),
+			Entry("invalid boolean", "unused=maybe", "expected 'true' or 'false'"),
+			Entry("invalid size", "size>big", "invalid size"),
+		)
+	})
+})
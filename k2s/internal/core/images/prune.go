@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package images
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// PrunePolicy selects the images to be removed from the node image stores. All criteria set
+// must be met; images used by Pods are never pruned.
+type PrunePolicy struct {
+	UnusedFor    time.Duration // Minimum time since an image was last used, 0 disables the criterion; images of unknown age are kept
+	KeepLatest   int           // Number of most recent images to keep per repository and node, 0 disables the criterion
+	DanglingOnly bool
+	MaxUsage     int64 // In bytes; if set, only nodes exceeding it are pruned, oldest images first, until falling below
+}
+
+// PruneCandidate is an image to be removed from a node.
+type PruneCandidate struct {
+	ImageId    string    `json:"imageid" yaml:"imageid"`
+	References []string  `json:"references" yaml:"references"`
+	Size       int64     `json:"size" yaml:"size"`
+	Created    time.Time `json:"created,omitzero" yaml:"created,omitempty"`
+	LastUsed   time.Time `json:"lastUsed,omitzero" yaml:"lastUsed,omitempty"`
+}
+
+// NodePrunePlan lists the images to be removed from a single node. Sizes are in bytes.
+type NodePrunePlan struct {
+	Node      string           `json:"node" yaml:"node"`
+	Usage     int64            `json:"usage" yaml:"usage"`
+	Reclaimed int64            `json:"reclaimed" yaml:"reclaimed"`
+	Images    []PruneCandidate `json:"images" yaml:"images"`
+}
+
+type nodeEntry struct {
+	PruneCandidate
+	digest string
+}
+
+// unusedSince returns the time since which the image has not been used: the start of its most recent container if
+// known, else its creation, since an image never run has not been used since it exists. Zero if unknown.
+func (e *nodeEntry) unusedSince() time.Time {
+	if !e.LastUsed.IsZero() {
+		return e.LastUsed
+	}
+	return e.Created
+}
+
+// PlanPrune evaluates the policy per node. podImages are the image references and image IDs used by
+// the cluster's Pods. The disk usage of an image is its uncompressed size if known, else its compressed size.
+func PlanPrune(nodeImages []NodeImage, podImages []string, policy PrunePolicy, now time.Time) []NodePrunePlan {
+	var nodes []string
+	entriesByNode := map[string][]*nodeEntry{}
+
+	for _, nodeImage := range nodeImages {
+		entries, found := entriesByNode[nodeImage.Node]
+		if !found {
+			nodes = append(nodes, nodeImage.Node)
+		}
+
+		index := slices.IndexFunc(entries, func(e *nodeEntry) bool { return e.ImageId == nodeImage.ImageId })
+		if index < 0 {
+			entries = append(entries, &nodeEntry{
+				PruneCandidate: PruneCandidate{ImageId: nodeImage.ImageId, References: []string{}},
+				digest:         nodeImage.Digest,
+			})
+			index = len(entries) - 1
+		}
+
+		entry := entries[index]
+		if reference := Reference(nodeImage.Repository, nodeImage.Tag); reference != "" && !slices.Contains(entry.References, reference) {
+			entry.References = append(entry.References, reference)
+		}
+		entry.Size = max(entry.Size, diskUsage(nodeImage))
+		if nodeImage.Created.After(entry.Created) {
+			entry.Created = nodeImage.Created
+		}
+		if nodeImage.LastUsed.After(entry.LastUsed) {
+			entry.LastUsed = nodeImage.LastUsed
+		}
+		entriesByNode[nodeImage.Node] = entries
+	}
+
+	slices.Sort(nodes)
+
+	plans := make([]NodePrunePlan, 0, len(nodes))
+	for _, node := range nodes {
+		plans = append(plans, planNode(node, entriesByNode[node], podImages, policy, now))
+	}
+	return plans
+}
+
+func planNode(node string, entries []*nodeEntry, podImages []string, policy PrunePolicy, now time.Time) NodePrunePlan {
+	plan := NodePrunePlan{Node: node, Images: []PruneCandidate{}}
+	for _, entry := range entries {
+		plan.Usage += entry.Size
+	}
+
+	if policy.MaxUsage > 0 && plan.Usage <= policy.MaxUsage {
+		return plan
+	}
+
+	kept := keepLatest(entries, policy.KeepLatest)
+
+	var candidates []*nodeEntry
+	for _, entry := range entries {
+		switch {
+		case kept[entry]:
+		case isUsed(Image{Digest: entry.digest, ImageId: entry.ImageId, References: entry.References}, podImages):
+		case policy.DanglingOnly && len(entry.References) > 0:
+		case policy.UnusedFor > 0 && (entry.unusedSince().IsZero() || now.Sub(entry.unusedSince()) < policy.UnusedFor):
+		default:
+			candidates = append(candidates, entry)
+		}
+	}
+
+	// least recently used first, so that a usage limit removes the oldest images; images of unknown age last
+	slices.SortStableFunc(candidates, func(a, b *nodeEntry) int {
+		aSince, bSince := a.unusedSince(), b.unusedSince()
+		if aSince.IsZero() != bSince.IsZero() {
+			if aSince.IsZero() {
+				return 1
+			}
+			return -1
+		}
+		if c := aSince.Compare(bSince); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Size, a.Size)
+	})
+
+	for _, candidate := range candidates {
+		if policy.MaxUsage > 0 && plan.Usage-plan.Reclaimed <= policy.MaxUsage {
+			break
+		}
+		slices.Sort(candidate.References)
+		plan.Images = append(plan.Images, candidate.PruneCandidate)
+		plan.Reclaimed += candidate.Size
+	}
+	return plan
+}
+
+// keepLatest returns the n most recent images per repository; images of unknown age count as most recent
+func keepLatest(entries []*nodeEntry, n int) map[*nodeEntry]bool {
+	kept := map[*nodeEntry]bool{}
+	if n <= 0 {
+		return kept
+	}
+
+	byRepository := map[string][]*nodeEntry{}
+	for _, entry := range entries {
+		for _, reference := range entry.References {
+			repository := repositoryOf(reference)
+			if !slices.Contains(byRepository[repository], entry) {
+				byRepository[repository] = append(byRepository[repository], entry)
+			}
+		}
+	}
+
+	for _, repositoryEntries := range byRepository {
+		slices.SortStableFunc(repositoryEntries, func(a, b *nodeEntry) int {
+			if a.Created.IsZero() || b.Created.IsZero() {
+				return cmp.Compare(boolRank(b.Created.IsZero()), boolRank(a.Created.IsZero()))
+			}
+			return b.Created.Compare(a.Created)
+		})
+		for _, entry := range repositoryEntries[:min(n, len(repositoryEntries))] {
+			kept[entry] = true
+		}
+	}
+	return kept
+}
+
+func repositoryOf(reference string) string {
+	name := NormalizeReference(reference)
+	return name[:strings.LastIndex(name, ":")]
+}
+
+func diskUsage(image NodeImage) int64 {
+	if image.UncompressedSize > 0 {
+		return image.UncompressedSize
+	}
+	return image.CompressedSize
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
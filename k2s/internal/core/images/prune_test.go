@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package images_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/siemens-healthineers/k2s/internal/core/images"
+)
+
+var _ = Describe("prune", func() {
+	now := time.Date(2026, 5, 20, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+
+	nodeImages := []images.NodeImage{
+		{ImageId: "app1", Repository: "k2s.registry.local/app", Tag: "v1", Node: "n1", CompressedSize: 100, Created: daysAgo(30)},
+		{ImageId: "app2", Repository: "k2s.registry.local/app", Tag: "v2", Node: "n1", CompressedSize: 100, UncompressedSize: 250, Created: daysAgo(10)},
+		{ImageId: "app3", Repository: "k2s.registry.local/app", Tag: "v3", Node: "n1", CompressedSize: 100, Created: daysAgo(1)},
+		{ImageId: "nginx", Repository: "docker.io/library/nginx", Tag: "latest", Node: "n1", CompressedSize: 500, Created: daysAgo(60)},
+		{ImageId: "dangling", Repository: "<none>", Tag: "<none>", Node: "n1", CompressedSize: 50, Created: daysAgo(20)},
+		{ImageId: "unknown-age", Repository: "k2s.registry.local/tool", Tag: "v1", Node: "n1", CompressedSize: 70},
+		{ImageId: "app1", Repository: "k2s.registry.local/app", Tag: "v1", Node: "n2", CompressedSize: 100, Created: daysAgo(30)},
+	}
+	podImages := []string{"nginx"}
+
+	candidateIds := func(plan images.NodePrunePlan) []string {
+		ids := []string{}
+		for _, candidate := range plan.Images {
+			ids = append(ids, candidate.ImageId)
+		}
+		return ids
+	}
+
+	DescribeTable("PlanPrune selects images per node",
+		func(policy images.PrunePolicy, expectedN1 []string, expectedN2 []string) {
+			plans := images.PlanPrune(nodeImages, podImages, policy, now)
+
+			Expect(plans).To(HaveLen(2))
+			Expect(plans[0].Node).To(Equal("n1"))
+			Expect(candidateIds(plans[0])).To(Equal(expectedN1))
+			Expect(plans[1].Node).To(Equal("n2"))
+			Expect(candidateIds(plans[1])).To(Equal(expectedN2))
+		},
+		Entry("no criteria prunes all unused images, oldest first", images.PrunePolicy{},
+			[]string{"app1", "dangling", "app2", "app3", "unknown-age"}, []string{"app1"}),
+		Entry("dangling only", images.PrunePolicy{DanglingOnly: true},
+			[]string{"dangling"}, []string{}),
+		Entry("unused for keeps recent images and images of unknown age", images.PrunePolicy{UnusedFor: 7 * 24 * time.Hour},
+			[]string{"app1", "dangling", "app2"}, []string{"app1"}),
+		Entry("keep latest per repository", images.PrunePolicy{KeepLatest: 2},
+			[]string{"app1", "dangling"}, []string{}),
+		Entry("combined criteria", images.PrunePolicy{UnusedFor: 14 * 24 * time.Hour, KeepLatest: 1},
+			[]string{"app1", "dangling"}, []string{}),
+	)
+
+	Describe("unused for", func() {
+		policy := images.PrunePolicy{UnusedFor: 7 * 24 * time.Hour}
+
+		It("prunes an old image whose last container ran before the given duration", func() {
+			oldImages := []images.NodeImage{
+				{ImageId: "old", Repository: "k2s.registry.local/old", Tag: "v1", Node: "n1", CompressedSize: 10, Created: daysAgo(90), LastUsed: daysAgo(8)},
+			}
+
+			plans := images.PlanPrune(oldImages, nil, policy, now)
+
+			Expect(candidateIds(plans[0])).To(Equal([]string{"old"}))
+			Expect(plans[0].Images[0].LastUsed).To(Equal(daysAgo(8)))
+		})
+
+		It("keeps an old image whose last container ran recently", func() {
+			oldImages := []images.NodeImage{
+				{ImageId: "old", Repository: "k2s.registry.local/old", Tag: "v1", Node: "n1", CompressedSize: 10, Created: daysAgo(90), LastUsed: daysAgo(2)},
+			}
+
+			plans := images.PlanPrune(oldImages, nil, policy, now)
+
+			Expect(candidateIds(plans[0])).To(BeEmpty())
+		})
+
+		It("prunes the least recently used images first until the usage limit is met", func() {
+			usedImages := []images.NodeImage{
+				{ImageId: "recently-used", Repository: "k2s.registry.local/a", Tag: "v1", Node: "n1", CompressedSize: 10, Created: daysAgo(90), LastUsed: daysAgo(1)},
+				{ImageId: "long-unused", Repository: "k2s.registry.local/b", Tag: "v1", Node: "n1", CompressedSize: 10, Created: daysAgo(30)},
+			}
+
+			plans := images.PlanPrune(usedImages, nil, images.PrunePolicy{MaxUsage: 15}, now)
+
+			Expect(candidateIds(plans[0])).To(Equal([]string{"long-unused"}))
+		})
+	})
+
+	It("never prunes images used by Pods", func() {
+		plans := images.PlanPrune(nodeImages, podImages, images.PrunePolicy{}, now)
+
+		Expect(candidateIds(plans[0])).ToNot(ContainElement("nginx"))
+	})
+
+	It("reports usage and reclaimed space preferring uncompressed sizes", func() {
+		plans := images.PlanPrune(nodeImages, podImages, images.PrunePolicy{}, now)
+
+		Expect(plans[0].Usage).To(Equal(int64(100 + 250 + 100 + 500 + 50 + 70)))
+		Expect(plans[0].Reclaimed).To(Equal(int64(100 + 50 + 250 + 100 + 70)))
+	})
+
+	It("prunes oldest images only until the usage limit is met", func() {
+		plans := images.PlanPrune(nodeImages, podImages, images.PrunePolicy{MaxUsage: 900}, now)
+
+		Expect(candidateIds(plans[0])).To(Equal([]string{"app1", "dangling", "app2"}))
+		Expect(plans[0].Usage - plans[0].Reclaimed).To(BeNumerically("<=", 900))
+		Expect(candidateIds(plans[1])).To(BeEmpty(), "node below usage limit")
+	})
+})
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package images
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var sizeUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"kb":  1000,
+	"mb":  1000 * 1000,
+	"gb":  1000 * 1000 * 1000,
+	"tb":  1000 * 1000 * 1000 * 1000,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+}
+
+// ParseSize converts sizes as reported by container tools (e.g. '12345', '75.2MB', '157.4 MiB') to bytes.
+func ParseSize(size string) (int64, error) {
+	size = strings.TrimSpace(size)
+	unitIndex := strings.IndexFunc(size, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsSpace(r)
+	})
+
+	number, unit := size, ""
+	if unitIndex >= 0 {
+		number, unit = size[:unitIndex], strings.ToLower(strings.TrimSpace(size[unitIndex:]))
+	}
+
+	multiplier, ok := sizeUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("invalid size '%s': unknown unit '%s'", size, unit)
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid size '%s'", size)
+	}
+	return int64(value * multiplier), nil
+}
+
+// FormatSize converts bytes to a human-readable size with decimal units, e.g. '75.2MB'.
+func FormatSize(bytes int64) string {
+	if bytes <= 0 {
+		return "-"
+	}
+
+	units := []string{"B", "kB", "MB", "GB", "TB"}
+	value := float64(bytes)
+	unit := 0
+	for value >= 1000 && unit < len(units)-1 {
+		value /= 1000
+		unit++
+	}
+	return strconv.FormatFloat(value, 'g', 4, 64) + units[unit]
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package images
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+)
+
+// NodeStore is the container image store of a single node.
+type NodeStore interface {
+	Name() string
+	OS() string
+	// Digest returns the content digest (image ID) of the image or an empty string if the image is not present.
+	// Unlike repository digests, it is retained when an image is exported and imported again.
+	Digest(image string) (string, error)
+	// Export writes the image to a tar archive on the host.
+	Export(image string, archivePath string) error
+	// Import loads the image from a tar archive on the host.
+	Import(image string, archivePath string) error
+}
+
+type SyncStatus string
+
+const (
+	SyncCopied  SyncStatus = "copied"
+	SyncSkipped SyncStatus = "skipped"
+)
+
+var ErrImageNotFound = errors.New("image not found")
+
+// SyncResult is the outcome of syncing an image to a single node.
+type SyncResult struct {
+	Node   string
+	Status SyncStatus
+}
+
+// PlatformMismatchError occurs when an image is to be synced between nodes with different operating systems.
+type PlatformMismatchError struct {
+	Image      string
+	SourceNode string
+	SourceOS   string
+	TargetNode string
+	TargetOS   string
+}
+
+func (e *PlatformMismatchError) Error() string {
+	return fmt.Sprintf("image '%s' on %s node '%s' cannot be synced to %s node '%s', since container images are platform-specific; build or pull a %s image instead",
+		e.Image, e.SourceOS, e.SourceNode, e.TargetOS, e.TargetNode, e.TargetOS)
+}
+
+// FindSource returns the first store holding the image.
+func FindSource(image string, stores []NodeStore) (NodeStore, error) {
+	for _, store := range stores {
+		digest, err := store.Digest(image)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up image '%s' on node '%s': %w", image, store.Name(), err)
+		}
+		if digest != "" {
+			return store, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: '%s' is not present on any node", ErrImageNotFound, image)
+}
+
+// Sync copies the image from the source to all target stores via a tar archive in archiveDir.
+// Targets already holding the source's digest are skipped. The platforms of all targets are
+// validated before anything is copied.
+func Sync(image string, source NodeStore, targets []NodeStore, archiveDir string) ([]SyncResult, error) {
+	for _, target := range targets {
+		if target.OS() != source.OS() {
+			return nil, &PlatformMismatchError{
+				Image:      image,
+				SourceNode: source.Name(),
+				SourceOS:   source.OS(),
+				TargetNode: target.Name(),
+				TargetOS:   target.OS(),
+			}
+		}
+	}
+
+	sourceDigest, err := source.Digest(image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up image '%s' on node '%s': %w", image, source.Name(), err)
+	}
+	if sourceDigest == "" {
+		return nil, fmt.Errorf("%w: '%s' is not present on node '%s'", ErrImageNotFound, image, source.Name())
+	}
+
+	archivePath := filepath.Join(archiveDir, "k2s-image-sync.tar")
+	exported := false
+	defer func() {
+		if exported {
+			if err := os.Remove(archivePath); err != nil {
+				slog.Warn("Failed to remove image archive", "path", archivePath, "error", err)
+			}
+		}
+	}()
+
+	var results []SyncResult
+	for _, target := range targets {
+		targetDigest, err := target.Digest(image)
+		if err != nil {
+			return results, fmt.Errorf("failed to look up image '%s' on node '%s': %w", image, target.Name(), err)
+		}
+		if targetDigest == sourceDigest {
+			slog.Info("Image already present on node", "image", image, "node", target.Name(), "digest", sourceDigest)
+			results = append(results, SyncResult{Node: target.Name(), Status: SyncSkipped})
+			continue
+		}
+
+		if !exported {
+			slog.Info("Exporting image", "image", image, "node", source.Name())
+			if err := source.Export(image, archivePath); err != nil {
+				return results, fmt.Errorf("failed to export image '%s' from node '%s': %w", image, source.Name(), err)
+			}
+			exported = true
+		}
+
+		slog.Info("Importing image", "image", image, "node", target.Name())
+		if err := target.Import(image, archivePath); err != nil {
+			return results, fmt.Errorf("failed to import image '%s' on node '%s': %w", image, target.Name(), err)
+		}
+		results = append(results, SyncResult{Node: target.Name(), Status: SyncCopied})
+	}
+	return results, nil
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package images_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/siemens-healthineers/k2s/internal/core/images"
+)
+
+type fakeStore struct {
+	name    string
+	os      string
+	digests map[string]string
+	exports int
+	imports int
+}
+
+func (s *fakeStore) Name() string { return s.name }
+
+func (s *fakeStore) OS() string { return s.os }
+
+func (s *fakeStore) Digest(image string) (string, error) { return s.digests[image], nil }
+
+func (s *fakeStore) Export(image string, archivePath string) error {
+	s.exports++
+	return os.WriteFile(archivePath, []byte(s.digests[image]), 0o644)
+}
+
+func (s *fakeStore) Import(image string, archivePath string) error {
+	content, err := os.ReadFile(archivePath)
+	if err != nil {
+		return err
+	}
+	s.imports++
+	s.digests[image] = string(content)
+	return nil
+}
+
+func newFakeStore(name, os string, digests map[string]string) *fakeStore {
+	if digests == nil {
+		digests = map[string]string{}
+	}
+	return &fakeStore{name: name, os: os, digests: digests}
+}
+
+var _ = Describe("sync", func() {
+	const image = "k2s.registry.local/app:v1"
+
+	Describe("Sync", func() {
+		It("exports once and skips nodes already holding the digest", func() {
+			source := newFakeStore("kubemaster", "linux", map[string]string{image: "sha256:111"})
+			upToDate := newFakeStore("worker-1", "linux", map[string]string{image: "sha256:111"})
+			outdated := newFakeStore("worker-2", "linux", map[string]string{image: "sha256:000"})
+			missing := newFakeStore("worker-3", "linux", nil)
+			archiveDir := GinkgoT().TempDir()
+
+			results, err := images.Sync(image, source, []images.NodeStore{upToDate, outdated, missing}, archiveDir)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]images.SyncResult{
+				{Node: "worker-1", Status: images.SyncSkipped},
+				{Node: "worker-2", Status: images.SyncCopied},
+				{Node: "worker-3", Status: images.SyncCopied},
+			}))
+			Expect(source.exports).To(Equal(1))
+			Expect(upToDate.imports).To(BeZero())
+			Expect(outdated.digests[image]).To(Equal("sha256:111"))
+			Expect(missing.digests[image]).To(Equal("sha256:111"))
+			Expect(filepath.Join(archiveDir, "k2s-image-sync.tar")).ToNot(BeAnExistingFile())
+		})
+
+		It("does not export if all nodes hold the digest", func() {
+			source := newFakeStore("kubemaster", "linux", map[string]string{image: "sha256:111"})
+			target := newFakeStore("worker-1", "linux", map[string]string{image: "sha256:111"})
+
+			_, err := images.Sync(image, source, []images.NodeStore{target}, GinkgoT().TempDir())
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(source.exports).To(BeZero())
+		})
+
+		It("rejects targets of another platform before copying anything", func() {
+			source := newFakeStore("kubemaster", "linux", map[string]string{image: "sha256:111"})
+			linuxTarget := newFakeStore("worker-1", "linux", nil)
+			windowsTarget := newFakeStore("winnode", "windows", nil)
+
+			_, err := images.Sync(image, source, []images.NodeStore{linuxTarget, windowsTarget}, GinkgoT().TempDir())
+
+			var mismatchErr *images.PlatformMismatchError
+			Expect(errors.As(err, &mismatchErr)).To(BeTrue())
+			Expect(mismatchErr.TargetNode).To(Equal("winnode"))
+			Expect(err.Error()).To(ContainSubstring("linux node 'kubemaster' cannot be synced to windows node 'winnode'"))
+			Expect(source.exports).To(BeZero())
+			Expect(linuxTarget.imports).To(BeZero())
+		})
+
+		It("returns an error if the source does not hold the image", func() {
+			source := newFakeStore("kubemaster", "linux", nil)
+
+			_, err := images.Sync(image, source, []images.NodeStore{newFakeStore("worker-1", "linux", nil)}, GinkgoT().TempDir())
+
+			Expect(err).To(MatchError(images.ErrImageNotFound))
+		})
+	})
+
+	Describe("FindSource", func() {
+		It("returns the first node holding the image", func() {
+			first := newFakeStore("worker-1", "linux", nil)
+			second := newFakeStore("worker-2", "linux", map[string]string{image: "sha256:111"})
+
+			source, err := images.FindSource(image, []images.NodeStore{first, second})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(source.Name()).To(Equal("worker-2"))
+		})
+
+		It("returns an error if no node holds the image", func() {
+			_, err := images.FindSource(image, []images.NodeStore{newFakeStore("worker-1", "linux", nil)})
+
+			Expect(err).To(MatchError(images.ErrImageNotFound))
+		})
+	})
+})
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+// Package kubeadmconfig merges user-defined customizations into the kubeadm and kubelet configuration documents
+// generated by K2s.
+package kubeadmconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Customization holds the fields to merge into the generated documents of the respective kind.
+type Customization struct {
+	InitConfiguration    map[string]any `yaml:"initConfiguration,omitempty"`
+	ClusterConfiguration map[string]any `yaml:"clusterConfiguration,omitempty"`
+	KubeletConfiguration map[string]any `yaml:"kubeletConfiguration,omitempty"`
+}
+
+const (
+	// FileName is the name of the persisted customization in the K2s config dir.
+	FileName = "kubeadm-customization.yaml"
+
+	InitConfigurationKind    = "InitConfiguration"
+	ClusterConfigurationKind = "ClusterConfiguration"
+	KubeletConfigurationKind = "KubeletConfiguration"
+)
+
+// protectedFields are the fields K2s relies on per kind; customizations must not change them.
+var protectedFields = map[string][]string{
+	InitConfigurationKind:    {"nodeRegistration.criSocket"},
+	ClusterConfigurationKind: {"kubernetesVersion", "networking.podSubnet", "networking.serviceSubnet"},
+}
+
+// Parse returns the customization from the 'kubeadm' section of an install config file, nil if there is none.
+func Parse(installConfig []byte) (*Customization, error) {
+	var file struct {
+		Kubeadm yaml.Node `yaml:"kubeadm"`
+	}
+	if err := yaml.Unmarshal(installConfig, &file); err != nil {
+		return nil, fmt.Errorf("could not parse install config: %w", err)
+	}
+	if file.Kubeadm.IsZero() {
+		return nil, nil
+	}
+
+	section, err := yaml.Marshal(&file.Kubeadm)
+	if err != nil {
+		return nil, fmt.Errorf("could not read 'kubeadm' section: %w", err)
+	}
+	return decode(section)
+}
+
+// Read returns the customization persisted in the given config dir, nil if there is none.
+func Read(configDir string) (*Customization, error) {
+	data, err := os.ReadFile(filepath.Join(configDir, FileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read kubeadm customization: %w", err)
+	}
+	return decode(data)
+}
+
+// Write persists the customization in the given config dir.
+func Write(configDir string, customization *Customization) error {
+	data, err := yaml.Marshal(customization)
+	if err != nil {
+		return fmt.Errorf("could not marshal kubeadm customization: %w", err)
+	}
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		return fmt.Errorf("could not create config dir: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(configDir, FileName), data, 0600); err != nil {
+		return fmt.Errorf("could not write kubeadm customization: %w", err)
+	}
+	return nil
+}
+
+// Merge merges the customization into the given multi-document kubeadm configuration and returns the result. Maps are
+// merged recursively, lists of named entries like 'extraArgs' are merged by name, a null value removes the field and
+// all other values are replaced. Fields K2s relies on must not be changed.
+func Merge(documents []byte, customization *Customization) ([]byte, error) {
+	docs, err := splitDocuments(documents)
+	if err != nil {
+		return nil, err
+	}
+	if customization == nil {
+		return documents, nil
+	}
+
+	merged := false
+	var out bytes.Buffer
+	for i, doc := range docs {
+		kind, _ := doc["kind"].(string)
+		if fields := customization.fieldsOf(kind); fields != nil {
+			result := mergeMaps(deepCopy(doc).(map[string]any), fields)
+			if err := checkProtectedFields(kind, doc, result); err != nil {
+				return nil, err
+			}
+			doc = result
+			merged = true
+		}
+
+		if i > 0 {
+			out.WriteString("---\n")
+		}
+		data, err := yaml.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal %s: %w", kind, err)
+		}
+		out.Write(data)
+	}
+
+	if !merged {
+		return nil, errors.New("kubeadm customization does not match any generated document")
+	}
+	return out.Bytes(), nil
+}
+
+func decode(data []byte) (*Customization, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var customization Customization
+	if err := decoder.Decode(&customization); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid 'kubeadm' section: %w", err)
+	}
+
+	for kind, fields := range map[string]map[string]any{
+		InitConfigurationKind:    customization.InitConfiguration,
+		ClusterConfigurationKind: customization.ClusterConfiguration,
+		KubeletConfigurationKind: customization.KubeletConfiguration,
+	} {
+		for _, key := range []string{"apiVersion", "kind"} {
+			if _, found := fields[key]; found {
+				return nil, fmt.Errorf("invalid 'kubeadm' section: '%s' of %s is set by K2s and cannot be customized", key, kind)
+			}
+		}
+	}
+	return &customization, nil
+}
+
+func (c *Customization) fieldsOf(kind string) map[string]any {
+	switch kind {
+	case InitConfigurationKind:
+		return c.InitConfiguration
+	case ClusterConfigurationKind:
+		return c.ClusterConfiguration
+	case KubeletConfigurationKind:
+		return c.KubeletConfiguration
+	default:
+		return nil
+	}
+}
+
+func splitDocuments(documents []byte) ([]map[string]any, error) {
+	var docs []map[string]any
+	decoder := yaml.NewDecoder(bytes.NewReader(documents))
+	for {
+		var doc map[string]any
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not parse kubeadm configuration: %w", err)
+		}
+		if doc != nil {
+			docs = append(docs, doc)
+		}
+	}
+}
+
+func mergeMaps(base, override map[string]any) map[string]any {
+	for key, value := range override {
+		if value == nil {
+			delete(base, key)
+			continue
+		}
+		base[key] = mergeValues(base[key], value)
+	}
+	return base
+}
+
+func mergeValues(base, override any) any {
+	switch overrideValue := override.(type) {
+	case map[string]any:
+		if baseMap, ok := base.(map[string]any); ok {
+			return mergeMaps(baseMap, overrideValue)
+		}
+	case []any:
+		if baseList, ok := base.([]any); ok && isNamedList(baseList) && isNamedList(overrideValue) {
+			return mergeNamedLists(baseList, overrideValue)
+		}
+	}
+	return deepCopy(override)
+}
+
+// mergeNamedLists merges entries with the same name and appends new ones, like kubeadm's 'extraArgs'.
+func mergeNamedLists(base, override []any) []any {
+	result := slices.Clone(base)
+	for _, entry := range override {
+		entryMap := entry.(map[string]any)
+		index := slices.IndexFunc(result, func(existing any) bool {
+			return existing.(map[string]any)["name"] == entryMap["name"]
+		})
+		if index < 0 {
+			result = append(result, deepCopy(entryMap))
+			continue
+		}
+		result[index] = mergeMaps(result[index].(map[string]any), entryMap)
+	}
+	return result
+}
+
+func isNamedList(list []any) bool {
+	for _, entry := range list {
+		entryMap, ok := entry.(map[string]any)
+		if !ok {
+			return false
+		}
+		if _, ok := entryMap["name"].(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func deepCopy(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(typed))
+		for key, entry := range typed {
+			result[key] = deepCopy(entry)
+		}
+		return result
+	case []any:
+		result := make([]any, len(typed))
+		for i, entry := range typed {
+			result[i] = deepCopy(entry)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+func checkProtectedFields(kind string, original, merged map[string]any) error {
+	for _, field := range protectedFields[kind] {
+		if !reflect.DeepEqual(lookup(original, field), lookup(merged, field)) {
+			return fmt.Errorf("'%s' of %s is set by K2s and cannot be customized", field, kind)
+		}
+	}
+	return nil
+}
+
+func lookup(doc map[string]any, field string) any {
+	var current any = doc
+	for _, key := range strings.Split(field, ".") {
+		currentMap, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = currentMap[key]
+	}
+	return current
+}
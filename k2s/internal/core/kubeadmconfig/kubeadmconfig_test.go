@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package kubeadmconfig_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/core/kubeadmconfig"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPkg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "kubeadmconfig pkg Unit Tests", Label("unit", "ci", "kubeadmconfig"))
+}
+
+var _ = BeforeSuite(func() {
+	slog.SetDefault(slog.New(logr.ToSlogHandler(GinkgoLogr)))
+})
+
+const generated = `apiVersion: kubeadm.k8s.io/v1beta4
+kind: InitConfiguration
+nodeRegistration:
+  criSocket: unix:///var/run/crio/crio.sock
+---
+apiVersion: kubeadm.k8s.io/v1beta4
+kind: ClusterConfiguration
+kubernetesVersion: v1.35.0
+apiServer:
+  extraArgs:
+    - name: profiling
+      value: "false"
+networking:
+  podSubnet: 172.20.0.0/16
+  serviceSubnet: 172.21.0.0/16
+---
+apiVersion: kubelet.config.k8s.io/v1beta1
+kind: KubeletConfiguration
+failCgroupV1: false
+`
+
+func documents(data []byte) []map[string]any {
+	var docs []map[string]any
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc map[string]any
+		if decoder.Decode(&doc) != nil {
+			return docs
+		}
+		docs = append(docs, doc)
+	}
+}
+
+var _ = Describe("kubeadmconfig pkg", func() {
+	Describe("Parse", func() {
+		It("returns nil if there is no kubeadm section", func() {
+			customization, err := kubeadmconfig.Parse([]byte("kind: k2s\napiVersion: v1\n"))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(customization).To(BeNil())
+		})
+
+		It("keeps the case of keys", func() {
+			config := `kind: k2s
+kubeadm:
+  clusterConfiguration:
+    featureGates:
+      StructuredAuthenticationConfiguration: true
+  kubeletConfiguration:
+    maxPods: 200
+`
+
+			customization, err := kubeadmconfig.Parse([]byte(config))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(customization.ClusterConfiguration).To(HaveKeyWithValue("featureGates", HaveKeyWithValue("StructuredAuthenticationConfiguration", true)))
+			Expect(customization.KubeletConfiguration).To(HaveKeyWithValue("maxPods", 200))
+		})
+
+		It("fails on unknown documents", func() {
+			_, err := kubeadmconfig.Parse([]byte("kubeadm:\n  joinConfiguration: {}\n"))
+
+			Expect(err).To(MatchError(ContainSubstring("field joinConfiguration not found")))
+		})
+
+		It("fails if the kind is set", func() {
+			_, err := kubeadmconfig.Parse([]byte("kubeadm:\n  kubeletConfiguration:\n    kind: Other\n"))
+
+			Expect(err).To(MatchError("invalid 'kubeadm' section: 'kind' of KubeletConfiguration is set by K2s and cannot be customized"))
+		})
+	})
+
+	Describe("Merge", func() {
+		It("returns the documents unchanged without customization", func() {
+			result, err := kubeadmconfig.Merge([]byte(generated), nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(result)).To(Equal(generated))
+		})
+
+		It("merges the customization into the documents of the respective kind", func() {
+			customization, err := kubeadmconfig.Parse([]byte(`kubeadm:
+  clusterConfiguration:
+    apiServer:
+      extraArgs:
+        - name: profiling
+          value: "true"
+        - name: oidc-issuer-url
+          value: https://issuer.example
+    featureGates:
+      ControlPlaneKubeletLocalMode: true
+  kubeletConfiguration:
+    failCgroupV1: null
+    maxPods: 200
+    evictionHard:
+      memory.available: 500Mi
+    imageGCHighThresholdPercent: 80
+    systemReserved:
+      cpu: 500m
+      memory: 1Gi
+`))
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := kubeadmconfig.Merge([]byte(generated), customization)
+
+			Expect(err).ToNot(HaveOccurred())
+			docs := documents(result)
+			Expect(docs).To(HaveLen(3))
+			Expect(docs[0]).To(Equal(documents([]byte(generated))[0]))
+			Expect(docs[1]).To(Equal(map[string]any{
+				"apiVersion":        "kubeadm.k8s.io/v1beta4",
+				"kind":              "ClusterConfiguration",
+				"kubernetesVersion": "v1.35.0",
+				"apiServer": map[string]any{"extraArgs": []any{
+					map[string]any{"name": "profiling", "value": "true"},
+					map[string]any{"name": "oidc-issuer-url", "value": "https://issuer.example"},
+				}},
+				"featureGates": map[string]any{"ControlPlaneKubeletLocalMode": true},
+				"networking":   map[string]any{"podSubnet": "172.20.0.0/16", "serviceSubnet": "172.21.0.0/16"},
+			}))
+			Expect(docs[2]).To(Equal(map[string]any{
+				"apiVersion":                  "kubelet.config.k8s.io/v1beta1",
+				"kind":                        "KubeletConfiguration",
+				"maxPods":                     200,
+				"evictionHard":                map[string]any{"memory.available": "500Mi"},
+				"imageGCHighThresholdPercent": 80,
+				"systemReserved":              map[string]any{"cpu": "500m", "memory": "1Gi"},
+			}))
+		})
+
+		DescribeTable("fails if fields K2s relies on are changed", func(customization kubeadmconfig.Customization, expected string) {
+			_, err := kubeadmconfig.Merge([]byte(generated), &customization)
+
+			Expect(err).To(MatchError(expected))
+		},
+			Entry("pod subnet", kubeadmconfig.Customization{ClusterConfiguration: map[string]any{"networking": map[string]any{"podSubnet": "10.0.0.0/8"}}},
+				"'networking.podSubnet' of ClusterConfiguration is set by K2s and cannot be customized"),
+			Entry("removed version", kubeadmconfig.Customization{ClusterConfiguration: map[string]any{"kubernetesVersion": nil}},
+				"'kubernetesVersion' of ClusterConfiguration is set by K2s and cannot be customized"),
+			Entry("CRI socket", kubeadmconfig.Customization{InitConfiguration: map[string]any{"nodeRegistration": map[string]any{"criSocket": "unix:///run/containerd.sock"}}},
+				"'nodeRegistration.criSocket' of InitConfiguration is set by K2s and cannot be customized"),
+		)
+	})
+
+	Describe("Write and Read", func() {
+		It("persists the customization", func() {
+			dir := GinkgoT().TempDir()
+			customization := &kubeadmconfig.Customization{KubeletConfiguration: map[string]any{"maxPods": 200}}
+
+			Expect(kubeadmconfig.Write(dir, customization)).To(Succeed())
+
+			Expect(kubeadmconfig.Read(dir)).To(Equal(customization))
+		})
+
+		It("returns nil if nothing was persisted", func() {
+			Expect(kubeadmconfig.Read(GinkgoT().TempDir())).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package noderesources
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Amounts of CPU and memory.
+type Amounts struct {
+	CpuMillis   int64 `json:"cpuMillis"`
+	MemoryBytes int64 `json:"memoryBytes"`
+}
+
+// Resources of a node; Usage is only present if the metrics API is available.
+type Resources struct {
+	Allocatable Amounts  `json:"allocatable"`
+	Requested   Amounts  `json:"requested"`
+	Usage       *Amounts `json:"usage,omitempty"`
+	Pressure    []string `json:"pressure,omitempty"`
+}
+
+type resourceList struct {
+	Cpu    string `json:"cpu"`
+	Memory string `json:"memory"`
+}
+
+type nodeList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Status struct {
+			Allocatable resourceList `json:"allocatable"`
+			Conditions  []struct {
+				Type   string `json:"type"`
+				Status string `json:"status"`
+			} `json:"conditions"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+type container struct {
+	Resources struct {
+		Requests resourceList `json:"requests"`
+	} `json:"resources"`
+}
+
+type podList struct {
+	Items []struct {
+		Spec struct {
+			NodeName       string      `json:"nodeName"`
+			Containers     []container `json:"containers"`
+			InitContainers []container `json:"initContainers"`
+		} `json:"spec"`
+		Status struct {
+			Phase string `json:"phase"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+type nodeMetricsList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Usage resourceList `json:"usage"`
+	} `json:"items"`
+}
+
+// pressureConditions are the node conditions reported if their status is 'True'.
+var pressureConditions = []string{"MemoryPressure", "DiskPressure", "PIDPressure"}
+
+// Parse determines the allocatable and requested resources as well as the pressure conditions per node name from
+// the output of 'kubectl get nodes -o json' and 'kubectl get pods -A -o json'. Like the scheduler, the requests of
+// a pod are the maximum of the summed container requests and the largest init container request; terminated pods
+// are not taken into account.
+func Parse(nodesJson, podsJson []byte) (map[string]*Resources, error) {
+	var nodes nodeList
+	if err := json.Unmarshal(nodesJson, &nodes); err != nil {
+		return nil, fmt.Errorf("could not unmarshal nodes: %w", err)
+	}
+
+	var pods podList
+	if err := json.Unmarshal(podsJson, &pods); err != nil {
+		return nil, fmt.Errorf("could not unmarshal pods: %w", err)
+	}
+
+	result := map[string]*Resources{}
+	for _, node := range nodes.Items {
+		allocatable, err := parseAmounts(node.Status.Allocatable)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse allocatable resources of node '%s': %w", node.Metadata.Name, err)
+		}
+
+		resources := &Resources{Allocatable: allocatable}
+		for _, condition := range node.Status.Conditions {
+			if condition.Status == "True" && slices.Contains(pressureConditions, condition.Type) {
+				resources.Pressure = append(resources.Pressure, condition.Type)
+			}
+		}
+		result[node.Metadata.Name] = resources
+	}
+
+	for _, pod := range pods.Items {
+		resources, found := result[pod.Spec.NodeName]
+		if !found || pod.Status.Phase == "Succeeded" || pod.Status.Phase == "Failed" {
+			continue
+		}
+
+		requested, err := podRequests(pod.Spec.Containers, pod.Spec.InitContainers)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse resource requests of pod on node '%s': %w", pod.Spec.NodeName, err)
+		}
+		resources.Requested.CpuMillis += requested.CpuMillis
+		resources.Requested.MemoryBytes += requested.MemoryBytes
+	}
+	return result, nil
+}
+
+// AddUsage sets the usage of the nodes from the output of 'kubectl get --raw /apis/metrics.k8s.io/v1beta1/nodes';
+// nodes without metrics are left unchanged.
+func AddUsage(resources map[string]*Resources, nodeMetricsJson []byte) error {
+	var metrics nodeMetricsList
+	if err := json.Unmarshal(nodeMetricsJson, &metrics); err != nil {
+		return fmt.Errorf("could not unmarshal node metrics: %w", err)
+	}
+
+	for _, item := range metrics.Items {
+		nodeResources, found := resources[item.Metadata.Name]
+		if !found {
+			continue
+		}
+
+		usage, err := parseAmounts(item.Usage)
+		if err != nil {
+			return fmt.Errorf("could not parse usage of node '%s': %w", item.Metadata.Name, err)
+		}
+		nodeResources.Usage = &usage
+	}
+	return nil
+}
+
+// Percent returns the share of the given part in percent, 0 if the total is 0.
+func Percent(part, total int64) int64 {
+	if total == 0 {
+		return 0
+	}
+	return part * 100 / total
+}
+
+// FormatCpu formats millicores like kubectl, e.g. '250m' or '2'.
+func FormatCpu(millis int64) string {
+	if millis%1000 == 0 {
+		return fmt.Sprintf("%d", millis/1000)
+	}
+	return fmt.Sprintf("%dm", millis)
+}
+
+func podRequests(containers, initContainers []container) (Amounts, error) {
+	var sum Amounts
+	for _, c := range containers {
+		requests, err := parseAmounts(c.Resources.Requests)
+		if err != nil {
+			return Amounts{}, err
+		}
+		sum.CpuMillis += requests.CpuMillis
+		sum.MemoryBytes += requests.MemoryBytes
+	}
+
+	for _, c := range initContainers {
+		requests, err := parseAmounts(c.Resources.Requests)
+		if err != nil {
+			return Amounts{}, err
+		}
+		sum.CpuMillis = max(sum.CpuMillis, requests.CpuMillis)
+		sum.MemoryBytes = max(sum.MemoryBytes, requests.MemoryBytes)
+	}
+	return sum, nil
+}
+
+func parseAmounts(list resourceList) (Amounts, error) {
+	var amounts Amounts
+	if list.Cpu != "" {
+		cpu, err := resource.ParseQuantity(list.Cpu)
+		if err != nil {
+			return Amounts{}, fmt.Errorf("invalid CPU quantity '%s': %w", list.Cpu, err)
+		}
+		amounts.CpuMillis = cpu.MilliValue()
+	}
+	if list.Memory != "" {
+		memory, err := resource.ParseQuantity(list.Memory)
+		if err != nil {
+			return Amounts{}, fmt.Errorf("invalid memory quantity '%s': %w", list.Memory, err)
+		}
+		amounts.MemoryBytes = memory.Value()
+	}
+	return amounts, nil
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package noderesources_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/core/noderesources"
+)
+
+func TestPkg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "noderesources pkg Unit Tests", Label("unit", "ci", "noderesources"))
+}
+
+var _ = BeforeSuite(func() {
+	slog.SetDefault(slog.New(logr.ToSlogHandler(GinkgoLogr)))
+})
+
+const nodesJson = `{"items":[
+	{"metadata":{"name":"kubemaster"},"status":{"allocatable":{"cpu":"4","memory":"8Gi"},"conditions":[
+		{"type":"MemoryPressure","status":"True"},{"type":"DiskPressure","status":"False"},{"type":"PIDPressure","status":"True"},{"type":"Ready","status":"True"}]}},
+	{"metadata":{"name":"winnode"},"status":{"allocatable":{"cpu":"7500m","memory":"16Gi"}}}]}`
+
+var _ = Describe("Parse", func() {
+	It("sums requests of non-terminated pods per node", func() {
+		pods := `{"items":[
+			{"spec":{"nodeName":"kubemaster","containers":[{"resources":{"requests":{"cpu":"250m","memory":"128Mi"}}},{"resources":{"requests":{"cpu":"100m"}}}]},"status":{"phase":"Running"}},
+			{"spec":{"nodeName":"kubemaster","containers":[{"resources":{}}],"initContainers":[{"resources":{"requests":{"cpu":"1","memory":"64Mi"}}}]},"status":{"phase":"Pending"}},
+			{"spec":{"nodeName":"kubemaster","containers":[{"resources":{"requests":{"cpu":"2"}}}]},"status":{"phase":"Succeeded"}},
+			{"spec":{"nodeName":"winnode","containers":[{"resources":{"requests":{"memory":"1Gi"}}}]},"status":{"phase":"Running"}},
+			{"spec":{"containers":[{"resources":{"requests":{"cpu":"3"}}}]},"status":{"phase":"Pending"}}]}`
+
+		result, err := noderesources.Parse([]byte(nodesJson), []byte(pods))
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(2))
+		Expect(*result["kubemaster"]).To(Equal(noderesources.Resources{
+			Allocatable: noderesources.Amounts{CpuMillis: 4000, MemoryBytes: 8 << 30},
+			Requested:   noderesources.Amounts{CpuMillis: 1350, MemoryBytes: 192 << 20},
+			Pressure:    []string{"MemoryPressure", "PIDPressure"},
+		}))
+		Expect(*result["winnode"]).To(Equal(noderesources.Resources{
+			Allocatable: noderesources.Amounts{CpuMillis: 7500, MemoryBytes: 16 << 30},
+			Requested:   noderesources.Amounts{MemoryBytes: 1 << 30},
+		}))
+	})
+
+	It("fails on invalid quantity", func() {
+		pods := `{"items":[{"spec":{"nodeName":"winnode","containers":[{"resources":{"requests":{"cpu":"lots"}}}]},"status":{"phase":"Running"}}]}`
+
+		_, err := noderesources.Parse([]byte(nodesJson), []byte(pods))
+
+		Expect(err).To(MatchError(ContainSubstring("could not parse resource requests of pod on node 'winnode': invalid CPU quantity 'lots'")))
+	})
+})
+
+var _ = Describe("AddUsage", func() {
+	It("sets usage of known nodes", func() {
+		resources, err := noderesources.Parse([]byte(nodesJson), []byte(`{"items":[]}`))
+		Expect(err).ToNot(HaveOccurred())
+		metrics := `{"kind":"NodeMetricsList","items":[
+			{"metadata":{"name":"kubemaster"},"usage":{"cpu":"523442812n","memory":"2097152Ki"}},
+			{"metadata":{"name":"removed"},"usage":{"cpu":"1","memory":"1Gi"}}]}`
+
+		Expect(noderesources.AddUsage(resources, []byte(metrics))).To(Succeed())
+
+		Expect(resources["kubemaster"].Usage).To(Equal(&noderesources.Amounts{CpuMillis: 524, MemoryBytes: 2 << 30}))
+		Expect(resources["winnode"].Usage).To(BeNil())
+	})
+})
+
+var _ = Describe("FormatCpu", func() {
+	DescribeTable("formats like kubectl", func(millis int64, expected string) {
+		Expect(noderesources.FormatCpu(millis)).To(Equal(expected))
+	},
+		Entry("whole cores", int64(2000), "2"),
+		Entry("millicores", int64(1350), "1350m"),
+		Entry("zero", int64(0), "0"),
+	)
+})
+
+var _ = Describe("Percent", func() {
+	It("returns 0 for total of 0", func() {
+		Expect(noderesources.Percent(5, 0)).To(BeZero())
+	})
+
+	It("returns rounded down share", func() {
+		Expect(noderesources.Percent(1350, 4000)).To(Equal(int64(33)))
+	})
+})
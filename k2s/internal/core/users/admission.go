@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+	"time"
 
 	"github.com/siemens-healthineers/k2s/internal/contracts/users"
 )
@@ -20,28 +21,40 @@ type k2sUserNameProvider interface {
 	DetermineK2sUserName(user *users.OSUser) string
 }
 
-type admission interface {
+type controlPlaneAdmission interface {
 	GrantAccess(user *users.OSUser, k2sUserName string) error
 }
 
+type clusterAdmission interface {
+	GrantAccess(user *users.OSUser, k2sUserName string, access users.Access, certValidity time.Duration) error
+}
+
+type userRecorder interface {
+	Record(user *users.OSUser, k2sUserName string, access users.Access) error
+}
+
 type UserAdmission struct {
 	userValidator         userValidator
 	k2sUserNameProvider   k2sUserNameProvider
-	controlPlaneAdmission admission
-	clusterAdmission      admission
+	controlPlaneAdmission controlPlaneAdmission
+	clusterAdmission      clusterAdmission
+	userRecorder          userRecorder
 }
 
-func NewUserAdmission(userValidator userValidator, k2sUserNameProvider k2sUserNameProvider, controlPlaneAdmission, clusterAdmission admission) *UserAdmission {
+func NewUserAdmission(userValidator userValidator, k2sUserNameProvider k2sUserNameProvider, controlPlaneAdmission controlPlaneAdmission, clusterAdmission clusterAdmission, userRecorder userRecorder) *UserAdmission {
 	return &UserAdmission{
 		userValidator:         userValidator,
 		k2sUserNameProvider:   k2sUserNameProvider,
 		controlPlaneAdmission: controlPlaneAdmission,
 		clusterAdmission:      clusterAdmission,
+		userRecorder:          userRecorder,
 	}
 }
 
-func (u *UserAdmission) Add(user *users.OSUser) error {
-	slog.Debug("Adding user to K2s", "name", user.Name(), "id", user.Id())
+// Add grants the user control-plane access and Kubernetes access with the given role. A certValidity of 0 means the
+// default validity of the user's client certificate.
+func (u *UserAdmission) Add(user *users.OSUser, access users.Access, certValidity time.Duration) error {
+	slog.Debug("Adding user to K2s", "name", user.Name(), "id", user.Id(), "role", access.Role, "namespace", access.Namespace)
 
 	if err := u.userValidator.ValidateUser(user); err != nil {
 		return fmt.Errorf("failed to validate user '%s': %w", user.Name(), err)
@@ -62,12 +75,19 @@ func (u *UserAdmission) Add(user *users.OSUser) error {
 
 	go func() {
 		defer tasks.Done()
-		if err := u.clusterAdmission.GrantAccess(user, k2sUserName); err != nil {
+		if err := u.clusterAdmission.GrantAccess(user, k2sUserName, access, certValidity); err != nil {
 			allErrors[1] = fmt.Errorf("failed to grant user Kubernetes access: %w", err)
 		}
 	}()
 
 	tasks.Wait()
 
-	return errors.Join(allErrors...)
+	if err := errors.Join(allErrors...); err != nil {
+		return err
+	}
+
+	if err := u.userRecorder.Record(user, k2sUserName, access); err != nil {
+		return fmt.Errorf("failed to register user '%s': %w", user.Name(), err)
+	}
+	return nil
 }
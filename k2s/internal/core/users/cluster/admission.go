@@ -9,9 +9,11 @@ import (
 	"log/slog"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/siemens-healthineers/k2s/internal/contracts/config"
 	"github.com/siemens-healthineers/k2s/internal/contracts/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users/cluster/rbac"
 	"github.com/siemens-healthineers/k2s/internal/definitions"
 )
 
@@ -24,13 +26,24 @@ type kubeconfigCopier interface {
 }
 
 type certGenerator interface {
-	GenerateUserCert(userName string, targetDir string) (certPath, keyPath string, err error)
+	GenerateUserCert(userName string, groups []string, validity time.Duration, targetDir string) (certPath, keyPath string, err error)
 }
 
 type kubeconfigWriter interface {
 	SetUserCredentials(k8sUserName, certPath, keyPath, kubeconfigPath string) error
 	SetContext(context, k8sUserName, clusterName, kubeconfigPath string) error
 	SetCurrentContext(context, kubeconfigPath string) error
+	DeleteContext(context, kubeconfigPath string) error
+	DeleteUserCredentials(k8sUserName, kubeconfigPath string) error
+	UnsetCurrentContext(kubeconfigPath string) error
+}
+
+type roleBinder interface {
+	BindRole(k8sUserName, certGroup string, access users.Access) error
+}
+
+type bindingRemover interface {
+	RemoveUserBindings(k8sUserName string) error
 }
 
 type accessVerifier interface {
@@ -45,9 +58,11 @@ type ClusterAdmission struct {
 	kubeconfigReader   kubeconfigReader
 	certGenerator      certGenerator
 	accessVerifier     accessVerifier
+	roleBinder         roleBinder
+	bindingRemover     bindingRemover
 }
 
-func NewClusterAdmission(config *config.K2sClusterConfig, kubeconfigResolver kubeconfigResolver, kubeconfigCopier kubeconfigCopier, kubeconfigWriter kubeconfigWriter, kubeconfigReader kubeconfigReader, certGenerator certGenerator, accessVerifier accessVerifier) *ClusterAdmission {
+func NewClusterAdmission(config *config.K2sClusterConfig, kubeconfigResolver kubeconfigResolver, kubeconfigCopier kubeconfigCopier, kubeconfigWriter kubeconfigWriter, kubeconfigReader kubeconfigReader, certGenerator certGenerator, accessVerifier accessVerifier, roleBinder roleBinder, bindingRemover bindingRemover) *ClusterAdmission {
 	return &ClusterAdmission{
 		config:             config,
 		kubeconfigResolver: kubeconfigResolver,
@@ -56,14 +71,23 @@ func NewClusterAdmission(config *config.K2sClusterConfig, kubeconfigResolver kub
 		kubeconfigReader:   kubeconfigReader,
 		certGenerator:      certGenerator,
 		accessVerifier:     accessVerifier,
+		roleBinder:         roleBinder,
+		bindingRemover:     bindingRemover,
 	}
 }
 
-func (c *ClusterAdmission) GrantAccess(user *users.OSUser, k8sUserName string) error {
-	slog.Debug("Granting user access to Kubernetes cluster", "name", user.Name(), "id", user.Id(), "k8s-user-name", k8sUserName)
+// GrantAccess issues a client certificate for a group unique to the certificate and binds the role's permissions
+// to this group. A certValidity of 0 means the default validity.
+func (c *ClusterAdmission) GrantAccess(user *users.OSUser, k8sUserName string, access users.Access, certValidity time.Duration) error {
+	slog.Debug("Granting user access to Kubernetes cluster", "name", user.Name(), "id", user.Id(), "k8s-user-name", k8sUserName, "role", access.Role, "namespace", access.Namespace)
 
 	kubeconfigPath := c.kubeconfigResolver.ResolveKubeconfigPath(user)
 
+	certGroup, err := rbac.NewCertGroup()
+	if err != nil {
+		return err
+	}
+
 	allErrors := []error{nil, nil}
 	tasks := sync.WaitGroup{}
 	tasks.Add(len(allErrors))
@@ -86,7 +110,7 @@ func (c *ClusterAdmission) GrantAccess(user *users.OSUser, k8sUserName string) e
 			return
 		}
 
-		certPath, keyPath, err = c.certGenerator.GenerateUserCert(k8sUserName, tempDir)
+		certPath, keyPath, err = c.certGenerator.GenerateUserCert(k8sUserName, rbac.Groups(certGroup), certValidity, tempDir)
 		if err != nil {
 			allErrors[1] = fmt.Errorf("failed to generate user certificate for user '%s': %w", k8sUserName, err)
 		}
@@ -100,7 +124,7 @@ func (c *ClusterAdmission) GrantAccess(user *users.OSUser, k8sUserName string) e
 
 	tasks.Wait()
 
-	err := errors.Join(allErrors...)
+	err = errors.Join(allErrors...)
 	if err != nil {
 		return fmt.Errorf("failed to grant user access to Kubernetes cluster: %w", err)
 	}
@@ -119,6 +143,10 @@ func (c *ClusterAdmission) GrantAccess(user *users.OSUser, k8sUserName string) e
 		return fmt.Errorf("failed to verify cluster access for user '%s': %w", k8sUserName, err)
 	}
 
+	if err := c.roleBinder.BindRole(k8sUserName, certGroup, access); err != nil {
+		return fmt.Errorf("failed to grant role '%s' to user '%s': %w", access.Role, k8sUserName, err)
+	}
+
 	currentContext, err := c.kubeconfigReader.ReadCurrentContext(kubeconfigPath)
 	if err != nil {
 		return fmt.Errorf("failed to read current context from kubeconfig '%s': %w", kubeconfigPath, err)
@@ -131,3 +159,88 @@ func (c *ClusterAdmission) GrantAccess(user *users.OSUser, k8sUserName string) e
 	}
 	return nil
 }
+
+// RenewCredentials issues a new client certificate for the user, replaces the user's credentials in the
+// user's kubeconfig, keeping contexts untouched, and binds the role to the new certificate only. It returns
+// the new certificate in PEM format. A certValidity of 0 means the default validity.
+func (c *ClusterAdmission) RenewCredentials(k8sUserName string, access users.Access, certValidity time.Duration, kubeconfigPath string) ([]byte, error) {
+	slog.Debug("Renewing user credentials", "k8s-user-name", k8sUserName, "role", access.Role, "kubeconfig-path", kubeconfigPath)
+
+	tempDir, err := os.MkdirTemp("", definitions.SetupNameK2s+"-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory for user certificate generation: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			slog.Error("failed to remove temporary directory for user certificate generation", "path", tempDir, "error", err)
+		}
+	}()
+
+	certGroup, err := rbac.NewCertGroup()
+	if err != nil {
+		return nil, err
+	}
+
+	certPath, keyPath, err := c.certGenerator.GenerateUserCert(k8sUserName, rbac.Groups(certGroup), certValidity, tempDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate user certificate for user '%s': %w", k8sUserName, err)
+	}
+
+	if err := c.kubeconfigWriter.SetUserCredentials(k8sUserName, certPath, keyPath, kubeconfigPath); err != nil {
+		return nil, fmt.Errorf("failed to set user credentials in '%s': %w", kubeconfigPath, err)
+	}
+
+	k8sContext := k8sUserName + "@" + c.config.Name()
+
+	if err := c.accessVerifier.VerifyAccess(k8sContext, kubeconfigPath); err != nil {
+		return nil, fmt.Errorf("failed to verify cluster access for user '%s': %w", k8sUserName, err)
+	}
+
+	if err := c.roleBinder.BindRole(k8sUserName, certGroup, access); err != nil {
+		return nil, fmt.Errorf("failed to grant role '%s' to user '%s': %w", access.Role, k8sUserName, err)
+	}
+
+	certPem, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read user certificate '%s': %w", certPath, err)
+	}
+	return certPem, nil
+}
+
+// RevokeAccess removes the user and the user's certificate group from all RBAC bindings, which makes the user's
+// client certificates unusable also if the user is added again, and deletes the user's credentials from the
+// user's kubeconfig.
+func (c *ClusterAdmission) RevokeAccess(k8sUserName, kubeconfigPath string) error {
+	slog.Debug("Revoking user access to Kubernetes cluster", "k8s-user-name", k8sUserName, "kubeconfig-path", kubeconfigPath)
+
+	if err := c.bindingRemover.RemoveUserBindings(k8sUserName); err != nil {
+		return fmt.Errorf("failed to remove RBAC bindings of user '%s': %w", k8sUserName, err)
+	}
+
+	if _, err := os.Stat(kubeconfigPath); err != nil {
+		slog.Warn("User kubeconfig not accessible, skipping credentials removal", "path", kubeconfigPath, "error", err)
+		return nil
+	}
+
+	k8sContext := k8sUserName + "@" + c.config.Name()
+
+	currentContext, err := c.kubeconfigReader.ReadCurrentContext(kubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("failed to read current context from kubeconfig '%s': %w", kubeconfigPath, err)
+	}
+
+	if currentContext == k8sContext {
+		if err := c.kubeconfigWriter.UnsetCurrentContext(kubeconfigPath); err != nil {
+			return err
+		}
+	}
+
+	// the user might have removed the entries manually, which must not prevent the revocation
+	if err := c.kubeconfigWriter.DeleteContext(k8sContext, kubeconfigPath); err != nil {
+		slog.Warn("Could not delete user context", "context", k8sContext, "error", err)
+	}
+	if err := c.kubeconfigWriter.DeleteUserCredentials(k8sUserName, kubeconfigPath); err != nil {
+		slog.Warn("Could not delete user credentials", "k8s-user-name", k8sUserName, "error", err)
+	}
+	return nil
+}
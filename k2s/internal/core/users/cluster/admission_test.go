@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package cluster_test
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/contracts/kubeconfig"
+	"github.com/siemens-healthineers/k2s/internal/contracts/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users/cluster"
+)
+
+// fakeCluster records the calls of all cluster admission dependencies
+type fakeCluster struct {
+	mu             sync.Mutex
+	calls          []string
+	certGroups     [][]string
+	certValidities []time.Duration
+	boundGroups    []string
+	currentContext string
+	removeErr      error
+	verifyErr      error
+	kubeconfigPath string
+}
+
+func TestPkg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cluster pkg Unit Tests", Label("unit", "ci", "cluster"))
+}
+
+var _ = BeforeSuite(func() {
+	slog.SetDefault(slog.New(logr.ToSlogHandler(GinkgoLogr)))
+})
+
+var _ = Describe("ClusterAdmission", func() {
+	var fake *fakeCluster
+	var sut *cluster.ClusterAdmission
+
+	BeforeEach(func() {
+		fake = &fakeCluster{kubeconfigPath: filepath.Join(GinkgoT().TempDir(), "config")}
+		clusterConfig := config.NewK2sClusterConfig("k2s-cluster", nil, nil, nil, nil)
+
+		sut = cluster.NewClusterAdmission(clusterConfig, fake, fake, fake, fake, fake, fake, fake, fake)
+	})
+
+	Describe("GrantAccess", func() {
+		It("binds the role to the group of the issued certificate", func() {
+			user := users.NewOSUser("1001", "john", "")
+
+			Expect(sut.GrantAccess(user, "k2s-john", users.Access{Role: users.RoleViewer}, 90*24*time.Hour)).To(Succeed())
+
+			Expect(fake.certValidities).To(Equal([]time.Duration{90 * 24 * time.Hour}))
+			Expect(fake.certGroups).To(HaveLen(1))
+			Expect(fake.boundGroups).To(HaveLen(1))
+			Expect(fake.certGroups[0]).To(ContainElement(fake.boundGroups[0]))
+			Expect(fake.boundGroups[0]).To(HavePrefix("k2s-cert-"))
+		})
+	})
+
+	Describe("RenewCredentials", func() {
+		It("binds the role to the group of the new certificate only", func() {
+			user := users.NewOSUser("1001", "john", "")
+			Expect(sut.GrantAccess(user, "k2s-john", users.Access{Role: users.RoleViewer}, 0)).To(Succeed())
+
+			_, err := sut.RenewCredentials("k2s-john", users.Access{Role: users.RoleViewer}, 0, fake.kubeconfigPath)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fake.boundGroups).To(HaveLen(2))
+			Expect(fake.certGroups[1]).To(ContainElement(fake.boundGroups[1]))
+			Expect(fake.boundGroups[1]).ToNot(Equal(fake.boundGroups[0]))
+		})
+
+		It("replaces the credentials keeping the contexts and returns the new certificate", func() {
+			certPem, err := sut.RenewCredentials("k2s-john", users.Access{Role: users.RoleViewer}, 90*24*time.Hour, fake.kubeconfigPath)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(certPem)).To(Equal("cert"))
+			Expect(fake.certValidities).To(Equal([]time.Duration{90 * 24 * time.Hour}))
+			Expect(fake.calls).To(Equal([]string{
+				"GenerateUserCert k2s-john",
+				"SetUserCredentials k2s-john",
+				"VerifyAccess k2s-john@k2s-cluster",
+				"BindRole k2s-john " + fake.boundGroups[0],
+			}))
+		})
+
+		It("does not bind the role to the new certificate if access cannot be verified", func() {
+			fake.verifyErr = errors.New("unauthorized")
+
+			_, err := sut.RenewCredentials("k2s-john", users.Access{Role: users.RoleViewer}, 0, fake.kubeconfigPath)
+
+			Expect(err).To(MatchError(ContainSubstring("failed to verify cluster access for user 'k2s-john'")))
+			Expect(fake.boundGroups).To(BeEmpty())
+		})
+	})
+
+	Describe("RevokeAccess", func() {
+		When("the user's kubeconfig exists", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(fake.kubeconfigPath, []byte("kubeconfig"), 0600)).To(Succeed())
+			})
+
+			It("removes the RBAC bindings and the user's context and credentials", func() {
+				fake.currentContext = "other@k2s-cluster"
+
+				Expect(sut.RevokeAccess("k2s-john", fake.kubeconfigPath)).To(Succeed())
+
+				Expect(fake.calls).To(Equal([]string{
+					"RemoveUserBindings k2s-john",
+					"ReadCurrentContext",
+					"DeleteContext k2s-john@k2s-cluster",
+					"DeleteUserCredentials k2s-john",
+				}))
+			})
+
+			It("unsets the current context if it is the user's context", func() {
+				fake.currentContext = "k2s-john@k2s-cluster"
+
+				Expect(sut.RevokeAccess("k2s-john", fake.kubeconfigPath)).To(Succeed())
+
+				Expect(fake.calls).To(ContainElement("UnsetCurrentContext"))
+			})
+		})
+
+		It("removes the RBAC bindings if the user's kubeconfig does not exist", func() {
+			Expect(sut.RevokeAccess("k2s-john", fake.kubeconfigPath)).To(Succeed())
+
+			Expect(fake.calls).To(Equal([]string{"RemoveUserBindings k2s-john"}))
+		})
+
+		It("keeps the user's kubeconfig if the RBAC bindings cannot be removed", func() {
+			Expect(os.WriteFile(fake.kubeconfigPath, []byte("kubeconfig"), 0600)).To(Succeed())
+			fake.removeErr = errors.New("forbidden")
+
+			err := sut.RevokeAccess("k2s-john", fake.kubeconfigPath)
+
+			Expect(err).To(MatchError(ContainSubstring("failed to remove RBAC bindings of user 'k2s-john'")))
+			Expect(fake.calls).To(Equal([]string{"RemoveUserBindings k2s-john"}))
+		})
+	})
+})
+
+func (f *fakeCluster) record(call ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, strings.Join(call, " "))
+}
+
+func (f *fakeCluster) ResolveKubeconfigPath(*users.OSUser) string {
+	return f.kubeconfigPath
+}
+
+func (f *fakeCluster) CopyClusterConfig(string) error {
+	f.record("CopyClusterConfig")
+	return nil
+}
+
+func (f *fakeCluster) GenerateUserCert(userName string, groups []string, validity time.Duration, targetDir string) (string, string, error) {
+	f.record("GenerateUserCert", userName)
+	f.mu.Lock()
+	f.certGroups = append(f.certGroups, slices.Clone(groups))
+	f.certValidities = append(f.certValidities, validity)
+	f.mu.Unlock()
+
+	certPath := filepath.Join(targetDir, "user.crt")
+	if err := os.WriteFile(certPath, []byte("cert"), 0600); err != nil {
+		return "", "", err
+	}
+	return certPath, filepath.Join(targetDir, "user.key"), nil
+}
+
+func (f *fakeCluster) SetUserCredentials(k8sUserName, _, _, _ string) error {
+	f.record("SetUserCredentials", k8sUserName)
+	return nil
+}
+
+func (f *fakeCluster) SetContext(context, _, _, _ string) error {
+	f.record("SetContext", context)
+	return nil
+}
+
+func (f *fakeCluster) SetCurrentContext(context, _ string) error {
+	f.record("SetCurrentContext", context)
+	return nil
+}
+
+func (f *fakeCluster) DeleteContext(context, _ string) error {
+	f.record("DeleteContext", context)
+	return nil
+}
+
+func (f *fakeCluster) DeleteUserCredentials(k8sUserName, _ string) error {
+	f.record("DeleteUserCredentials", k8sUserName)
+	return nil
+}
+
+func (f *fakeCluster) UnsetCurrentContext(string) error {
+	f.record("UnsetCurrentContext")
+	return nil
+}
+
+func (f *fakeCluster) ReadK8sApiCredentials(string, string) (*kubeconfig.ClusterConfig, *kubeconfig.UserConfig, error) {
+	return &kubeconfig.ClusterConfig{}, &kubeconfig.UserConfig{}, nil
+}
+
+func (f *fakeCluster) ReadCurrentContext(string) (string, error) {
+	f.record("ReadCurrentContext")
+	return f.currentContext, nil
+}
+
+func (f *fakeCluster) VerifyAccess(context, _ string) error {
+	f.record("VerifyAccess", context)
+	return f.verifyErr
+}
+
+func (f *fakeCluster) BindRole(k8sUserName, certGroup string, _ users.Access) error {
+	f.record("BindRole", k8sUserName, certGroup)
+	f.boundGroups = append(f.boundGroups, certGroup)
+	return nil
+}
+
+func (f *fakeCluster) RemoveUserBindings(k8sUserName string) error {
+	f.record("RemoveUserBindings", k8sUserName)
+	return f.removeErr
+}
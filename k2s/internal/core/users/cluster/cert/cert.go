@@ -6,53 +6,43 @@ package cert
 import (
 	"fmt"
 	"log/slog"
+	"os"
 	"path/filepath"
-
-	"github.com/siemens-healthineers/k2s/internal/contracts/ssh"
+	"time"
 )
 
-type remoteCertCreator interface {
-	Create(userName string) (tempRemoteDir, keyFileName, certFileName string, err error)
-}
-
-type remoteMover interface {
-	Move(copyOptions ssh.CopyOptions) error
+type certIssuer interface {
+	Issue(userName string, groups []string, validity time.Duration) (certPem, keyPem []byte, err error)
 }
 
 type CertGenerator struct {
-	remoteCertCreator remoteCertCreator
-	remoteMover       remoteMover
+	certIssuer certIssuer
 }
 
-func NewCertGenerator(remoteCertCreator remoteCertCreator, remoteCertMover remoteMover) *CertGenerator {
+func NewCertGenerator(certIssuer certIssuer) *CertGenerator {
 	return &CertGenerator{
-		remoteCertCreator: remoteCertCreator,
-		remoteMover:       remoteCertMover,
+		certIssuer: certIssuer,
 	}
 }
 
-func (c *CertGenerator) GenerateUserCert(userName string, targetDir string) (certPath, keyPath string, err error) {
-	slog.Debug("Generating user cert", "user-name", userName, "target-dir", targetDir)
+// GenerateUserCert issues a user certificate and writes the certificate and private key files to the target directory on the host.
+func (c *CertGenerator) GenerateUserCert(userName string, groups []string, validity time.Duration, targetDir string) (certPath, keyPath string, err error) {
+	slog.Debug("Generating user cert", "user-name", userName, "groups", groups, "validity", validity, "target-dir", targetDir)
 
-	tempRemoteDir, keyFileName, certFileName, err := c.remoteCertCreator.Create(userName)
+	certPem, keyPem, err := c.certIssuer.Issue(userName, groups, validity)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to create user certificate on remote machine for user '%s': %w", userName, err)
+		return "", "", fmt.Errorf("failed to issue user certificate for user '%s': %w", userName, err)
 	}
 
-	options := ssh.CopyOptions{
-		Source:    tempRemoteDir,
-		Target:    targetDir,
-		Direction: ssh.CopyFromNode,
-	}
+	certPath = filepath.Join(targetDir, userName+".crt")
+	keyPath = filepath.Join(targetDir, userName+".key")
 
-	err = c.remoteMover.Move(options)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to move user certificate files from remote machine for user '%s': %w", userName, err)
+	if err := os.WriteFile(certPath, certPem, 0600); err != nil {
+		return "", "", fmt.Errorf("failed to write user certificate for user '%s': %w", userName, err)
+	}
+	if err := os.WriteFile(keyPath, keyPem, 0600); err != nil {
+		return "", "", fmt.Errorf("failed to write user key for user '%s': %w", userName, err)
 	}
-
-	tempDirName := filepath.Base(tempRemoteDir)
-	certPath = filepath.Join(targetDir, tempDirName, certFileName)
-	keyPath = filepath.Join(targetDir, tempDirName, keyFileName)
 
 	slog.Debug("User cert generated", "user-name", userName, "target-dir", targetDir, "cert-path", certPath, "key-path", keyPath)
 	return
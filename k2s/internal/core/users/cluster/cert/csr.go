@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package cert
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type kubectl interface {
+	Exec(args ...string) error
+	ExecWithOutput(args ...string) (string, error)
+}
+
+// CSRIssuer issues client certificates through the Kubernetes CertificateSigningRequest API. The private key
+// is generated on the host and never leaves it.
+type CSRIssuer struct {
+	kubectl      kubectl
+	pollInterval time.Duration
+	pollTimeout  time.Duration
+}
+
+type csrManifest struct {
+	ApiVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Metadata   csrMetadata `json:"metadata"`
+	Spec       csrSpec     `json:"spec"`
+}
+
+type csrMetadata struct {
+	Name string `json:"name"`
+}
+
+type csrSpec struct {
+	Request           []byte   `json:"request"`
+	SignerName        string   `json:"signerName"`
+	ExpirationSeconds int64    `json:"expirationSeconds"`
+	Usages            []string `json:"usages"`
+}
+
+type csrStatus struct {
+	Status struct {
+		Certificate []byte `json:"certificate"`
+		Conditions  []struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+		} `json:"conditions"`
+	} `json:"status"`
+}
+
+const (
+	privateKeyBits = 4096
+	// DefaultValidity is the validity of user certificates if not specified otherwise
+	DefaultValidity = 365 * 24 * time.Hour
+	// MinValidity is the minimum validity accepted by the Kubernetes CSR API
+	MinValidity = 10 * time.Minute
+
+	clientSignerName = "kubernetes.io/kube-apiserver-client"
+	csrNamePrefix    = "k2s-user-"
+)
+
+func NewCSRIssuer(kubectl kubectl) *CSRIssuer {
+	return &CSRIssuer{
+		kubectl:      kubectl,
+		pollInterval: time.Second,
+		pollTimeout:  30 * time.Second,
+	}
+}
+
+// Issue creates a private key and a client certificate for the user and groups, signed by the cluster CA.
+// The signer might cap the validity, e.g. to the controller manager's '--cluster-signing-duration'.
+func (c *CSRIssuer) Issue(userName string, groups []string, validity time.Duration) (certPem, keyPem []byte, err error) {
+	slog.Debug("Issuing user cert through Kubernetes CSR API", "user-name", userName, "groups", groups, "validity", validity)
+
+	if validity == 0 {
+		validity = DefaultValidity
+	}
+	if validity < MinValidity {
+		return nil, nil, fmt.Errorf("certificate validity must be at least %s", MinValidity)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, privateKeyBits)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate private key: %w", err)
+	}
+
+	request, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: userName, Organization: groups},
+	}, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create certificate signing request: %w", err)
+	}
+
+	name := csrNamePrefix + strings.ToLower(rand.Text())
+
+	if err := c.submit(name, request, validity); err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if err := c.kubectl.Exec("delete", "csr", name, "--ignore-not-found"); err != nil {
+			slog.Warn("Could not delete certificate signing request", "name", name, "error", err)
+		}
+	}()
+
+	if err := c.kubectl.Exec("certificate", "approve", name); err != nil {
+		return nil, nil, fmt.Errorf("failed to approve certificate signing request '%s': %w", name, err)
+	}
+
+	certPem, err = c.waitForCert(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := verifyCert(certPem, key); err != nil {
+		return nil, nil, err
+	}
+
+	keyPem = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	slog.Debug("User cert issued", "user-name", userName, "csr", name)
+	return certPem, keyPem, nil
+}
+
+func (c *CSRIssuer) submit(name string, request []byte, validity time.Duration) error {
+	manifest := csrManifest{
+		ApiVersion: "certificates.k8s.io/v1",
+		Kind:       "CertificateSigningRequest",
+		Metadata:   csrMetadata{Name: name},
+		Spec: csrSpec{
+			Request:           pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: request}),
+			SignerName:        clientSignerName,
+			ExpirationSeconds: int64(validity.Seconds()),
+			Usages:            []string{"client auth"},
+		},
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal certificate signing request: %w", err)
+	}
+
+	tempDir, err := os.MkdirTemp("", "k2s-csr-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory for certificate signing request: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	manifestPath := filepath.Join(tempDir, name+".json")
+	if err := os.WriteFile(manifestPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write certificate signing request: %w", err)
+	}
+
+	if err := c.kubectl.Exec("create", "-f", manifestPath); err != nil {
+		return fmt.Errorf("failed to submit certificate signing request '%s': %w", name, err)
+	}
+	return nil
+}
+
+func (c *CSRIssuer) waitForCert(name string) ([]byte, error) {
+	deadline := time.Now().Add(c.pollTimeout)
+	for {
+		output, err := c.kubectl.ExecWithOutput("get", "csr", name, "-o", "json")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read certificate signing request '%s': %w", name, err)
+		}
+
+		var csr csrStatus
+		if err := json.Unmarshal([]byte(output), &csr); err != nil {
+			return nil, fmt.Errorf("failed to parse certificate signing request '%s': %w", name, err)
+		}
+
+		for _, condition := range csr.Status.Conditions {
+			if condition.Type == "Denied" || condition.Type == "Failed" {
+				return nil, fmt.Errorf("certificate signing request '%s' %s: %s", name, strings.ToLower(condition.Type), condition.Message)
+			}
+		}
+
+		if len(csr.Status.Certificate) > 0 {
+			return csr.Status.Certificate, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for certificate signing request '%s' to be signed", name)
+		}
+		time.Sleep(c.pollInterval)
+	}
+}
+
+func verifyCert(certPem []byte, key *rsa.PrivateKey) error {
+	block, _ := pem.Decode(certPem)
+	if block == nil {
+		return errors.New("failed to decode issued certificate: no PEM data found")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse issued certificate: %w", err)
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return fmt.Errorf("failed to marshal public key: %w", err)
+	}
+	if !bytes.Equal(cert.RawSubjectPublicKeyInfo, publicKey) {
+		return errors.New("issued certificate does not match the private key")
+	}
+	return nil
+}
@@ -86,3 +86,36 @@ func (k *KubeconfigWriter) SetCurrentContext(context, kubeconfigPath string) err
 	slog.Debug("Current context set", "context", context, "kubeconfig-path", kubeconfigPath)
 	return nil
 }
+
+func (k *KubeconfigWriter) DeleteContext(context, kubeconfigPath string) error {
+	slog.Debug("Deleting context", "context", context, "kubeconfig-path", kubeconfigPath)
+
+	if err := k.kubectl.Exec("config", "delete-context", context, "--kubeconfig", kubeconfigPath); err != nil {
+		return fmt.Errorf("failed to delete context in kubeconfig '%s': %w", kubeconfigPath, err)
+	}
+
+	slog.Debug("Context deleted", "context", context, "kubeconfig-path", kubeconfigPath)
+	return nil
+}
+
+func (k *KubeconfigWriter) DeleteUserCredentials(k8sUserName, kubeconfigPath string) error {
+	slog.Debug("Deleting user credentials", "k8s-user-name", k8sUserName, "kubeconfig-path", kubeconfigPath)
+
+	if err := k.kubectl.Exec("config", "delete-user", k8sUserName, "--kubeconfig", kubeconfigPath); err != nil {
+		return fmt.Errorf("failed to delete user credentials in kubeconfig '%s': %w", kubeconfigPath, err)
+	}
+
+	slog.Debug("User credentials deleted", "k8s-user-name", k8sUserName, "kubeconfig-path", kubeconfigPath)
+	return nil
+}
+
+func (k *KubeconfigWriter) UnsetCurrentContext(kubeconfigPath string) error {
+	slog.Debug("Unsetting current context", "kubeconfig-path", kubeconfigPath)
+
+	if err := k.kubectl.Exec("config", "unset", "current-context", "--kubeconfig", kubeconfigPath); err != nil {
+		return fmt.Errorf("failed to unset current context in kubeconfig '%s': %w", kubeconfigPath, err)
+	}
+
+	slog.Debug("Current context unset", "kubeconfig-path", kubeconfigPath)
+	return nil
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package rbac
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+type kubectl interface {
+	Exec(args ...string) error
+	ExecWithOutput(args ...string) (string, error)
+}
+
+// Binding is a ClusterRoleBinding or RoleBinding referring to a user or the role binding K2s created for the user.
+type Binding struct {
+	Kind      string
+	Namespace string
+	Name      string
+	// SubjectIndex is the position of the user within the binding's subjects
+	SubjectIndex int
+	// SoleSubject is true if the user is the only subject of the binding
+	SoleSubject bool
+}
+
+type BindingRemover struct {
+	kubectl kubectl
+}
+
+func NewBindingRemover(kubectl kubectl) *BindingRemover {
+	return &BindingRemover{kubectl: kubectl}
+}
+
+// RemoveUserBindings removes the user from all ClusterRoleBindings and RoleBindings. Bindings
+// referring to the user only and the role binding K2s created for the user's certificate group are deleted,
+// other bindings are kept without the user.
+func (b *BindingRemover) RemoveUserBindings(k8sUserName string) error {
+	slog.Debug("Removing RBAC bindings of user", "k8s-user-name", k8sUserName)
+
+	output, err := b.kubectl.ExecWithOutput("get", "clusterrolebindings,rolebindings", "--all-namespaces", "-o", "json")
+	if err != nil {
+		return fmt.Errorf("failed to list RBAC bindings: %w", err)
+	}
+
+	bindings, err := FindUserBindings([]byte(output), k8sUserName)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, binding := range bindings {
+		args := []string{}
+		if binding.SoleSubject {
+			args = append(args, "delete", binding.Kind, binding.Name)
+		} else {
+			patch := fmt.Sprintf(`[{"op":"remove","path":"/subjects/%d"}]`, binding.SubjectIndex)
+			args = append(args, "patch", binding.Kind, binding.Name, "--type=json", "-p", patch)
+		}
+		if binding.Namespace != "" {
+			args = append(args, "-n", binding.Namespace)
+		}
+
+		slog.Debug("Removing user from RBAC binding", "kind", binding.Kind, "namespace", binding.Namespace, "name", binding.Name, "delete", binding.SoleSubject)
+		if err := b.kubectl.Exec(args...); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove user from %s '%s': %w", binding.Kind, binding.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// FindUserBindings returns the bindings in the given kubectl JSON list having the user as subject and the role
+// binding K2s created for the user, which refers to the group of the user's current certificate instead.
+func FindUserBindings(bindingList []byte, k8sUserName string) ([]Binding, error) {
+	var list struct {
+		Items []struct {
+			Kind     string `json:"kind"`
+			Metadata struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"metadata"`
+			Subjects []struct {
+				Kind string `json:"kind"`
+				Name string `json:"name"`
+			} `json:"subjects"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(bindingList, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse RBAC bindings: %w", err)
+	}
+
+	name := BindingName(k8sUserName)
+
+	var bindings []Binding
+	for _, item := range list.Items {
+		if item.Metadata.Name == name {
+			bindings = append(bindings, Binding{
+				Kind:        item.Kind,
+				Namespace:   item.Metadata.Namespace,
+				Name:        item.Metadata.Name,
+				SoleSubject: true,
+			})
+			continue
+		}
+		for i, subject := range item.Subjects {
+			if subject.Kind != "User" || subject.Name != k8sUserName {
+				continue
+			}
+			bindings = append(bindings, Binding{
+				Kind:         item.Kind,
+				Namespace:    item.Metadata.Namespace,
+				Name:         item.Metadata.Name,
+				SubjectIndex: i,
+				SoleSubject:  len(item.Subjects) == 1,
+			})
+			break
+		}
+	}
+	return bindings, nil
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package rbac_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/contracts/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users/cluster/rbac"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockKubectl struct {
+	mock.Mock
+}
+
+func (m *mockKubectl) Exec(args ...string) error {
+	return m.Called(args).Error(0)
+}
+
+func (m *mockKubectl) ExecWithOutput(args ...string) (string, error) {
+	called := m.Called(args)
+	return called.String(0), called.Error(1)
+}
+
+const bindingList = `{
+  "items": [
+    {"kind": "ClusterRoleBinding", "metadata": {"name": "john-admin"}, "subjects": [{"kind": "User", "name": "k2s-john"}]},
+    {"kind": "RoleBinding", "metadata": {"name": "devs", "namespace": "team"}, "subjects": [{"kind": "User", "name": "k2s-jane"}, {"kind": "User", "name": "k2s-john"}]},
+    {"kind": "RoleBinding", "metadata": {"name": "group", "namespace": "team"}, "subjects": [{"kind": "Group", "name": "k2s-john"}]},
+    {"kind": "ClusterRoleBinding", "metadata": {"name": "k2s-john-role"}, "subjects": [{"kind": "Group", "name": "k2s-cert-0123456789abcdef"}]},
+    {"kind": "ClusterRoleBinding", "metadata": {"name": "system"}}
+  ]
+}`
+
+func TestPkg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rbac pkg Unit Tests", Label("unit", "ci", "rbac"))
+}
+
+var _ = BeforeSuite(func() {
+	slog.SetDefault(slog.New(logr.ToSlogHandler(GinkgoLogr)))
+})
+
+var _ = Describe("rbac pkg", func() {
+	Describe("FindUserBindings", func() {
+		It("returns the bindings having the user as subject and the K2s role binding", func() {
+			bindings, err := rbac.FindUserBindings([]byte(bindingList), "k2s-john")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bindings).To(Equal([]rbac.Binding{
+				{Kind: "ClusterRoleBinding", Name: "john-admin", SubjectIndex: 0, SoleSubject: true},
+				{Kind: "RoleBinding", Namespace: "team", Name: "devs", SubjectIndex: 1, SoleSubject: false},
+				{Kind: "ClusterRoleBinding", Name: "k2s-john-role", SoleSubject: true},
+			}))
+		})
+
+		It("returns an error on invalid input", func() {
+			_, err := rbac.FindUserBindings([]byte("oops"), "k2s-john")
+
+			Expect(err).To(MatchError(ContainSubstring("failed to parse RBAC bindings")))
+		})
+	})
+
+	Describe("BindingRemover", func() {
+		It("deletes bindings of the user only and the K2s role binding and removes the user from shared bindings", func() {
+			kubectl := &mockKubectl{}
+			kubectl.On("ExecWithOutput", []string{"get", "clusterrolebindings,rolebindings", "--all-namespaces", "-o", "json"}).Return(bindingList, nil)
+			kubectl.On("Exec", []string{"delete", "ClusterRoleBinding", "john-admin"}).Return(nil)
+			kubectl.On("Exec", []string{"patch", "RoleBinding", "devs", "--type=json", "-p", `[{"op":"remove","path":"/subjects/1"}]`, "-n", "team"}).Return(nil)
+			kubectl.On("Exec", []string{"delete", "ClusterRoleBinding", "k2s-john-role"}).Return(nil)
+
+			sut := rbac.NewBindingRemover(kubectl)
+
+			Expect(sut.RemoveUserBindings("k2s-john")).To(Succeed())
+
+			kubectl.AssertExpectations(GinkgoT())
+		})
+	})
+
+	Describe("Groups", func() {
+		It("returns the common K2s user group and the certificate group", func() {
+			Expect(rbac.Groups("k2s-cert-01")).To(Equal([]string{"k2s-users", "k2s-cert-01"}))
+		})
+	})
+
+	Describe("NewCertGroup", func() {
+		It("returns unique K2s group names", func() {
+			first, err := rbac.NewCertGroup()
+			Expect(err).ToNot(HaveOccurred())
+
+			second, err := rbac.NewCertGroup()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(first).To(MatchRegexp(`^k2s-cert-[0-9a-f]{16}$`))
+			Expect(second).ToNot(Equal(first))
+		})
+	})
+
+	DescribeTable("ClusterRole", func(access users.Access, expected string) {
+		Expect(rbac.ClusterRole(access)).To(Equal(expected))
+	},
+		Entry("viewer", users.Access{Role: users.RoleViewer}, "view"),
+		Entry("developer in namespace", users.Access{Role: users.RoleDeveloper, Namespace: "team"}, "edit"),
+		Entry("cluster-wide admin", users.Access{Role: users.RoleAdmin}, "cluster-admin"),
+		Entry("namespace admin", users.Access{Role: users.RoleAdmin, Namespace: "team"}, "admin"),
+	)
+
+	Describe("BindingName", func() {
+		It("returns a valid Kubernetes resource name", func() {
+			Expect(rbac.BindingName("k2s-AD123-John_Doe")).To(Equal("k2s-ad123-john-doe-role"))
+		})
+	})
+
+	Describe("RoleBinder", func() {
+		const previousBindings = `{
+  "items": [
+    {"kind": "RoleBinding", "metadata": {"name": "k2s-john-role", "namespace": "old"}, "subjects": [{"kind": "Group", "name": "k2s-cert-01"}]},
+    {"kind": "ClusterRoleBinding", "metadata": {"name": "custom"}, "subjects": [{"kind": "User", "name": "k2s-john"}]}
+  ]
+}`
+
+		It("deletes the previous role binding only if no role is given", func() {
+			kubectl := &mockKubectl{}
+			kubectl.On("ExecWithOutput", []string{"get", "clusterrolebindings,rolebindings", "--all-namespaces", "-o", "json"}).Return(previousBindings, nil)
+			kubectl.On("Exec", []string{"delete", "RoleBinding", "k2s-john-role", "-n", "old"}).Return(nil)
+
+			sut := rbac.NewRoleBinder(kubectl)
+
+			Expect(sut.BindRole("k2s-john", "k2s-cert-02", users.Access{})).To(Succeed())
+
+			kubectl.AssertExpectations(GinkgoT())
+			kubectl.AssertNumberOfCalls(GinkgoT(), "Exec", 1)
+		})
+
+		It("replaces the previous role binding and keeps other bindings", func() {
+			kubectl := &mockKubectl{}
+			kubectl.On("ExecWithOutput", []string{"get", "clusterrolebindings,rolebindings", "--all-namespaces", "-o", "json"}).Return(previousBindings, nil)
+			kubectl.On("Exec", []string{"delete", "RoleBinding", "k2s-john-role", "-n", "old"}).Return(nil)
+			kubectl.On("Exec", []string{"create", "rolebinding", "k2s-john-role", "-n", "team", "--clusterrole=edit", "--group=k2s-cert-02"}).Return(nil)
+
+			sut := rbac.NewRoleBinder(kubectl)
+
+			Expect(sut.BindRole("k2s-john", "k2s-cert-02", users.Access{Role: users.RoleDeveloper, Namespace: "team"})).To(Succeed())
+
+			kubectl.AssertExpectations(GinkgoT())
+			kubectl.AssertNumberOfCalls(GinkgoT(), "Exec", 2)
+		})
+
+		It("creates a cluster-wide binding if no namespace is given", func() {
+			kubectl := &mockKubectl{}
+			kubectl.On("ExecWithOutput", mock.Anything).Return(`{"items": []}`, nil)
+			kubectl.On("Exec", []string{"create", "clusterrolebinding", "k2s-john-role", "--clusterrole=view", "--group=k2s-cert-02"}).Return(nil)
+
+			sut := rbac.NewRoleBinder(kubectl)
+
+			Expect(sut.BindRole("k2s-john", "k2s-cert-02", users.Access{Role: users.RoleViewer})).To(Succeed())
+
+			kubectl.AssertExpectations(GinkgoT())
+		})
+	})
+})
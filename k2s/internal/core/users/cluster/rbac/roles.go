@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package rbac
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/siemens-healthineers/k2s/internal/contracts/users"
+	"github.com/siemens-healthineers/k2s/internal/definitions"
+)
+
+// RoleBinder binds the Kubernetes permissions of a role to a user. The bindings refer to a group unique to the
+// user's current certificate, so that replaced or revoked certificates lose the permissions although Kubernetes
+// cannot reject them.
+type RoleBinder struct {
+	kubectl kubectl
+}
+
+const (
+	bindingNameSuffix = "-role"
+	certGroupPrefix   = definitions.K2sUsersPrefix + "cert-"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+func NewRoleBinder(kubectl kubectl) *RoleBinder {
+	return &RoleBinder{kubectl: kubectl}
+}
+
+// Groups returns the groups a user certificate is issued for: the common K2s user group and the certificate group
+// the user's role is bound to. The role itself is not part of the certificate, so that changing the role does not
+// require a new certificate and a revoked certificate cannot claim it.
+func Groups(certGroup string) []string {
+	return []string{definitions.K2sUserGroup, certGroup}
+}
+
+// NewCertGroup returns a random group name identifying a single user certificate.
+func NewCertGroup() (string, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate certificate group: %w", err)
+	}
+	return certGroupPrefix + hex.EncodeToString(id), nil
+}
+
+// ClusterRole returns the Kubernetes default ClusterRole granting the permissions of the role.
+func ClusterRole(access users.Access) string {
+	switch access.Role {
+	case users.RoleViewer:
+		return "view"
+	case users.RoleDeveloper:
+		return "edit"
+	case users.RoleAdmin:
+		if access.Namespace == "" {
+			return "cluster-admin"
+		}
+		return "admin"
+	default:
+		return ""
+	}
+}
+
+// BindingName returns the name of the binding K2s creates for the user's role.
+func BindingName(k8sUserName string) string {
+	return invalidNameChars.ReplaceAllString(strings.ToLower(k8sUserName), "-") + bindingNameSuffix
+}
+
+// BindRole replaces a previous role binding of the user with a ClusterRoleBinding or, if a namespace
+// is given, a RoleBinding in that namespace for the given certificate group. Previous certificates of the user
+// lose the permissions also if no role is given. Bindings not created by K2s are kept.
+func (r *RoleBinder) BindRole(k8sUserName, certGroup string, access users.Access) error {
+	slog.Debug("Binding role to user", "k8s-user-name", k8sUserName, "cert-group", certGroup, "role", access.Role, "namespace", access.Namespace)
+
+	output, err := r.kubectl.ExecWithOutput("get", "clusterrolebindings,rolebindings", "--all-namespaces", "-o", "json")
+	if err != nil {
+		return fmt.Errorf("failed to list RBAC bindings: %w", err)
+	}
+
+	bindings, err := FindUserBindings([]byte(output), k8sUserName)
+	if err != nil {
+		return err
+	}
+
+	name := BindingName(k8sUserName)
+
+	for _, binding := range bindings {
+		if binding.Name != name {
+			continue
+		}
+		args := []string{"delete", binding.Kind, binding.Name}
+		if binding.Namespace != "" {
+			args = append(args, "-n", binding.Namespace)
+		}
+
+		slog.Debug("Deleting previous role binding", "kind", binding.Kind, "namespace", binding.Namespace, "name", binding.Name)
+		if err := r.kubectl.Exec(args...); err != nil {
+			return fmt.Errorf("failed to delete previous role binding '%s': %w", binding.Name, err)
+		}
+	}
+
+	if access.Role == users.RoleNone {
+		return nil
+	}
+
+	args := []string{"create", "clusterrolebinding", name}
+	if access.Namespace != "" {
+		args = []string{"create", "rolebinding", name, "-n", access.Namespace}
+	}
+	args = append(args, "--clusterrole="+ClusterRole(access), "--group="+certGroup)
+
+	if err := r.kubectl.Exec(args...); err != nil {
+		return fmt.Errorf("failed to bind role '%s' to user '%s': %w", access.Role, k8sUserName, err)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"os"
 	"path/filepath"
 	"sync"
 
@@ -26,6 +27,7 @@ type aclProvider interface {
 
 type keyAuthorizer interface {
 	AuthorizePubKeyOnRemote(publicKeyPath, publicKeyComment string) error
+	RevokePubKeyOnRemote(publicKeyComment string) error
 }
 
 type knownHostsCopier interface {
@@ -53,8 +55,7 @@ func NewControlPlaneAdmission(config *config.K2sConfig, sshProvider sshProvider,
 func (u *ControlPlaneAdmission) GrantAccess(user *users.OSUser, publicKeyComment string) error {
 	slog.Debug("Granting user access to control-plane", "name", user.Name(), "id", user.Id())
 
-	sshDir := host.ResolveTildePrefix(u.config.Host().SshConfig().RelativeDir(), user.HomeDir())
-	privateKeyPath := filepath.Join(sshDir, definitions.SSHSubDirName, definitions.SSHPrivateKeyName)
+	privateKeyPath := ResolvePrivateKeyPath(u.config.Host().SshConfig(), user)
 
 	slog.Debug("SSH private key path determined", "path", privateKeyPath)
 
@@ -92,3 +93,25 @@ func (u *ControlPlaneAdmission) GrantAccess(user *users.OSUser, publicKeyComment
 
 	return errors.Join(allErrors...)
 }
+
+// RevokeAccess removes the user's public SSH key from the control-plane and deletes the user's key pair.
+func (u *ControlPlaneAdmission) RevokeAccess(publicKeyComment, privateKeyPath string) error {
+	slog.Debug("Revoking user access to control-plane", "comment", publicKeyComment, "private-key-path", privateKeyPath)
+
+	if err := u.keyAuthorizer.RevokePubKeyOnRemote(publicKeyComment); err != nil {
+		return fmt.Errorf("failed to revoke public SSH key on control-plane: %w", err)
+	}
+
+	for _, path := range []string{privateKeyPath, privateKeyPath + ".pub"} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to delete SSH key '%s': %w", path, err)
+		}
+	}
+	return nil
+}
+
+// ResolvePrivateKeyPath returns the path of the user's private SSH key for control-plane access.
+func ResolvePrivateKeyPath(sshConfig *config.SSHConfig, user *users.OSUser) string {
+	sshDir := host.ResolveTildePrefix(sshConfig.RelativeDir(), user.HomeDir())
+	return filepath.Join(sshDir, definitions.SSHSubDirName, definitions.SSHPrivateKeyName)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"path/filepath"
+	"strings"
 
 	"github.com/siemens-healthineers/k2s/internal/contracts/ssh"
 )
@@ -49,7 +50,7 @@ func (k *KeyAuthorizer) AuthorizePubKeyOnRemote(publicKeyPath, publicKeyComment
 		return fmt.Errorf("failed to copy public SSH key to remote machine: %w", err)
 	}
 
-	deleteObsoletePubKeyFromAuthKeys := fmt.Sprintf("sudo sed -i '/.*%s.*/d' %s", publicKeyComment, authorizedKeysPath)
+	deleteObsoletePubKeyFromAuthKeys := removePubKeyCmd(publicKeyComment)
 	addNewPubKeyToAuthKeys := fmt.Sprintf("sudo cat %s >> %s", remotePubKeyPath, authorizedKeysPath)
 	removePubKeyFile := "rm -f " + remotePubKeyPath
 
@@ -65,3 +66,31 @@ func (k *KeyAuthorizer) AuthorizePubKeyOnRemote(publicKeyPath, publicKeyComment
 	slog.Debug("Public SSH key authorized on remote machine", "path", publicKeyPath, "comment", publicKeyComment)
 	return nil
 }
+
+// RevokePubKeyOnRemote removes the public SSH key with the given comment from the authorized keys file.
+func (k *KeyAuthorizer) RevokePubKeyOnRemote(publicKeyComment string) error {
+	slog.Debug("Revoking public SSH key on remote machine", "comment", publicKeyComment)
+
+	if err := k.remoteAccessProvider.Exec(removePubKeyCmd(publicKeyComment)); err != nil {
+		return fmt.Errorf("failed to remove public SSH key from authorized keys file: %w", err)
+	}
+
+	slog.Debug("Public SSH key revoked on remote machine", "comment", publicKeyComment)
+	return nil
+}
+
+// removePubKeyCmd returns a shell command removing the public SSH keys with the given comment from the authorized
+// keys file. The comment is compared as fixed string with the end of each line, so that neither special characters
+// nor comments starting with the given one select other keys. The file is rewritten in place to keep its ownership
+// and permissions.
+func removePubKeyCmd(publicKeyComment string) string {
+	const filter = `substr($0, length($0) - length(ENVIRON["K2S_KEY_COMMENT"]) + 1) != ENVIRON["K2S_KEY_COMMENT"]`
+
+	return fmt.Sprintf(`{ tmp=$(mktemp) && K2S_KEY_COMMENT=%s awk '%s' %s > "$tmp" && cp "$tmp" %s; rc=$?; rm -f "$tmp"; [ $rc -eq 0 ]; }`,
+		quoteShellArg(" "+publicKeyComment), filter, authorizedKeysPath, authorizedKeysPath)
+}
+
+// quoteShellArg quotes the value as a single POSIX shell word.
+func quoteShellArg(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package keyauth_test
+
+import (
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/contracts/ssh"
+	"github.com/siemens-healthineers/k2s/internal/core/users/controlplane/keyauth"
+)
+
+// shellRemote runs the commands in a local POSIX shell with the given home directory
+type shellRemote struct {
+	homeDir string
+}
+
+func TestPkg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "keyauth pkg Tests", Label("ci", "keyauth"))
+}
+
+var _ = BeforeSuite(func() {
+	slog.SetDefault(slog.New(logr.ToSlogHandler(GinkgoLogr)))
+})
+
+var _ = Describe("KeyAuthorizer", func() {
+	Describe("RevokePubKeyOnRemote", func() {
+		var remote *shellRemote
+		var authorizedKeysPath string
+
+		BeforeEach(func() {
+			if _, err := exec.LookPath("sh"); err != nil {
+				Skip("POSIX shell not available")
+			}
+
+			remote = &shellRemote{homeDir: GinkgoT().TempDir()}
+			authorizedKeysPath = filepath.Join(remote.homeDir, ".ssh", "authorized_keys")
+
+			Expect(os.MkdirAll(filepath.Dir(authorizedKeysPath), 0700)).To(Succeed())
+			Expect(os.WriteFile(authorizedKeysPath, []byte(
+				"ssh-rsa AAAA1 k2s-john\n"+
+					"ssh-rsa AAAA2 k2s-johnny\n"+
+					"ssh-rsa AAAA3 k2s-john.doe\n"+
+					"ssh-rsa AAAA4 remote@control-plane\n"+
+					"ssh-rsa AAAA5 k2s-it's-me\n"), 0600)).To(Succeed())
+		})
+
+		It("removes only the key with exactly the given comment", func() {
+			sut := keyauth.NewKeyAuthorizer(remote)
+
+			Expect(sut.RevokePubKeyOnRemote("k2s-john")).To(Succeed())
+
+			Expect(os.ReadFile(authorizedKeysPath)).To(BeEquivalentTo(
+				"ssh-rsa AAAA2 k2s-johnny\n" +
+					"ssh-rsa AAAA3 k2s-john.doe\n" +
+					"ssh-rsa AAAA4 remote@control-plane\n" +
+					"ssh-rsa AAAA5 k2s-it's-me\n"))
+		})
+
+		It("does not interpret the comment as pattern", func() {
+			sut := keyauth.NewKeyAuthorizer(remote)
+
+			Expect(sut.RevokePubKeyOnRemote("k2s-john.*")).To(Succeed())
+
+			Expect(os.ReadFile(authorizedKeysPath)).To(ContainSubstring("k2s-john\n"))
+			Expect(os.ReadFile(authorizedKeysPath)).To(ContainSubstring("k2s-john.doe\n"))
+		})
+
+		It("handles quotes in the comment", func() {
+			sut := keyauth.NewKeyAuthorizer(remote)
+
+			Expect(sut.RevokePubKeyOnRemote("k2s-it's-me")).To(Succeed())
+
+			Expect(os.ReadFile(authorizedKeysPath)).ToNot(ContainSubstring("AAAA5"))
+			Expect(os.ReadFile(authorizedKeysPath)).To(ContainSubstring("AAAA1"))
+		})
+
+		It("keeps an empty file when the last key is removed", func() {
+			Expect(os.WriteFile(authorizedKeysPath, []byte("ssh-rsa AAAA1 k2s-john\n"), 0600)).To(Succeed())
+			sut := keyauth.NewKeyAuthorizer(remote)
+
+			Expect(sut.RevokePubKeyOnRemote("k2s-john")).To(Succeed())
+
+			Expect(os.ReadFile(authorizedKeysPath)).To(BeEmpty())
+		})
+
+		It("returns an error if the authorized keys file cannot be read", func() {
+			Expect(os.Remove(authorizedKeysPath)).To(Succeed())
+			sut := keyauth.NewKeyAuthorizer(remote)
+
+			err := sut.RevokePubKeyOnRemote("k2s-john")
+
+			Expect(err).To(MatchError(ContainSubstring("failed to remove public SSH key")))
+		})
+	})
+})
+
+func (r *shellRemote) Exec(command string) error {
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Env = append(os.Environ(), "HOME="+r.homeDir)
+	cmd.Stdout = GinkgoWriter
+	cmd.Stderr = GinkgoWriter
+	return cmd.Run()
+}
+
+func (r *shellRemote) Copy(ssh.CopyOptions) error {
+	return nil
+}
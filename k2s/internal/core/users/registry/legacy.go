@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/contracts/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users/controlplane"
+)
+
+type osUserFinder interface {
+	FindByName(name string) (*users.OSUser, error)
+	FindById(id string) (*users.OSUser, error)
+}
+
+type userValidator interface {
+	ValidateUser(user *users.OSUser) error
+}
+
+type k2sUserNameProvider interface {
+	DetermineK2sUserName(user *users.OSUser) string
+}
+
+// LegacyUserFinder finds users added before K2s kept a users registry by their credentials on disk.
+type LegacyUserFinder struct {
+	osUserFinder        osUserFinder
+	userValidator       userValidator
+	k2sUserNameProvider k2sUserNameProvider
+	sshConfig           *config.SSHConfig
+	clusterName         string
+	kubeconfigResolver  kubeconfigResolver
+	kubeconfigReader    kubeconfigReader
+	credentialsDecoder  credentialsDecoder
+}
+
+func NewLegacyUserFinder(osUserFinder osUserFinder, userValidator userValidator, k2sUserNameProvider k2sUserNameProvider, sshConfig *config.SSHConfig, clusterName string, kubeconfigResolver kubeconfigResolver, kubeconfigReader kubeconfigReader, credentialsDecoder credentialsDecoder) *LegacyUserFinder {
+	return &LegacyUserFinder{
+		osUserFinder:        osUserFinder,
+		userValidator:       userValidator,
+		k2sUserNameProvider: k2sUserNameProvider,
+		sshConfig:           sshConfig,
+		clusterName:         clusterName,
+		kubeconfigResolver:  kubeconfigResolver,
+		kubeconfigReader:    kubeconfigReader,
+		credentialsDecoder:  credentialsDecoder,
+	}
+}
+
+// FindLegacyUser returns the entry of the OS user with the given name or id, determined from the user's K2s SSH key
+// and the K2s credentials in the user's kubeconfig. It returns ErrUserNotRegistered if the OS user does not exist or
+// has neither of them.
+func (f *LegacyUserFinder) FindLegacyUser(nameOrId string) (*UserEntry, error) {
+	slog.Debug("Looking for credentials of unregistered user", "name-or-id", nameOrId)
+
+	user, err := f.osUserFinder.FindByName(nameOrId)
+	if err != nil {
+		var idErr error
+		if user, idErr = f.osUserFinder.FindById(nameOrId); idErr != nil {
+			slog.Debug("OS user not found", "name-or-id", nameOrId, "error", errors.Join(err, idErr))
+			return nil, fmt.Errorf("%w: '%s'", ErrUserNotRegistered, nameOrId)
+		}
+	}
+
+	if err := f.userValidator.ValidateUser(user); err != nil {
+		return nil, fmt.Errorf("failed to validate user '%s': %w", user.Name(), err)
+	}
+
+	entry := UserEntry{
+		OSUserName:     user.Name(),
+		OSUserId:       user.Id(),
+		K2sUserName:    f.k2sUserNameProvider.DetermineK2sUserName(user),
+		KubeconfigPath: f.kubeconfigResolver.ResolveKubeconfigPath(user),
+		PrivateKeyPath: controlplane.ResolvePrivateKeyPath(f.sshConfig, user),
+	}
+
+	hasKey := false
+	if fingerprint, err := readKeyFingerprint(entry.PrivateKeyPath + ".pub"); err == nil {
+		entry.SSHKeyFingerprint = fingerprint
+		hasKey = true
+	} else if _, err := os.Stat(entry.PrivateKeyPath); err == nil {
+		hasKey = true
+	}
+
+	hasCert := false
+	if cert, err := readUserCert(f.kubeconfigReader, f.credentialsDecoder, entry.K2sUserName+"@"+f.clusterName, entry.KubeconfigPath); err == nil {
+		entry.CertSerial = FormatSerial(cert)
+		entry.CertExpiry = cert.NotAfter
+		hasCert = true
+	} else {
+		slog.Debug("No K2s credentials found in user kubeconfig", "path", entry.KubeconfigPath, "error", err)
+	}
+
+	if !hasKey && !hasCert {
+		return nil, fmt.Errorf("%w: '%s' (no K2s credentials found)", ErrUserNotRegistered, nameOrId)
+	}
+
+	slog.Debug("Credentials of unregistered user found", "k2s-user-name", entry.K2sUserName, "ssh-key", hasKey, "cert-serial", entry.CertSerial)
+	return &entry, nil
+}
@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package registry_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/contracts/kubeconfig"
+	"github.com/siemens-healthineers/k2s/internal/contracts/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
+)
+
+type fakeOSUsers struct {
+	byName map[string]*users.OSUser
+	byId   map[string]*users.OSUser
+}
+
+type fakeValidator struct {
+	err error
+}
+
+type fakeNameProvider struct{}
+
+type fakeKubeconfig struct {
+	contexts map[string][]byte
+}
+
+type fakeDecoder struct{}
+
+var _ = Describe("LegacyUserFinder", func() {
+	var homeDir string
+	var john *users.OSUser
+	var osUsers *fakeOSUsers
+	var validator *fakeValidator
+	var kubeconfigs *fakeKubeconfig
+	var sut *registry.LegacyUserFinder
+
+	expiry := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		homeDir = GinkgoT().TempDir()
+		john = users.NewOSUser("1001", "john", homeDir)
+		osUsers = &fakeOSUsers{
+			byName: map[string]*users.OSUser{"john": john},
+			byId:   map[string]*users.OSUser{"1001": john},
+		}
+		validator = &fakeValidator{}
+		kubeconfigs = &fakeKubeconfig{contexts: map[string][]byte{}}
+		sshConfig := config.NewSshConfig("", "~/.ssh", "")
+
+		sut = registry.NewLegacyUserFinder(osUsers, validator, fakeNameProvider{}, sshConfig, "k2s-cluster", kubeconfigs, kubeconfigs, fakeDecoder{})
+	})
+
+	It("determines the user's certificate from the user's kubeconfig", func() {
+		kubeconfigs.contexts["k2s-john@k2s-cluster"] = newCertPem(0x2A, expiry)
+
+		entry, err := sut.FindLegacyUser("john")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entry.OSUserName).To(Equal("john"))
+		Expect(entry.OSUserId).To(Equal("1001"))
+		Expect(entry.K2sUserName).To(Equal("k2s-john"))
+		Expect(entry.KubeconfigPath).To(Equal(filepath.Join(homeDir, ".kube", "config")))
+		Expect(entry.PrivateKeyPath).To(Equal(filepath.Join(homeDir, ".ssh", "k2s", "id_rsa")))
+		Expect(entry.CertSerial).To(Equal("2A"))
+		Expect(entry.CertExpiry).To(Equal(expiry))
+	})
+
+	It("finds users by OS user id and SSH key only", func() {
+		keyPath := filepath.Join(homeDir, ".ssh", "k2s", "id_rsa")
+		Expect(os.MkdirAll(filepath.Dir(keyPath), 0700)).To(Succeed())
+		Expect(os.WriteFile(keyPath, []byte("key"), 0600)).To(Succeed())
+
+		entry, err := sut.FindLegacyUser("1001")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entry.K2sUserName).To(Equal("k2s-john"))
+		Expect(entry.CertSerial).To(BeEmpty())
+	})
+
+	It("returns ErrUserNotRegistered for unknown OS users", func() {
+		_, err := sut.FindLegacyUser("jane")
+
+		Expect(err).To(MatchError(registry.ErrUserNotRegistered))
+	})
+
+	It("returns ErrUserNotRegistered for users without K2s credentials", func() {
+		_, err := sut.FindLegacyUser("john")
+
+		Expect(err).To(MatchError(registry.ErrUserNotRegistered))
+		Expect(err).To(MatchError(ContainSubstring("no K2s credentials found")))
+	})
+
+	It("rejects users failing validation", func() {
+		kubeconfigs.contexts["k2s-john@k2s-cluster"] = newCertPem(0x2A, expiry)
+		validator.err = errors.New("cannot overwrite access of current user")
+
+		_, err := sut.FindLegacyUser("john")
+
+		Expect(err).To(MatchError(ContainSubstring("cannot overwrite access of current user")))
+	})
+})
+
+func newCertPem(serial int64, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "k2s-john"},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func (f *fakeOSUsers) FindByName(name string) (*users.OSUser, error) {
+	if user, found := f.byName[name]; found {
+		return user, nil
+	}
+	return nil, errors.New("user not found")
+}
+
+func (f *fakeOSUsers) FindById(id string) (*users.OSUser, error) {
+	if user, found := f.byId[id]; found {
+		return user, nil
+	}
+	return nil, errors.New("user not found")
+}
+
+func (f *fakeValidator) ValidateUser(*users.OSUser) error {
+	return f.err
+}
+
+func (fakeNameProvider) DetermineK2sUserName(user *users.OSUser) string {
+	return "k2s-" + user.Name()
+}
+
+func (*fakeKubeconfig) ResolveKubeconfigPath(user *users.OSUser) string {
+	return filepath.Join(user.HomeDir(), ".kube", "config")
+}
+
+func (f *fakeKubeconfig) ReadK8sApiCredentials(context, _ string) (*kubeconfig.ClusterConfig, *kubeconfig.UserConfig, error) {
+	certPem, found := f.contexts[context]
+	if !found {
+		return nil, nil, errors.New("context not found")
+	}
+	return &kubeconfig.ClusterConfig{}, &kubeconfig.UserConfig{Cert: string(certPem)}, nil
+}
+
+func (fakeDecoder) DecodeK8sApiCredentials(_ *kubeconfig.ClusterConfig, userConfig *kubeconfig.UserConfig) ([]byte, []byte, []byte, error) {
+	return nil, []byte(userConfig.Cert), nil, nil
+}
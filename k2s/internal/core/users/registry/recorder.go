@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package registry
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/contracts/kubeconfig"
+	"github.com/siemens-healthineers/k2s/internal/contracts/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users/controlplane"
+	"golang.org/x/crypto/ssh"
+)
+
+type kubeconfigResolver interface {
+	ResolveKubeconfigPath(user *users.OSUser) string
+}
+
+type kubeconfigReader interface {
+	ReadK8sApiCredentials(context, kubeconfigPath string) (clusterConfig *kubeconfig.ClusterConfig, userConfig *kubeconfig.UserConfig, err error)
+}
+
+type credentialsDecoder interface {
+	DecodeK8sApiCredentials(clusterConfig *kubeconfig.ClusterConfig, userConfig *kubeconfig.UserConfig) (caCert, userCert, userKey []byte, err error)
+}
+
+type registrar interface {
+	Register(entry UserEntry) error
+}
+
+// Recorder registers an admitted user with the credentials issued by the admission.
+type Recorder struct {
+	registrar          registrar
+	sshConfig          *config.SSHConfig
+	clusterName        string
+	kubeconfigResolver kubeconfigResolver
+	kubeconfigReader   kubeconfigReader
+	credentialsDecoder credentialsDecoder
+	now                func() time.Time
+}
+
+func NewRecorder(registrar registrar, sshConfig *config.SSHConfig, clusterName string, kubeconfigResolver kubeconfigResolver, kubeconfigReader kubeconfigReader, credentialsDecoder credentialsDecoder) *Recorder {
+	return &Recorder{
+		registrar:          registrar,
+		sshConfig:          sshConfig,
+		clusterName:        clusterName,
+		kubeconfigResolver: kubeconfigResolver,
+		kubeconfigReader:   kubeconfigReader,
+		credentialsDecoder: credentialsDecoder,
+		now:                time.Now,
+	}
+}
+
+func (r *Recorder) Record(user *users.OSUser, k2sUserName string, access users.Access) error {
+	slog.Debug("Recording user", "name", user.Name(), "id", user.Id(), "k2s-user-name", k2sUserName, "role", access.Role)
+
+	entry := UserEntry{
+		OSUserName:     user.Name(),
+		OSUserId:       user.Id(),
+		K2sUserName:    k2sUserName,
+		Role:           access.Role,
+		Namespace:      access.Namespace,
+		KubeconfigPath: r.kubeconfigResolver.ResolveKubeconfigPath(user),
+		PrivateKeyPath: controlplane.ResolvePrivateKeyPath(r.sshConfig, user),
+		AddedAt:        r.now(),
+	}
+
+	fingerprint, err := readKeyFingerprint(entry.PrivateKeyPath + ".pub")
+	if err != nil {
+		return fmt.Errorf("failed to determine SSH key fingerprint: %w", err)
+	}
+	entry.SSHKeyFingerprint = fingerprint
+
+	cert, err := readUserCert(r.kubeconfigReader, r.credentialsDecoder, k2sUserName+"@"+r.clusterName, entry.KubeconfigPath)
+	if err != nil {
+		return err
+	}
+	entry.CertSerial = FormatSerial(cert)
+	entry.CertExpiry = cert.NotAfter
+
+	return r.registrar.Register(entry)
+}
+
+func readUserCert(kubeconfigReader kubeconfigReader, credentialsDecoder credentialsDecoder, context, kubeconfigPath string) (*x509.Certificate, error) {
+	clusterConfig, userConfig, err := kubeconfigReader.ReadK8sApiCredentials(context, kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read user credentials: %w", err)
+	}
+
+	_, userCert, _, err := credentialsDecoder.DecodeK8sApiCredentials(clusterConfig, userConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode user credentials: %w", err)
+	}
+	return ParseCert(userCert)
+}
+
+// ParseCert parses a PEM-encoded X.509 certificate.
+func ParseCert(pemData []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, errors.New("failed to decode user certificate: no PEM data found")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse user certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// FormatSerial returns the certificate serial number in hex notation like openssl.
+func FormatSerial(cert *x509.Certificate) string {
+	return fmt.Sprintf("%X", cert.SerialNumber)
+}
+
+func readKeyFingerprint(publicKeyPath string) (string, error) {
+	content, err := os.ReadFile(publicKeyPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read public SSH key '%s': %w", publicKeyPath, err)
+	}
+
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse public SSH key '%s': %w", publicKeyPath, err)
+	}
+	return ssh.FingerprintSHA256(publicKey), nil
+}
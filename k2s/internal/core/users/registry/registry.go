@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/contracts/users"
+	"github.com/siemens-healthineers/k2s/internal/json"
+)
+
+// UserEntry is a user granted access to K2s.
+type UserEntry struct {
+	OSUserName        string     `json:"osUserName"`
+	OSUserId          string     `json:"osUserId"`
+	K2sUserName       string     `json:"k2sUserName"`
+	Role              users.Role `json:"role,omitempty"`
+	Namespace         string     `json:"namespace,omitempty"`
+	KubeconfigPath    string     `json:"kubeconfigPath"`
+	PrivateKeyPath    string     `json:"privateKeyPath"`
+	SSHKeyFingerprint string     `json:"sshKeyFingerprint"`
+	CertSerial        string     `json:"certSerial"`
+	CertExpiry        time.Time  `json:"certExpiry"`
+	AddedAt           time.Time  `json:"addedAt"`
+	RenewedAt         time.Time  `json:"renewedAt,omitzero"`
+}
+
+// RevokedCert is a client certificate of a removed user. Kubernetes does not support revoking client
+// certificates, hence serials are tracked to detect re-use until the certificate expires.
+type RevokedCert struct {
+	K2sUserName string    `json:"k2sUserName"`
+	Serial      string    `json:"serial"`
+	Expiry      time.Time `json:"expiry"`
+	RevokedAt   time.Time `json:"revokedAt"`
+}
+
+type Users struct {
+	Users   []UserEntry   `json:"users"`
+	Revoked []RevokedCert `json:"revoked"`
+}
+
+type Registry struct {
+	path string
+}
+
+const (
+	fileName = "users.json"
+
+	// DefaultRenewalPeriod is the period before expiry in which user certificates should be renewed
+	DefaultRenewalPeriod = 30 * 24 * time.Hour
+)
+
+var ErrUserNotRegistered = errors.New("user not registered")
+
+func NewRegistry(configDir string) *Registry {
+	return &Registry{path: filepath.Join(configDir, fileName)}
+}
+
+// Load returns the registered users; an empty list if no user has been added yet.
+func (r *Registry) Load() (*Users, error) {
+	users, err := json.FromFile[Users](r.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			slog.Debug("Users registry not found, assuming no users added", "path", r.path)
+			return &Users{}, nil
+		}
+		return nil, fmt.Errorf("failed to read users registry: %w", err)
+	}
+	return users, nil
+}
+
+// Register adds the user or replaces the existing entry of the same K2s user name. A replaced certificate
+// is recorded as revoked at the time the user was added again or renewed.
+func (r *Registry) Register(entry UserEntry) error {
+	users, err := r.Load()
+	if err != nil {
+		return err
+	}
+
+	index := slices.IndexFunc(users.Users, func(u UserEntry) bool { return u.K2sUserName == entry.K2sUserName })
+	if index >= 0 {
+		if previous := users.Users[index]; previous.CertSerial != "" && previous.CertSerial != entry.CertSerial {
+			revokedAt := entry.AddedAt
+			if entry.RenewedAt.After(revokedAt) {
+				revokedAt = entry.RenewedAt
+			}
+			users.Revoked = append(users.Revoked, RevokedCert{
+				K2sUserName: previous.K2sUserName,
+				Serial:      previous.CertSerial,
+				Expiry:      previous.CertExpiry,
+				RevokedAt:   revokedAt,
+			})
+		}
+		users.Users[index] = entry
+	} else {
+		users.Users = append(users.Users, entry)
+	}
+	return r.save(users)
+}
+
+// Revoke removes the user, if registered, and records the user's certificate serial as revoked. Users added before
+// the registry existed are revoked by their entries determined from disk.
+func (r *Registry) Revoke(entry UserEntry, revokedAt time.Time) error {
+	users, err := r.Load()
+	if err != nil {
+		return err
+	}
+
+	users.Users = slices.DeleteFunc(users.Users, func(u UserEntry) bool { return u.K2sUserName == entry.K2sUserName })
+	if entry.CertSerial != "" && !users.IsRevoked(entry.CertSerial) {
+		users.Revoked = append(users.Revoked, RevokedCert{
+			K2sUserName: entry.K2sUserName,
+			Serial:      entry.CertSerial,
+			Expiry:      entry.CertExpiry,
+			RevokedAt:   revokedAt,
+		})
+	}
+	return r.save(users)
+}
+
+// Find returns the user with the given K2s or OS user name (case-insensitive) or OS user id.
+func (u *Users) Find(nameOrId string) (*UserEntry, error) {
+	for i, entry := range u.Users {
+		if strings.EqualFold(entry.K2sUserName, nameOrId) || strings.EqualFold(entry.OSUserName, nameOrId) || entry.OSUserId == nameOrId {
+			return &u.Users[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: '%s'", ErrUserNotRegistered, nameOrId)
+}
+
+// ExpiringWithin returns the users whose certificates expire within the given period or have expired already.
+func (u *Users) ExpiringWithin(period time.Duration, now time.Time) []UserEntry {
+	var expiring []UserEntry
+	for _, entry := range u.Users {
+		if entry.ExpiresWithin(period, now) {
+			expiring = append(expiring, entry)
+		}
+	}
+	return expiring
+}
+
+func (u *Users) IsRevoked(serial string) bool {
+	return slices.ContainsFunc(u.Revoked, func(c RevokedCert) bool { return c.Serial == serial })
+}
+
+// ExpiresWithin returns true if the user's certificate expires within the given period; false if the expiry is unknown.
+func (e *UserEntry) ExpiresWithin(period time.Duration, now time.Time) bool {
+	return !e.CertExpiry.IsZero() && e.CertExpiry.Before(now.Add(period))
+}
+
+// Access returns the role granted to the user by K2s.
+func (e *UserEntry) Access() users.Access {
+	return users.Access{Role: e.Role, Namespace: e.Namespace}
+}
+
+func (r *Registry) save(users *Users) error {
+	if err := json.ToFile(r.path, users); err != nil {
+		return fmt.Errorf("failed to write users registry: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package registry_test
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
+)
+
+func TestPkg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "registry pkg Unit Tests", Label("unit", "ci", "registry"))
+}
+
+var _ = BeforeSuite(func() {
+	slog.SetDefault(slog.New(logr.ToSlogHandler(GinkgoLogr)))
+})
+
+var _ = Describe("Registry", func() {
+	var sut *registry.Registry
+
+	addedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	revokedAt := addedAt.AddDate(0, 1, 0)
+	expiry := addedAt.AddDate(1, 0, 0)
+
+	BeforeEach(func() {
+		sut = registry.NewRegistry(GinkgoT().TempDir())
+	})
+
+	When("no user has been added", func() {
+		It("returns an empty list", func() {
+			users, err := sut.Load()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users.Users).To(BeEmpty())
+			Expect(users.Revoked).To(BeEmpty())
+		})
+	})
+
+	Describe("Register", func() {
+		It("adds users", func() {
+			Expect(sut.Register(registry.UserEntry{OSUserName: "john", K2sUserName: "k2s-john", CertSerial: "01"})).To(Succeed())
+			Expect(sut.Register(registry.UserEntry{OSUserName: "jane", K2sUserName: "k2s-jane", CertSerial: "02"})).To(Succeed())
+
+			users, err := sut.Load()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users.Users).To(HaveLen(2))
+		})
+
+		It("replaces an existing user and revokes the replaced certificate", func() {
+			Expect(sut.Register(registry.UserEntry{K2sUserName: "k2s-john", CertSerial: "01", CertExpiry: expiry})).To(Succeed())
+			Expect(sut.Register(registry.UserEntry{K2sUserName: "k2s-john", CertSerial: "02", AddedAt: revokedAt})).To(Succeed())
+
+			users, err := sut.Load()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users.Users).To(HaveLen(1))
+			Expect(users.Users[0].CertSerial).To(Equal("02"))
+			Expect(users.Revoked).To(ConsistOf(registry.RevokedCert{K2sUserName: "k2s-john", Serial: "01", Expiry: expiry, RevokedAt: revokedAt}))
+		})
+	})
+
+	Describe("Revoke", func() {
+		It("removes the user and records the certificate serial", func() {
+			entry := registry.UserEntry{K2sUserName: "k2s-john", CertSerial: "0A", CertExpiry: expiry}
+			Expect(sut.Register(entry)).To(Succeed())
+
+			Expect(sut.Revoke(entry, revokedAt)).To(Succeed())
+
+			users, err := sut.Load()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users.Users).To(BeEmpty())
+			Expect(users.IsRevoked("0A")).To(BeTrue())
+			Expect(users.Revoked[0].RevokedAt).To(Equal(revokedAt))
+		})
+
+		It("records the certificate serial of unregistered users", func() {
+			Expect(sut.Register(registry.UserEntry{K2sUserName: "k2s-john", CertSerial: "0A"})).To(Succeed())
+
+			Expect(sut.Revoke(registry.UserEntry{K2sUserName: "k2s-legacy", CertSerial: "0B", CertExpiry: expiry}, revokedAt)).To(Succeed())
+
+			users, err := sut.Load()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users.Users).To(HaveExactElements(HaveField("K2sUserName", "k2s-john")))
+			Expect(users.Revoked).To(ConsistOf(registry.RevokedCert{K2sUserName: "k2s-legacy", Serial: "0B", Expiry: expiry, RevokedAt: revokedAt}))
+		})
+
+		It("does not record a certificate serial twice", func() {
+			entry := registry.UserEntry{K2sUserName: "k2s-john", CertSerial: "0A"}
+
+			Expect(sut.Revoke(entry, revokedAt)).To(Succeed())
+			Expect(sut.Revoke(entry, revokedAt)).To(Succeed())
+
+			users, err := sut.Load()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users.Revoked).To(HaveLen(1))
+		})
+	})
+
+	Describe("Register with renewed certificate", func() {
+		It("records the replaced certificate as revoked at renewal time", func() {
+			Expect(sut.Register(registry.UserEntry{K2sUserName: "k2s-john", CertSerial: "01", AddedAt: addedAt})).To(Succeed())
+			Expect(sut.Register(registry.UserEntry{K2sUserName: "k2s-john", CertSerial: "02", AddedAt: addedAt, RenewedAt: revokedAt})).To(Succeed())
+
+			users, err := sut.Load()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users.Revoked).To(HaveExactElements(HaveField("RevokedAt", revokedAt)))
+		})
+	})
+
+	Describe("ExpiringWithin", func() {
+		It("returns users with certificates expiring within the period or expired", func() {
+			users := &registry.Users{Users: []registry.UserEntry{
+				{K2sUserName: "expired", CertExpiry: addedAt.Add(-time.Hour)},
+				{K2sUserName: "expiring", CertExpiry: addedAt.AddDate(0, 0, 10)},
+				{K2sUserName: "valid", CertExpiry: addedAt.AddDate(0, 2, 0)},
+				{K2sUserName: "unknown"},
+			}}
+
+			expiring := users.ExpiringWithin(registry.DefaultRenewalPeriod, addedAt)
+
+			Expect(expiring).To(HaveExactElements(HaveField("K2sUserName", "expired"), HaveField("K2sUserName", "expiring")))
+		})
+	})
+
+	Describe("Find", func() {
+		users := &registry.Users{Users: []registry.UserEntry{{OSUserName: `domain\john`, OSUserId: "S-1-5-21", K2sUserName: "k2s-domain-john"}}}
+
+		DescribeTable("finds users by K2s user name, OS user name or OS user id",
+			func(nameOrId string) {
+				user, err := users.Find(nameOrId)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(user.K2sUserName).To(Equal("k2s-domain-john"))
+			},
+			Entry("K2s user name", "k2s-domain-john"),
+			Entry("OS user name, case-insensitive", `DOMAIN\John`),
+			Entry("OS user id", "S-1-5-21"),
+		)
+
+		It("returns an error for unknown users", func() {
+			_, err := users.Find("jane")
+
+			Expect(err).To(MatchError(registry.ErrUserNotRegistered))
+		})
+	})
+})
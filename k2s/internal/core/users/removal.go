@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package users
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
+)
+
+type userRegistry interface {
+	Load() (*registry.Users, error)
+	Revoke(entry registry.UserEntry, revokedAt time.Time) error
+}
+
+type legacyUserFinder interface {
+	FindLegacyUser(nameOrId string) (*registry.UserEntry, error)
+}
+
+type controlPlaneRevocation interface {
+	RevokeAccess(publicKeyComment, privateKeyPath string) error
+}
+
+type clusterRevocation interface {
+	RevokeAccess(k8sUserName, kubeconfigPath string) error
+}
+
+type UserRemoval struct {
+	userRegistry           userRegistry
+	legacyUserFinder       legacyUserFinder
+	controlPlaneRevocation controlPlaneRevocation
+	clusterRevocation      clusterRevocation
+	now                    func() time.Time
+}
+
+func NewUserRemoval(userRegistry userRegistry, legacyUserFinder legacyUserFinder, controlPlaneRevocation controlPlaneRevocation, clusterRevocation clusterRevocation) *UserRemoval {
+	return &UserRemoval{
+		userRegistry:           userRegistry,
+		legacyUserFinder:       legacyUserFinder,
+		controlPlaneRevocation: controlPlaneRevocation,
+		clusterRevocation:      clusterRevocation,
+		now:                    time.Now,
+	}
+}
+
+// Remove revokes the control-plane and cluster access of the user with the given K2s or OS user name or OS user id.
+// Registered users do not need to exist on the OS anymore, users added before K2s kept a registry are determined by
+// their credentials on disk.
+func (u *UserRemoval) Remove(nameOrId string) (*registry.UserEntry, error) {
+	slog.Debug("Removing user from K2s", "name-or-id", nameOrId)
+
+	registeredUsers, err := u.userRegistry.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := registeredUsers.Find(nameOrId)
+	if errors.Is(err, registry.ErrUserNotRegistered) {
+		slog.Debug("User not registered, looking for credentials on disk", "name-or-id", nameOrId)
+		user, err = u.legacyUserFinder.FindLegacyUser(nameOrId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	allErrors := []error{nil, nil}
+	tasks := sync.WaitGroup{}
+	tasks.Add(len(allErrors))
+
+	go func() {
+		defer tasks.Done()
+		if err := u.controlPlaneRevocation.RevokeAccess(user.K2sUserName, user.PrivateKeyPath); err != nil {
+			allErrors[0] = fmt.Errorf("failed to revoke user control-plane access: %w", err)
+		}
+	}()
+
+	go func() {
+		defer tasks.Done()
+		if err := u.clusterRevocation.RevokeAccess(user.K2sUserName, user.KubeconfigPath); err != nil {
+			allErrors[1] = fmt.Errorf("failed to revoke user Kubernetes access: %w", err)
+		}
+	}()
+
+	tasks.Wait()
+
+	if err := errors.Join(allErrors...); err != nil {
+		return nil, err
+	}
+
+	if err := u.userRegistry.Revoke(*user, u.now()); err != nil {
+		return nil, fmt.Errorf("failed to unregister user '%s': %w", user.K2sUserName, err)
+	}
+	return user, nil
+}
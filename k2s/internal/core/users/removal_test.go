@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package users_test
+
+import (
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/siemens-healthineers/k2s/internal/core/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
+)
+
+type fakeRegistry struct {
+	users      registry.Users
+	revoked    []registry.UserEntry
+	registered []registry.UserEntry
+}
+
+type fakeLegacyUserFinder struct {
+	entry    *registry.UserEntry
+	err      error
+	searched []string
+}
+
+type fakeRevocation struct {
+	mu      sync.Mutex
+	revoked [][2]string
+	err     error
+}
+
+func TestPkg(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "users pkg Unit Tests", Label("unit", "ci", "users"))
+}
+
+var _ = BeforeSuite(func() {
+	slog.SetDefault(slog.New(logr.ToSlogHandler(GinkgoLogr)))
+})
+
+var _ = Describe("UserRemoval", func() {
+	var userRegistry *fakeRegistry
+	var legacyUsers *fakeLegacyUserFinder
+	var controlPlane *fakeRevocation
+	var cluster *fakeRevocation
+	var sut *users.UserRemoval
+
+	john := registry.UserEntry{
+		OSUserName:     "john",
+		OSUserId:       "1001",
+		K2sUserName:    "k2s-john",
+		KubeconfigPath: "/home/john/.kube/config",
+		PrivateKeyPath: "/home/john/.ssh/k2s/id_rsa",
+		CertSerial:     "0A",
+	}
+
+	BeforeEach(func() {
+		userRegistry = &fakeRegistry{}
+		legacyUsers = &fakeLegacyUserFinder{err: registry.ErrUserNotRegistered}
+		controlPlane = &fakeRevocation{}
+		cluster = &fakeRevocation{}
+
+		sut = users.NewUserRemoval(userRegistry, legacyUsers, controlPlane, cluster)
+	})
+
+	It("revokes the access of registered users", func() {
+		userRegistry.users.Users = []registry.UserEntry{john}
+
+		removed, err := sut.Remove("john")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*removed).To(Equal(john))
+		Expect(controlPlane.revoked).To(Equal([][2]string{{"k2s-john", "/home/john/.ssh/k2s/id_rsa"}}))
+		Expect(cluster.revoked).To(Equal([][2]string{{"k2s-john", "/home/john/.kube/config"}}))
+		Expect(userRegistry.revoked).To(ConsistOf(john))
+		Expect(legacyUsers.searched).To(BeEmpty())
+	})
+
+	It("revokes the access of users added before the registry existed", func() {
+		legacy := john
+		legacy.CertSerial = "0B"
+		legacyUsers.entry, legacyUsers.err = &legacy, nil
+
+		removed, err := sut.Remove("1001")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(removed.CertSerial).To(Equal("0B"))
+		Expect(legacyUsers.searched).To(ConsistOf("1001"))
+		Expect(controlPlane.revoked).To(Equal([][2]string{{"k2s-john", "/home/john/.ssh/k2s/id_rsa"}}))
+		Expect(cluster.revoked).To(Equal([][2]string{{"k2s-john", "/home/john/.kube/config"}}))
+		Expect(userRegistry.revoked).To(ConsistOf(legacy))
+	})
+
+	It("returns ErrUserNotRegistered if the user has no credentials", func() {
+		_, err := sut.Remove("jane")
+
+		Expect(err).To(MatchError(registry.ErrUserNotRegistered))
+		Expect(controlPlane.revoked).To(BeEmpty())
+		Expect(cluster.revoked).To(BeEmpty())
+		Expect(userRegistry.revoked).To(BeEmpty())
+	})
+
+	It("keeps the user registered if a revocation fails", func() {
+		userRegistry.users.Users = []registry.UserEntry{john}
+		cluster.err = errors.New("API not reachable")
+
+		_, err := sut.Remove("k2s-john")
+
+		Expect(err).To(MatchError(ContainSubstring("failed to revoke user Kubernetes access")))
+		Expect(controlPlane.revoked).To(HaveLen(1))
+		Expect(userRegistry.revoked).To(BeEmpty())
+	})
+})
+
+func (r *fakeRegistry) Load() (*registry.Users, error) {
+	return &r.users, nil
+}
+
+func (r *fakeRegistry) Revoke(entry registry.UserEntry, _ time.Time) error {
+	r.revoked = append(r.revoked, entry)
+	return nil
+}
+
+func (f *fakeLegacyUserFinder) FindLegacyUser(nameOrId string) (*registry.UserEntry, error) {
+	f.searched = append(f.searched, nameOrId)
+	return f.entry, f.err
+}
+
+func (r *fakeRevocation) RevokeAccess(name, path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.revoked = append(r.revoked, [2]string{name, path})
+	return r.err
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package users
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/contracts/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
+)
+
+type renewalRegistry interface {
+	Load() (*registry.Users, error)
+	Register(entry registry.UserEntry) error
+}
+
+type credentialsRenewer interface {
+	RenewCredentials(k8sUserName string, access users.Access, certValidity time.Duration, kubeconfigPath string) ([]byte, error)
+}
+
+// RenewOptions select the users whose certificates are renewed.
+type RenewOptions struct {
+	// NameOrId selects a single user by K2s or OS user name or OS user id; all users if empty
+	NameOrId string
+	// Within limits the renewal to certificates expiring within the period; no limit if 0
+	Within time.Duration
+	// CertValidity is the validity of the renewed certificates; 0 means the default validity
+	CertValidity time.Duration
+}
+
+type UserRenewal struct {
+	userRegistry       renewalRegistry
+	credentialsRenewer credentialsRenewer
+	now                func() time.Time
+}
+
+func NewUserRenewal(userRegistry renewalRegistry, credentialsRenewer credentialsRenewer) *UserRenewal {
+	return &UserRenewal{
+		userRegistry:       userRegistry,
+		credentialsRenewer: credentialsRenewer,
+		now:                time.Now,
+	}
+}
+
+// Renew re-issues the client certificates of the selected users with their roles and updates the users'
+// kubeconfig entries. It returns the renewed users; users failing to renew do not stop the renewal of others.
+func (u *UserRenewal) Renew(options RenewOptions) ([]registry.UserEntry, error) {
+	slog.Debug("Renewing user certificates", "name-or-id", options.NameOrId, "within", options.Within)
+
+	registeredUsers, err := u.userRegistry.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := registeredUsers.Users
+	if options.NameOrId != "" {
+		user, err := registeredUsers.Find(options.NameOrId)
+		if err != nil {
+			return nil, err
+		}
+		candidates = []registry.UserEntry{*user}
+	}
+
+	now := u.now()
+
+	var renewed []registry.UserEntry
+	var errs []error
+	for _, candidate := range candidates {
+		if options.Within > 0 && !candidate.ExpiresWithin(options.Within, now) {
+			slog.Debug("User certificate not expiring within period, skipping", "k2s-user-name", candidate.K2sUserName, "expiry", candidate.CertExpiry)
+			continue
+		}
+
+		entry, err := u.renew(candidate, options.CertValidity, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to renew certificate of user '%s': %w", candidate.K2sUserName, err))
+			continue
+		}
+		renewed = append(renewed, *entry)
+	}
+	return renewed, errors.Join(errs...)
+}
+
+func (u *UserRenewal) renew(entry registry.UserEntry, validity time.Duration, now time.Time) (*registry.UserEntry, error) {
+	certPem, err := u.credentialsRenewer.RenewCredentials(entry.K2sUserName, entry.Access(), validity, entry.KubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := registry.ParseCert(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.CertSerial = registry.FormatSerial(cert)
+	entry.CertExpiry = cert.NotAfter
+	entry.RenewedAt = now
+
+	if err := u.userRegistry.Register(entry); err != nil {
+		return nil, fmt.Errorf("failed to register renewed certificate: %w", err)
+	}
+	return &entry, nil
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package users_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	contracts "github.com/siemens-healthineers/k2s/internal/contracts/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
+)
+
+type fakeRenewer struct {
+	renewed    []string
+	accesses   []contracts.Access
+	validities []time.Duration
+	notAfter   time.Time
+	err        error
+}
+
+var _ = Describe("UserRenewal", func() {
+	var userRegistry *fakeRegistry
+	var renewer *fakeRenewer
+	var sut *users.UserRenewal
+
+	now := time.Now()
+	john := registry.UserEntry{
+		OSUserName:     "john",
+		K2sUserName:    "k2s-john",
+		KubeconfigPath: "/home/john/.kube/config",
+		Role:           contracts.RoleDeveloper,
+		Namespace:      "team",
+		CertSerial:     "0A",
+		CertExpiry:     now.Add(200 * 24 * time.Hour),
+	}
+	jane := registry.UserEntry{
+		OSUserName:     "jane",
+		K2sUserName:    "k2s-jane",
+		KubeconfigPath: "/home/jane/.kube/config",
+		CertSerial:     "0B",
+		CertExpiry:     now.Add(-time.Hour),
+	}
+
+	BeforeEach(func() {
+		userRegistry = &fakeRegistry{users: registry.Users{Users: []registry.UserEntry{john, jane}}}
+		renewer = &fakeRenewer{notAfter: now.Add(365 * 24 * time.Hour).Truncate(time.Second)}
+
+		sut = users.NewUserRenewal(userRegistry, renewer)
+	})
+
+	It("renews the certificate of a single user regardless of its expiry", func() {
+		renewed, err := sut.Renew(users.RenewOptions{NameOrId: "john", CertValidity: 90 * 24 * time.Hour})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(renewer.renewed).To(Equal([]string{"k2s-john"}))
+		Expect(renewer.accesses).To(Equal([]contracts.Access{{Role: contracts.RoleDeveloper, Namespace: "team"}}))
+		Expect(renewer.validities).To(Equal([]time.Duration{90 * 24 * time.Hour}))
+		Expect(renewed).To(HaveLen(1))
+		Expect(renewed[0].CertSerial).To(Equal("2A"))
+		Expect(renewed[0].CertExpiry).To(BeTemporally("==", renewer.notAfter))
+		Expect(renewed[0].RenewedAt).ToNot(BeZero())
+		Expect(userRegistry.registered).To(Equal(renewed))
+	})
+
+	It("renews all certificates without a period", func() {
+		renewed, err := sut.Renew(users.RenewOptions{})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(renewed).To(HaveLen(2))
+		Expect(renewer.renewed).To(Equal([]string{"k2s-john", "k2s-jane"}))
+	})
+
+	It("renews only certificates expiring within the period, including expired ones", func() {
+		renewed, err := sut.Renew(users.RenewOptions{Within: 30 * 24 * time.Hour})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(renewer.renewed).To(Equal([]string{"k2s-jane"}))
+		Expect(renewed).To(HaveLen(1))
+		Expect(renewed[0].K2sUserName).To(Equal("k2s-jane"))
+	})
+
+	It("skips a selected user whose certificate does not expire within the period", func() {
+		renewed, err := sut.Renew(users.RenewOptions{NameOrId: "john", Within: 30 * 24 * time.Hour})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(renewed).To(BeEmpty())
+		Expect(renewer.renewed).To(BeEmpty())
+	})
+
+	It("returns ErrUserNotRegistered if the user is missing in the registry", func() {
+		_, err := sut.Renew(users.RenewOptions{NameOrId: "bob"})
+
+		Expect(err).To(MatchError(registry.ErrUserNotRegistered))
+		Expect(renewer.renewed).To(BeEmpty())
+	})
+
+	It("keeps the registration of users failing to renew", func() {
+		renewer.err = errors.New("API not reachable")
+
+		renewed, err := sut.Renew(users.RenewOptions{})
+
+		Expect(err).To(MatchError(ContainSubstring("failed to renew certificate of user 'k2s-john'")))
+		Expect(err).To(MatchError(ContainSubstring("failed to renew certificate of user 'k2s-jane'")))
+		Expect(renewed).To(BeEmpty())
+		Expect(userRegistry.registered).To(BeEmpty())
+	})
+})
+
+func (r *fakeRegistry) Register(entry registry.UserEntry) error {
+	r.registered = append(r.registered, entry)
+	return nil
+}
+
+func (r *fakeRenewer) RenewCredentials(k8sUserName string, access contracts.Access, certValidity time.Duration, _ string) ([]byte, error) {
+	r.renewed = append(r.renewed, k8sUserName)
+	r.accesses = append(r.accesses, access)
+	r.validities = append(r.validities, certValidity)
+	if r.err != nil {
+		return nil, r.err
+	}
+	return newCertPem(big.NewInt(42), r.notAfter), nil
+}
+
+func newCertPem(serial *big.Int, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "k2s-user"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
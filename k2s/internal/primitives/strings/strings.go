@@ -6,6 +6,8 @@ package strings
 import (
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -42,3 +44,20 @@ func ToAgeString(duration time.Duration) string {
 
 	return duration.Round(time.Second).String()
 }
+
+// ParseAge parses Go durations extended by days, e.g. '7d' or '12h'.
+func ParseAge(age string) (time.Duration, error) {
+	if days, found := strings.CutSuffix(age, "d"); found {
+		value, err := strconv.ParseFloat(days, 64)
+		if err != nil || value < 0 {
+			return 0, fmt.Errorf("invalid age '%s'", age)
+		}
+		return time.Duration(value * float64(24*time.Hour)), nil
+	}
+
+	duration, err := time.ParseDuration(age)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("invalid age '%s', expected e.g. '7d' or '12h'", age)
+	}
+	return duration, nil
+}
@@ -82,4 +82,25 @@ var _ = Describe("strings", func() {
 		Entry("Hours", time.Hour*4+time.Minute*55+time.Second*23, "4h55m23s"),
 		Entry("Days", time.Hour*26+time.Minute*55+time.Second*23, "1d2h"),
 	)
+
+	DescribeTable("ParseAge", func(input string, expected time.Duration) {
+		actual, err := sut.ParseAge(input)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(expected))
+	},
+		Entry("Days", "7d", 7*24*time.Hour),
+		Entry("Fractional days", "1.5d", 36*time.Hour),
+		Entry("Hours", "12h", 12*time.Hour),
+	)
+
+	DescribeTable("ParseAge with invalid input", func(input string) {
+		_, err := sut.ParseAge(input)
+
+		Expect(err).To(MatchError(ContainSubstring("invalid age")))
+	},
+		Entry("Text", "a week"),
+		Entry("Negative days", "-1d"),
+		Entry("Negative duration", "-1h"),
+	)
 })
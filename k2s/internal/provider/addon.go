@@ -3,6 +3,8 @@
 
 package provider
 
+import "github.com/siemens-healthineers/k2s/internal/core/addons/health"
+
 // AddonProvider abstracts addon management operations.
 // On Windows: delegates to PowerShell scripts (Enable.ps1, Disable.ps1, etc.).
 // On Linux: reads addon.manifest.yaml and applies manifests via kubectl directly.
@@ -30,6 +32,10 @@ type AddonProvider interface {
 	// handler uses this to dispatch manifest-defined operations through the
 	// provider instead of calling PowerShell directly.
 	RunCommand(config AddonRunCommandConfig) error
+
+	// DryRun renders the manifests of an addon command and compares them with
+	// the live cluster using a server-side dry-run, without changing anything.
+	DryRun(config AddonDryRunConfig) (*AddonDryRunResult, error)
 }
 
 // AddonEnableConfig holds parameters for enabling an addon.
@@ -65,11 +71,18 @@ type AddonInfo struct {
 
 // AddonStatusConfig holds parameters for querying addon status.
 type AddonStatusConfig struct {
-	Name       string // Empty means all addons
-	Directory  string // Full path to addon directory (required by PS Get-Status.ps1)
+	Name       string                // Empty means all addons
+	Directory  string                // Full path to addon directory (required by PS Get-Status.ps1)
+	Manifests  []AddonManifestSource // Sources of the deployed objects; empty means no health evaluation
 	ShowOutput bool
 }
 
+// AddonManifestSource references a manifest file, kustomization or directory of an addon.
+type AddonManifestSource struct {
+	Path     string // Full path to the manifest source
+	Optional bool   // Objects are only evaluated if present in the cluster (e.g. flag-dependent variants)
+}
+
 // AddonStatusResult holds addon status information.
 type AddonStatusResult struct {
 	Addons []AddonStatusInfo
@@ -80,6 +93,7 @@ type AddonStatusInfo struct {
 	Name    string
 	Enabled bool
 	Props   []AddonStatusProp
+	Health  *health.Report // nil if no manifests were given or the addon is disabled
 }
 
 // AddonStatusProp holds a single status property for an addon.
@@ -116,3 +130,21 @@ type AddonRunCommandConfig struct {
 	Params         []string // Pre-formatted script parameters from CLI flag mapping
 	ShowOutput     bool
 }
+
+// AddonDryRunConfig holds parameters for a dry-run of an addon command.
+type AddonDryRunConfig struct {
+	AddonName      string   // Addon metadata name (e.g., "monitoring")
+	CommandName    string   // Command name from manifest (e.g., "enable", "disable", "update")
+	AddonDirectory string   // Full path to addon directory
+	ManifestPaths  []string // Manifest paths relative to the addon directory, selected by CLI flag values
+	ShowOutput     bool
+}
+
+// AddonDryRunResult holds the outcome of an addon command dry-run.
+type AddonDryRunResult struct {
+	// Diff is the unified diff (enable/update) or the list of resources that
+	// would be deleted (disable), as reported by kubectl.
+	Diff string
+	// HasChanges is true if the command would change the live cluster.
+	HasChanges bool
+}
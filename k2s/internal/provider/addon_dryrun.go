@@ -0,0 +1,387 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// addonKubectl renders addon manifests and compares them with the live
+// cluster via kubectl (dry-run and health evaluation). It is shared by the
+// platform providers, which only differ in the kubectl binary and its base arguments.
+type addonKubectl struct {
+	kubectl     string
+	kubectlArgs []string
+}
+
+var kustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+func (r addonKubectl) run(cfg AddonDryRunConfig) (*AddonDryRunResult, error) {
+	if len(cfg.ManifestPaths) == 0 {
+		return nil, NotSupportedError(fmt.Sprintf("addons %s --dry-run", cfg.CommandName),
+			fmt.Sprintf("addon '%s' neither declares dry-run manifests for command '%s' in its addon.manifest.yaml nor has a manifests directory", cfg.AddonName, cfg.CommandName))
+	}
+
+	slog.Info("[Addon] Rendering manifests for dry-run", "name", cfg.AddonName, "command", cfg.CommandName, "paths", cfg.ManifestPaths)
+
+	rendered, err := r.render(cfg.AddonDirectory, cfg.ManifestPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := splitDocuments(rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	// kubectl fails for resources in namespaces or of kinds that do not exist yet, e.g. on
+	// the first enable; such resources are reported as new instead of being diffed.
+	namespaces, err := r.names("namespaces")
+	if err != nil {
+		return nil, err
+	}
+	crds, err := r.names("customresourcedefinitions")
+	if err != nil {
+		return nil, err
+	}
+	existing, created := partitionNewDocuments(uniqueDocuments(documents), namespaces, crds)
+
+	if cfg.CommandName == "disable" {
+		// resources in missing namespaces or of missing kinds cannot be deleted
+		if len(existing) == 0 {
+			return &AddonDryRunResult{}, nil
+		}
+		return r.withManifestFile(existing, r.deleteDryRun)
+	}
+
+	result := &AddonDryRunResult{}
+	if len(existing) > 0 {
+		result, err = r.withManifestFile(existing, r.diff)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(created) > 0 {
+		result.Diff = strings.TrimLeft(strings.TrimRight(result.Diff, "\n")+"\n"+creationDiff(created), "\n")
+		result.HasChanges = true
+	}
+	return result, nil
+}
+
+// withManifestFile writes the documents to a temp file and runs the kubectl command on it.
+func (r addonKubectl) withManifestFile(documents []manifestDocument, run func(manifestPath string) (*AddonDryRunResult, error)) (*AddonDryRunResult, error) {
+	tmpFile, err := os.CreateTemp("", "k2s-addon-dry-run-*.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file for rendered manifests: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	var content strings.Builder
+	for _, document := range documents {
+		content.WriteString("---\n")
+		content.WriteString(document.content)
+	}
+
+	if _, err := tmpFile.WriteString(content.String()); err != nil {
+		tmpFile.Close()
+		return nil, fmt.Errorf("failed to write rendered manifests: %w", err)
+	}
+	tmpFile.Close()
+
+	return run(tmpFile.Name())
+}
+
+// names returns the names of all live resources of the given type.
+func (r addonKubectl) names(resource string) ([]string, error) {
+	output, err := r.output("get", resource, "-o", "name")
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for line := range strings.Lines(string(output)) {
+		if _, name, found := strings.Cut(strings.TrimSpace(line), "/"); found {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// render concatenates all manifest sources into a single multi-document YAML.
+func (r addonKubectl) render(addonDir string, paths []string) ([]byte, error) {
+	var rendered bytes.Buffer
+
+	for _, relPath := range paths {
+		path := filepath.Join(addonDir, filepath.FromSlash(relPath))
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("dry-run manifest source not found: %w", err)
+		}
+
+		var content []byte
+		switch {
+		case !info.IsDir():
+			content, err = os.ReadFile(path)
+		case IsKustomization(path):
+			content, err = r.output("kustomize", path)
+		default:
+			content, err = readYamlFiles(path)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to render manifests from '%s': %w", relPath, err)
+		}
+
+		rendered.WriteString("---\n")
+		rendered.Write(content)
+		rendered.WriteString("\n")
+	}
+
+	return rendered.Bytes(), nil
+}
+
+// diff runs a server-side dry-run diff; kubectl exits with code 1 if differences were found.
+func (r addonKubectl) diff(manifestPath string) (*AddonDryRunResult, error) {
+	output, err := r.output("diff", "--server-side", "--force-conflicts", "-f", manifestPath)
+	if err == nil {
+		return &AddonDryRunResult{Diff: string(output)}, nil
+	}
+
+	if exitErr, ok := errors.AsType[*exec.ExitError](err); ok && exitErr.ExitCode() == 1 {
+		return &AddonDryRunResult{Diff: string(output), HasChanges: true}, nil
+	}
+	return nil, err
+}
+
+// deleteDryRun lists the live resources a deletion of the rendered manifests would remove.
+func (r addonKubectl) deleteDryRun(manifestPath string) (*AddonDryRunResult, error) {
+	output, err := r.output("delete", "--dry-run=server", "--ignore-not-found", "-f", manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := strings.TrimSpace(string(output))
+	return &AddonDryRunResult{Diff: diff, HasChanges: diff != ""}, nil
+}
+
+func (r addonKubectl) output(args ...string) ([]byte, error) {
+	allArgs := append(append([]string{}, r.kubectlArgs...), args...)
+
+	slog.Debug("[Addon] Executing kubectl", "args", allArgs)
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(r.kubectl, allArgs...)
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return output, fmt.Errorf("kubectl %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return output, nil
+}
+
+// manifestDocument is a single document of the rendered manifests.
+type manifestDocument struct {
+	content    string
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name      string `yaml:"name"`
+		Namespace string `yaml:"namespace"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Group string `yaml:"group"`
+		Names struct {
+			Kind string `yaml:"kind"`
+		} `yaml:"names"`
+	} `yaml:"spec"`
+}
+
+// splitDocuments splits multi-document YAML into its non-empty documents.
+func splitDocuments(rendered []byte) ([]manifestDocument, error) {
+	var documents []manifestDocument
+	var current strings.Builder
+
+	flush := func() error {
+		content := current.String()
+		current.Reset()
+
+		var document manifestDocument
+		if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+			return fmt.Errorf("failed to parse rendered manifests: %w", err)
+		}
+		if document.Kind == "" {
+			return nil
+		}
+		document.content = content
+		documents = append(documents, document)
+		return nil
+	}
+
+	for line := range strings.Lines(string(rendered)) {
+		if strings.TrimSpace(line) == "---" {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		current.WriteString(line)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return documents, nil
+}
+
+// uniqueDocuments drops repeated resources, e.g. of overlapping manifest sources; the first occurrence wins.
+func uniqueDocuments(documents []manifestDocument) []manifestDocument {
+	var unique []manifestDocument
+	for _, document := range documents {
+		if !slices.ContainsFunc(unique, func(u manifestDocument) bool {
+			return u.APIVersion == document.APIVersion && u.Kind == document.Kind &&
+				u.Metadata.Namespace == document.Metadata.Namespace && u.Metadata.Name == document.Metadata.Name
+		}) {
+			unique = append(unique, document)
+		}
+	}
+	return unique
+}
+
+// partitionNewDocuments separates the documents that kubectl can compare with the live cluster from
+// those that are new because their namespace or CRD does not exist yet.
+func partitionNewDocuments(documents []manifestDocument, namespaces, crds []string) (existing, created []manifestDocument) {
+	var newKinds []string
+	for _, document := range documents {
+		if document.Kind == "CustomResourceDefinition" && !slices.Contains(crds, document.Metadata.Name) {
+			newKinds = append(newKinds, document.Spec.Group+"/"+document.Spec.Names.Kind)
+		}
+	}
+
+	for _, document := range documents {
+		group := ""
+		if prefix, _, found := strings.Cut(document.APIVersion, "/"); found {
+			group = prefix
+		}
+
+		isNew := false
+		switch {
+		case document.Kind == "Namespace":
+			isNew = !slices.Contains(namespaces, document.Metadata.Name)
+		case document.Kind == "CustomResourceDefinition":
+			isNew = !slices.Contains(crds, document.Metadata.Name)
+		case document.Metadata.Namespace != "" && !slices.Contains(namespaces, document.Metadata.Namespace):
+			isNew = true
+		case slices.Contains(newKinds, group+"/"+document.Kind):
+			isNew = true
+		}
+
+		if isNew {
+			created = append(created, document)
+		} else {
+			existing = append(existing, document)
+		}
+	}
+	return existing, created
+}
+
+// creationDiff renders new documents in the unified diff format of 'kubectl diff'.
+func creationDiff(documents []manifestDocument) string {
+	var diff strings.Builder
+	for _, document := range documents {
+		name := strings.Join(slices.DeleteFunc([]string{document.Kind, document.Metadata.Namespace, document.Metadata.Name}, func(s string) bool { return s == "" }), ".")
+
+		fmt.Fprintf(&diff, "diff -u -N /dev/null %s\n--- /dev/null\n+++ %s\n", name, name)
+		for line := range strings.Lines(strings.TrimRight(document.content, "\n")) {
+			diff.WriteString("+" + strings.TrimRight(line, "\n") + "\n")
+		}
+	}
+	return strings.TrimRight(diff.String(), "\n")
+}
+
+// DiscoverManifests returns the directory as single source if it is a kustomization, otherwise its YAML files
+// and kustomization sub-directories. Sub-directories named like the addon or the implementation hold the main
+// workloads, others are variants selected by flags, e.g. 'ingress-nginx', and therefore optional. Other
+// sub-directories, e.g. Helm charts or CRDs, are skipped.
+func DiscoverManifests(manifestsDir, addonName, implementation string) []AddonManifestSource {
+	if IsKustomization(manifestsDir) {
+		return []AddonManifestSource{{Path: manifestsDir}}
+	}
+
+	entries, err := os.ReadDir(manifestsDir)
+	if err != nil {
+		slog.Debug("[Addon] No manifests found", "path", manifestsDir, "error", err)
+		return nil
+	}
+
+	var sources []AddonManifestSource
+	for _, entry := range entries {
+		path := filepath.Join(manifestsDir, entry.Name())
+		switch {
+		case !entry.IsDir():
+			if ext := strings.ToLower(filepath.Ext(entry.Name())); ext == ".yaml" || ext == ".yml" {
+				sources = append(sources, AddonManifestSource{Path: path})
+			}
+		case IsKustomization(path):
+			sources = append(sources, AddonManifestSource{
+				Path:     path,
+				Optional: entry.Name() != addonName && entry.Name() != implementation,
+			})
+		}
+	}
+	return sources
+}
+
+// IsKustomization reports whether dir contains a kustomization file.
+func IsKustomization(dir string) bool {
+	for _, name := range kustomizationFileNames {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// readYamlFiles reads all YAML files below dir in lexical order, matching kubectl's
+// behavior for 'apply -f <dir> --recursive'.
+func readYamlFiles(dir string) ([]byte, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		ext := strings.ToLower(filepath.Ext(path))
+		if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var content bytes.Buffer
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		content.WriteString("---\n")
+		content.Write(data)
+		content.WriteString("\n")
+	}
+	return content.Bytes(), nil
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package provider
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const dryRunManifests = `---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: monitoring
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: prometheuses.monitoring.coreos.com
+spec:
+  group: monitoring.coreos.com
+  names:
+    kind: Prometheus
+---
+apiVersion: monitoring.coreos.com/v1
+kind: Prometheus
+metadata:
+  name: k8s
+  namespace: default
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: grafana
+  namespace: monitoring
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: grafana
+---
+`
+
+var _ = Describe("addon_dryrun", func() {
+	Describe("splitDocuments", func() {
+		It("skips empty documents and keeps the document content", func() {
+			documents, err := splitDocuments([]byte(dryRunManifests))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(documents).To(HaveLen(5))
+			Expect(documents[3].Kind).To(Equal("Deployment"))
+			Expect(documents[3].Metadata.Namespace).To(Equal("monitoring"))
+			Expect(documents[3].content).To(HavePrefix("apiVersion: apps/v1\n"))
+		})
+	})
+
+	Describe("uniqueDocuments", func() {
+		It("drops repeated resources of overlapping manifest sources", func() {
+			documents, err := splitDocuments([]byte(dryRunManifests + dryRunManifests))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(uniqueDocuments(documents)).To(Equal(documents[:5]))
+		})
+	})
+
+	Describe("partitionNewDocuments", func() {
+		kinds := func(documents []manifestDocument) []string {
+			var result []string
+			for _, document := range documents {
+				result = append(result, document.Kind)
+			}
+			return result
+		}
+
+		It("reports resources of missing namespaces and CRDs as new", func() {
+			documents, err := splitDocuments([]byte(dryRunManifests))
+			Expect(err).ToNot(HaveOccurred())
+
+			existing, created := partitionNewDocuments(documents, []string{"default", "kube-system"}, nil)
+
+			Expect(kinds(existing)).To(ConsistOf("ClusterRole"))
+			Expect(kinds(created)).To(ConsistOf("Namespace", "CustomResourceDefinition", "Prometheus", "Deployment"))
+		})
+
+		It("diffs all resources if namespaces and CRDs exist", func() {
+			documents, err := splitDocuments([]byte(dryRunManifests))
+			Expect(err).ToNot(HaveOccurred())
+
+			existing, created := partitionNewDocuments(documents, []string{"default", "monitoring"}, []string{"prometheuses.monitoring.coreos.com"})
+
+			Expect(existing).To(HaveLen(5))
+			Expect(created).To(BeEmpty())
+		})
+	})
+
+	Describe("creationDiff", func() {
+		It("renders new resources as additions", func() {
+			documents, err := splitDocuments([]byte("kind: Namespace\nmetadata:\n  name: monitoring\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(creationDiff(documents)).To(Equal("diff -u -N /dev/null Namespace.monitoring\n--- /dev/null\n+++ Namespace.monitoring\n" +
+				"+kind: Namespace\n+metadata:\n+  name: monitoring"))
+		})
+	})
+})
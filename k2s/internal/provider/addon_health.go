@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/core/addons/health"
+)
+
+const ingressProbeTimeout = 3 * time.Second
+
+// evaluateHealth renders the addon's manifest sources and evaluates the declared objects
+// against their live state in the cluster.
+func (r addonKubectl) evaluateHealth(name string, sources []AddonManifestSource) (*health.Report, error) {
+	var declared []health.ManifestObject
+	for _, source := range sources {
+		rendered, err := r.render("", []string{source.Path})
+		if err != nil {
+			return nil, err
+		}
+
+		objects, err := health.ParseManifestObjects(rendered, source.Optional)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse manifests from '%s': %w", source.Path, err)
+		}
+		declared = append(declared, objects...)
+	}
+	declared = health.Merge(declared)
+
+	slog.Debug("[Addon] Evaluating health", "name", name, "objects", len(declared))
+
+	var live []health.ClusterObject
+	for _, namespace := range health.Namespaces(declared) {
+		output, err := r.output("get", "deployments,daemonsets,statefulsets,ingresses,pods", "-n", namespace, "-o", "json")
+		if err != nil {
+			return nil, err
+		}
+
+		var list health.ClusterObjectList
+		if err := json.Unmarshal(output, &list); err != nil {
+			return nil, fmt.Errorf("failed to parse objects of namespace '%s': %w", namespace, err)
+		}
+		live = append(live, list.Items...)
+	}
+
+	return health.Evaluate(declared, live, probeIngress), nil
+}
+
+// probeIngress sends a request to the ingress address; any HTTP response, regardless
+// of its status code, proves the ingress route to be reachable.
+func probeIngress(host, address string) error {
+	client := &http.Client{
+		Timeout: ingressProbeTimeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	request, err := http.NewRequest(http.MethodGet, "http://"+net.JoinHostPort(address, "80"), nil)
+	if err != nil {
+		return err
+	}
+	if host != "" {
+		request.Host = host
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return fmt.Errorf("ingress not reachable: %w", err)
+	}
+	return response.Body.Close()
+}
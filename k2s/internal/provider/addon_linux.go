@@ -177,6 +177,14 @@ func (p *linuxAddonProvider) Status(cfg AddonStatusConfig) (*AddonStatusResult,
 					}
 				}
 			}
+
+			if len(cfg.Manifests) > 0 {
+				report, err := addonKubectl{kubectl: "kubectl"}.evaluateHealth(addon.Name, cfg.Manifests)
+				if err != nil {
+					slog.Warn("[Addon] Failed to evaluate health", "name", addon.Name, "error", err)
+				}
+				info.Health = report
+			}
 		}
 
 		result.Addons = append(result.Addons, info)
@@ -207,6 +215,10 @@ func (p *linuxAddonProvider) RunCommand(cfg AddonRunCommandConfig) error {
 	}
 }
 
+func (p *linuxAddonProvider) DryRun(cfg AddonDryRunConfig) (*AddonDryRunResult, error) {
+	return addonKubectl{kubectl: "kubectl"}.run(cfg)
+}
+
 // isAddonDeployed checks if an addon has any pods deployed in the cluster.
 func isAddonDeployed(addonName string) bool {
 	output, err := exec.Command("kubectl", "get", "pods", "-A",
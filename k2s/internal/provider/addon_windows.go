@@ -7,6 +7,7 @@ package provider
 
 import (
 	"fmt"
+	"log/slog"
 	"path/filepath"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/utils"
@@ -141,6 +142,14 @@ func (p *windowsAddonProvider) Status(cfg AddonStatusConfig) (*AddonStatusResult
 		}
 		info.Props = append(info.Props, sp)
 	}
+	if info.Enabled && len(cfg.Manifests) > 0 {
+		kubectl := filepath.Join(p.installDir, "bin", "kube", "kubectl.exe")
+		report, err := addonKubectl{kubectl: kubectl}.evaluateHealth(cfg.Name, cfg.Manifests)
+		if err != nil {
+			slog.Warn("[Addon] Failed to evaluate health", "name", cfg.Name, "error", err)
+		}
+		info.Health = report
+	}
 	statusResult.Addons = append(statusResult.Addons, info)
 
 	return statusResult, nil
@@ -196,3 +205,8 @@ func (p *windowsAddonProvider) RunCommand(cfg AddonRunCommandConfig) error {
 	}
 	return result.checkFailure()
 }
+
+func (p *windowsAddonProvider) DryRun(cfg AddonDryRunConfig) (*AddonDryRunResult, error) {
+	kubectl := filepath.Join(p.installDir, "bin", "kube", "kubectl.exe")
+	return addonKubectl{kubectl: kubectl}.run(cfg)
+}
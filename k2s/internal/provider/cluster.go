@@ -3,7 +3,12 @@
 
 package provider
 
-import k2sos "github.com/siemens-healthineers/k2s/internal/os"
+import (
+	"github.com/siemens-healthineers/k2s/internal/core/events"
+	"github.com/siemens-healthineers/k2s/internal/core/kubeadmconfig"
+	"github.com/siemens-healthineers/k2s/internal/core/noderesources"
+	k2sos "github.com/siemens-healthineers/k2s/internal/os"
+)
 
 // ClusterProvider abstracts cluster lifecycle operations.
 // On Windows: delegates to PowerShell scripts.
@@ -35,6 +40,7 @@ type ClusterInstallConfig struct {
 	MasterDiskSize         string
 	DynamicMemory          bool
 	LinuxOnly              bool
+	WindowsWorker          bool // set up a Windows worker VM (Linux only)
 	WSL                    bool
 	ShowLogs               bool
 	SkipStart              bool
@@ -51,6 +57,10 @@ type ClusterInstallConfig struct {
 	Version                string
 	ClusterName            string
 	ControlPlaneHostname   string
+	Resume                 bool // continue an interrupted installation (Linux only)
+	Rollback               bool // undo the completed steps of an interrupted installation (Linux only)
+	// Kubeadm customizes the generated kubeadm and kubelet configuration (Linux only)
+	Kubeadm *kubeadmconfig.Customization
 	// StdWriter overrides the default writer for capturing PS output (Windows).
 	// Linux providers ignore this field.
 	StdWriter              k2sos.StdWriter
@@ -89,15 +99,25 @@ type ClusterStopConfig struct {
 type ClusterStatusConfig struct {
 	// ShowOutput controls whether PS scripts emit verbose output (Windows only).
 	ShowOutput bool
+	// LoadResources loads allocatable and requested resources as well as pressure conditions of the nodes.
+	LoadResources bool
+	// LoadUsage additionally loads the node utilisation from the metrics API, i.e. requires the metrics addon.
+	LoadUsage bool
+	// EventNamespaces are the namespaces to load recent warning events for; no events are loaded if empty.
+	EventNamespaces []string
 }
 
 // ClusterStatus holds the loaded cluster status.
 type ClusterStatus struct {
-	IsRunning      bool
-	Issues         []string
+	IsRunning bool
+	Issues    []string
+	// Notes are information not affecting the health, e.g. host services restarted after a failure.
+	Notes          []string
 	Nodes          []NodeStatus
 	Pods           []PodStatus
 	K8sVersionInfo *K8sVersionInfo
+	// Events is nil if no events were requested or they could not be loaded.
+	Events []events.Group
 }
 
 // K8sVersionInfo holds Kubernetes version information.
@@ -119,6 +139,8 @@ type NodeStatus struct {
 	InternalIp       string
 	IsReady          bool
 	Capacity         NodeCapacity
+	// Resources is nil if the node resources could not be determined.
+	Resources *noderesources.Resources
 }
 
 // NodeCapacity holds resource capacity for a node.
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package provider
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/core/events"
+)
+
+// loadWarningEvents loads the recent warning events of the given namespaces grouped by involved object. Failures are
+// logged only since the events supplement the cluster status.
+func loadWarningEvents(kubectl commandKubectl, namespaces []string) []events.Group {
+	if len(namespaces) == 0 {
+		return nil
+	}
+
+	eventList, err := kubectl.ExecWithOutput("get", "events", "--all-namespaces", "--field-selector", "type=Warning", "-o", "json")
+	if err != nil {
+		slog.Warn("[Status] Could not load events", "error", err)
+		return nil
+	}
+
+	groups, err := events.GroupWarnings([]byte(eventList), namespaces, time.Now().Add(-events.DefaultWindow))
+	if err != nil {
+		slog.Warn("[Status] Could not group events", "error", err)
+		return nil
+	}
+	return groups
+}
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	contracts "github.com/siemens-healthineers/k2s/internal/contracts/config"
 	"github.com/siemens-healthineers/k2s/internal/setuporchestration"
 )
 
@@ -39,6 +40,7 @@ func (p *linuxClusterProvider) Install(cfg ClusterInstallConfig) error {
 		MasterVMMemory:          cfg.MasterVMMemory,
 		MasterDiskSize:          cfg.MasterDiskSize,
 		LinuxOnly:               cfg.LinuxOnly,
+		WindowsWorker:           cfg.WindowsWorker,
 		WSL:                     cfg.WSL,
 		ForceOnlineInstallation: cfg.ForceOnlineInstallation,
 		Proxy:                   cfg.Proxy,
@@ -49,6 +51,9 @@ func (p *linuxClusterProvider) Install(cfg ClusterInstallConfig) error {
 		Version:                 cfg.Version,
 		ClusterName:             cfg.ClusterName,
 		ControlPlaneHostname:    cfg.ControlPlaneHostname,
+		Resume:                  cfg.Resume,
+		Rollback:                cfg.Rollback,
+		Kubeadm:                 cfg.Kubeadm,
 	})
 }
 
@@ -60,6 +65,7 @@ func (p *linuxClusterProvider) Uninstall(cfg ClusterUninstallConfig) error {
 		DeleteFilesForOfflineInstallation: cfg.DeleteFilesForOfflineInstallation,
 		AdditionalHooksDir:                cfg.AdditionalHooksDir,
 		ConfigDir:                         cfg.ConfigDir,
+		InstallDir:                        p.installDir,
 	})
 }
 
@@ -69,6 +75,8 @@ func (p *linuxClusterProvider) Start(cfg ClusterStartConfig) error {
 		ShowLogs:            cfg.ShowLogs,
 		AdditionalHooksDir:  cfg.AdditionalHooksDir,
 		UseCachedK2sVSwitch: cfg.UseCachedK2sVSwitch,
+		ConfigDir:           p.configDir,
+		InstallDir:          p.installDir,
 	})
 }
 
@@ -77,28 +85,48 @@ func (p *linuxClusterProvider) Stop(cfg ClusterStopConfig) error {
 	return orch.Stop(setuporchestration.StopConfig{
 		ShowLogs:           cfg.ShowLogs,
 		AdditionalHooksDir: cfg.AdditionalHooksDir,
+		ConfigDir:          p.configDir,
+		InstallDir:         p.installDir,
 	})
 }
 
-func (p *linuxClusterProvider) Status(_ ClusterStatusConfig) (*ClusterStatus, error) {
+func (p *linuxClusterProvider) Status(cfg ClusterStatusConfig) (*ClusterStatus, error) {
 	slog.Debug("[Status] Loading status via kubectl (Linux)")
 
 	status := &ClusterStatus{}
 	status.IsRunning = isAPIServerReachable()
+	serviceIssues, serviceNotes := hostServiceStates(status.IsRunning)
+	status.Notes = serviceNotes
 
 	if !status.IsRunning {
-		status.Issues = []string{"Kubernetes API server is not reachable"}
+		status.Issues = append([]string{"Kubernetes API server is not reachable"}, serviceIssues...)
 		return status, nil
 	}
+	status.Issues = serviceIssues
+
+	worker := setuporchestration.InstalledWindowsWorker(p.configDir)
+	if worker != nil {
+		status.Issues = append(status.Issues, windowsWorkerVMIssues(worker)...)
+	}
+
+	kubectl := commandKubectl{binary: "kubectl", baseArgs: linuxKubectlArgs()}
 
 	nodes, err := gatherNodeStatus()
 	if err != nil {
 		slog.Warn("[Status] Could not gather node info", "error", err)
 		status.Issues = append(status.Issues, fmt.Sprintf("cannot list nodes: %v", err))
 	} else {
+		if cfg.LoadResources {
+			addNodeResources(kubectl, nodes, cfg.LoadUsage)
+		}
 		status.Nodes = nodes
+		if worker != nil {
+			status.Issues = append(status.Issues, windowsWorkerNodeIssues(worker, nodes)...)
+		}
 	}
 
+	status.Events = loadWarningEvents(kubectl, cfg.EventNamespaces)
+
 	pods, err := gatherPodStatus()
 	if err != nil {
 		slog.Warn("[Status] Could not gather pod info", "error", err)
@@ -120,6 +148,78 @@ func (p *linuxClusterProvider) Status(_ ClusterStatusConfig) (*ClusterStatus, er
 	return status, nil
 }
 
+// hostServiceStates reports host services that failed as issues and services restarted automatically but up again
+// as notes, since the restart count is kept until the next explicit start. Stopped services are only reported while
+// the cluster is running, since 'k2s stop' stops them intentionally.
+func hostServiceStates(clusterRunning bool) (issues, notes []string) {
+	serviceManager := setuporchestration.NewServiceManager()
+	for _, name := range setuporchestration.HostServices() {
+		serviceStatus, err := serviceManager.Status(name)
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("cannot determine state of host service '%s': %v", name, err))
+			continue
+		}
+		issue, note := hostServiceState(serviceStatus, clusterRunning)
+		if issue != "" {
+			issues = append(issues, issue)
+		}
+		if note != "" {
+			notes = append(notes, note)
+		}
+	}
+	return issues, notes
+}
+
+func hostServiceState(serviceStatus *setuporchestration.ServiceStatus, clusterRunning bool) (issue, note string) {
+	switch {
+	case !serviceStatus.Installed:
+		if !clusterRunning {
+			return "", ""
+		}
+		return fmt.Sprintf("host service '%s' is not installed", serviceStatus.Name), ""
+	case serviceStatus.IsActive():
+		if serviceStatus.RestartCount == 0 {
+			return "", ""
+		}
+		note = fmt.Sprintf("host service '%s' was restarted %d time(s)", serviceStatus.Name, serviceStatus.RestartCount)
+		if serviceStatus.LastError != "" {
+			note += ", last error: " + serviceStatus.LastError
+		}
+		return "", note
+	case serviceStatus.ActiveState == "inactive" && !clusterRunning:
+		return "", ""
+	default:
+		issue = fmt.Sprintf("host service '%s' is %s (%s)", serviceStatus.Name, serviceStatus.ActiveState, serviceStatus.SubState)
+		if serviceStatus.RestartCount > 0 {
+			issue += fmt.Sprintf(", restarted %d time(s)", serviceStatus.RestartCount)
+		}
+		if serviceStatus.LastError != "" {
+			issue += ", last error: " + serviceStatus.LastError
+		}
+		return issue, ""
+	}
+}
+
+func windowsWorkerVMIssues(worker *contracts.K2sWindowsWorkerConfig) []string {
+	running, err := setuporchestration.NewVMManager().VMIsRunning(worker.VMName())
+	if err != nil {
+		return []string{fmt.Sprintf("cannot determine state of Windows worker VM '%s': %v", worker.VMName(), err)}
+	}
+	if !running {
+		return []string{fmt.Sprintf("Windows worker VM '%s' is not running", worker.VMName())}
+	}
+	return nil
+}
+
+func windowsWorkerNodeIssues(worker *contracts.K2sWindowsWorkerConfig, nodes []NodeStatus) []string {
+	for _, node := range nodes {
+		if node.Name == worker.NodeName() {
+			return nil
+		}
+	}
+	return []string{fmt.Sprintf("Windows worker node '%s' is not registered in the cluster", worker.NodeName())}
+}
+
 // ---------- kubectl helpers ----------
 
 func isAPIServerReachable() bool {
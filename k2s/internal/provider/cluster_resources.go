@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package provider
+
+import (
+	"log/slog"
+
+	"github.com/siemens-healthineers/k2s/internal/core/noderesources"
+)
+
+// addNodeResources enriches the nodes with allocatable and requested resources, pressure conditions and optionally
+// the utilisation. Failures are logged only since the resources supplement the node status.
+func addNodeResources(kubectl commandKubectl, nodes []NodeStatus, loadUsage bool) {
+	if len(nodes) == 0 {
+		return
+	}
+
+	nodeList, err := kubectl.ExecWithOutput("get", "nodes", "-o", "json")
+	if err != nil {
+		slog.Warn("[Status] Could not load node resources", "error", err)
+		return
+	}
+	podList, err := kubectl.ExecWithOutput("get", "pods", "--all-namespaces", "-o", "json")
+	if err != nil {
+		slog.Warn("[Status] Could not load pod resource requests", "error", err)
+		return
+	}
+
+	resources, err := noderesources.Parse([]byte(nodeList), []byte(podList))
+	if err != nil {
+		slog.Warn("[Status] Could not determine node resources", "error", err)
+		return
+	}
+
+	if loadUsage {
+		metrics, err := kubectl.ExecWithOutput("get", "--raw", "/apis/metrics.k8s.io/v1beta1/nodes")
+		if err != nil {
+			slog.Warn("[Status] Could not load node metrics", "error", err)
+		} else if err := noderesources.AddUsage(resources, []byte(metrics)); err != nil {
+			slog.Warn("[Status] Could not determine node utilisation", "error", err)
+		}
+	}
+
+	for i := range nodes {
+		nodes[i].Resources = resources[nodes[i].Name]
+	}
+}
@@ -291,5 +291,13 @@ func (p *windowsClusterProvider) Status(cfg ClusterStatusConfig) (*ClusterStatus
 		})
 	}
 
+	if status.IsRunning {
+		kubectl := commandKubectl{binary: filepath.Join(p.installDir, "bin", "kube", "kubectl.exe")}
+		if cfg.LoadResources {
+			addNodeResources(kubectl, status.Nodes, cfg.LoadUsage)
+		}
+		status.Events = loadWarningEvents(kubectl, cfg.EventNamespaces)
+	}
+
 	return status, nil
 }
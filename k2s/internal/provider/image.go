@@ -3,6 +3,8 @@
 
 package provider
 
+import "time"
+
 // ImageProvider abstracts container image operations.
 // On Windows: delegates to PowerShell scripts via SSH to VMs.
 // On Linux: uses crictl/nerdctl locally + SSH to Windows VM.
@@ -39,6 +41,7 @@ type ImageProvider interface {
 type ImageListConfig struct {
 	IncludeK8sImages bool
 	Nodes            string
+	RequirePodImages bool // Fail if the images used by Pods cannot be determined
 	ShowOutput       bool
 }
 
@@ -47,15 +50,21 @@ type ImageListResult struct {
 	ContainerImages   []ContainerImage
 	ContainerRegistry string
 	PushedImages      []PushedImage
+	PodImages         []string // Image references and image IDs used by the cluster's Pods
 }
 
-// ContainerImage represents a single container image.
+// ContainerImage represents a single container image on a single node.
 type ContainerImage struct {
-	ImageId    string
-	Repository string
-	Tag        string
-	Node       string
-	Size       string
+	ImageId          string
+	Repository       string
+	Tag              string
+	Node             string
+	Size             string    // As reported by the node's container tooling
+	Digest           string    // Repository digest, empty if unknown
+	CompressedSize   int64     // In bytes, 0 if unknown
+	UncompressedSize int64     // In bytes, 0 if unknown
+	Created          time.Time // Zero if unknown
+	LastUsed         time.Time // Creation of the most recent container from the image, zero if unknown
 }
 
 // PushedImage represents an image pushed to a registry.
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"slices"
+)
+
+// listPodImages returns the image references and image IDs of all Pods' containers, used to
+// detect images not referenced by any Pod. It is shared by the platform providers, which only
+// differ in the kubectl binary and its base arguments.
+func listPodImages(kubectl string, kubectlArgs ...string) ([]string, error) {
+	args := append(append([]string{}, kubectlArgs...), "get", "pods", "-A", "-o", "json")
+
+	slog.Debug("[Image] Listing Pod images", "kubectl", kubectl, "args", args)
+
+	output, err := exec.Command(kubectl, args...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("kubectl get pods: %w", err)
+	}
+	return parsePodImages(output)
+}
+
+func parsePodImages(podList []byte) ([]string, error) {
+	type container struct {
+		Image string `json:"image"`
+	}
+	type containerStatus struct {
+		Image   string `json:"image"`
+		ImageID string `json:"imageID"`
+	}
+	var pods struct {
+		Items []struct {
+			Spec struct {
+				Containers     []container `json:"containers"`
+				InitContainers []container `json:"initContainers"`
+			} `json:"spec"`
+			Status struct {
+				ContainerStatuses     []containerStatus `json:"containerStatuses"`
+				InitContainerStatuses []containerStatus `json:"initContainerStatuses"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+
+	if err := json.Unmarshal(podList, &pods); err != nil {
+		return nil, fmt.Errorf("parsing Pod list: %w", err)
+	}
+
+	var images []string
+	add := func(image string) {
+		if image != "" && !slices.Contains(images, image) {
+			images = append(images, image)
+		}
+	}
+
+	for _, pod := range pods.Items {
+		for _, c := range append(pod.Spec.Containers, pod.Spec.InitContainers...) {
+			add(c.Image)
+		}
+		for _, s := range append(pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses...) {
+			add(s.Image)
+			add(s.ImageID)
+		}
+	}
+	return images, nil
+}
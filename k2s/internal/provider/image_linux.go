@@ -9,30 +9,53 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"os"
 	"os/exec"
 	"strings"
+	"time"
+
+	contracts "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/images"
+	"github.com/siemens-healthineers/k2s/internal/setuporchestration"
 )
 
 const (
-	winVMIP      = "172.19.1.101"
-	sshUser      = "remote"
+	winVMIP = "172.19.1.101"
+	sshUser = "remote"
+	// windowsNodeAlias selects the Windows worker regardless of its node name
+	windowsNodeAlias = "windows"
 )
 
 type linuxImageProvider struct {
 	installDir string
+	configDir  string
 }
 
 func newLinuxImageProvider(cfg ProviderConfig) *linuxImageProvider {
-	return &linuxImageProvider{installDir: cfg.InstallDir}
+	return &linuxImageProvider{installDir: cfg.InstallDir, configDir: cfg.ConfigDir}
 }
 
 // sshCmd executes a command on the Windows VM via SSH.
 func sshCmd(command string) (string, error) {
+	return sshHostCmd(winVMIP, command)
+}
+
+// windowsWorker returns the installed Windows worker or an error if the setup has none.
+func (p *linuxImageProvider) windowsWorker(operation string) (*contracts.K2sWindowsWorkerConfig, error) {
+	worker := setuporchestration.InstalledWindowsWorker(p.configDir)
+	if worker == nil {
+		return nil, NotSupportedError(operation, "no Windows worker node is installed; install K2s with '--windows-worker' to use Windows images")
+	}
+	return worker, nil
+}
+
+// sshHostCmd executes a command on the given host via SSH.
+func sshHostCmd(host, command string) (string, error) {
 	out, err := exec.Command("ssh",
 		"-o", "StrictHostKeyChecking=no",
 		"-o", "UserKnownHostsFile=/dev/null",
 		"-o", "ConnectTimeout=10",
-		fmt.Sprintf("%s@%s", sshUser, winVMIP),
+		fmt.Sprintf("%s@%s", sshUser, host),
 		command,
 	).CombinedOutput()
 	if err != nil {
@@ -42,14 +65,29 @@ func sshCmd(command string) (string, error) {
 }
 
 func (p *linuxImageProvider) List(cfg ImageListConfig) (*ImageListResult, error) {
-	slog.Debug("[Image] Listing images (Linux)")
+	slog.Debug("[Image] Listing images (Linux)", "nodes", cfg.Nodes)
 	result := &ImageListResult{}
 
-	// List images on the local Linux node via crictl
-	linuxImages, err := listCrictlImages()
+	worker := setuporchestration.InstalledWindowsWorker(p.configDir)
+	windowsNode := ""
+	if worker != nil {
+		windowsNode = worker.NodeName()
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine host name: %w", err)
+	}
+	selectLinux, selectWindows, err := selectImageNodes(cfg.Nodes, hostname, windowsNode)
 	if err != nil {
-		slog.Warn("[Image] Could not list Linux node images", "error", err)
-	} else {
+		return nil, err
+	}
+
+	// List images on the local Linux node via crictl
+	if selectLinux {
+		linuxImages, err := listCrictlImages()
+		if err != nil {
+			slog.Warn("[Image] Could not list Linux node images", "error", err)
+		}
 		for _, img := range linuxImages {
 			if !cfg.IncludeK8sImages && isK8sImage(img.Repository) {
 				continue
@@ -58,11 +96,12 @@ func (p *linuxImageProvider) List(cfg ImageListConfig) (*ImageListResult, error)
 		}
 	}
 
-	// List images on the Windows VM via SSH + crictl
-	winImages, err := listWindowsVMImages()
-	if err != nil {
-		slog.Debug("[Image] Could not list Windows VM images (VM may be offline)", "error", err)
-	} else {
+	// List images on the Windows worker VM via SSH + crictl
+	if selectWindows {
+		winImages, err := listWindowsVMImages(worker)
+		if err != nil {
+			slog.Warn("[Image] Could not list Windows worker images (VM may be offline)", "node", worker.NodeName(), "error", err)
+		}
 		for _, img := range winImages {
 			if !cfg.IncludeK8sImages && isK8sImage(img.Repository) {
 				continue
@@ -71,13 +110,26 @@ func (p *linuxImageProvider) List(cfg ImageListConfig) (*ImageListResult, error)
 		}
 	}
 
+	podImages, err := listPodImages("kubectl", linuxKubectlArgs()...)
+	if err != nil {
+		if cfg.RequirePodImages {
+			return nil, fmt.Errorf("failed to determine images used by Pods: %w", err)
+		}
+		slog.Warn("[Image] Could not list Pod images, usage of images is unknown", "error", err)
+	}
+	result.PodImages = podImages
+
 	return result, nil
 }
 
 func (p *linuxImageProvider) Pull(cfg ImagePullConfig) error {
 	if cfg.Windows {
-		slog.Info("[Image] Pulling image on Windows VM", "image", cfg.ImageName)
-		_, err := sshCmd(fmt.Sprintf("crictl pull %s", cfg.ImageName))
+		worker, err := p.windowsWorker("image pull")
+		if err != nil {
+			return err
+		}
+		slog.Info("[Image] Pulling image on Windows worker", "image", cfg.ImageName, "node", worker.NodeName())
+		_, err = sshHostCmd(worker.IpAddress(), fmt.Sprintf("crictl pull %s", cfg.ImageName))
 		return err
 	}
 	slog.Info("[Image] Pulling image on Linux node", "image", cfg.ImageName)
@@ -95,6 +147,14 @@ func (p *linuxImageProvider) Remove(cfg ImageRemoveConfig) error {
 		args = append(args, "--force")
 	}
 	args = append(args, ref)
+	if cfg.Nodes != "" && (cfg.Nodes == windowsNodeAlias || isWindowsWorkerNode(p.configDir, cfg.Nodes)) {
+		worker, err := p.windowsWorker("image rm")
+		if err != nil {
+			return err
+		}
+		_, err = sshHostCmd(worker.IpAddress(), "crictl "+strings.Join(args, " "))
+		return err
+	}
 	return exec.Command("crictl", args...).Run()
 }
 
@@ -139,8 +199,12 @@ func (p *linuxImageProvider) Import(cfg ImageImportConfig) error {
 	slog.Info("[Image] Importing image", "path", path, "windows", cfg.Windows)
 
 	if cfg.Windows {
-		// Import on Windows VM via SSH
-		_, err := sshCmd(fmt.Sprintf(`ctr -n k8s.io images import "%s"`, path))
+		worker, err := p.windowsWorker("image import")
+		if err != nil {
+			return err
+		}
+		// Import on Windows worker VM via SSH
+		_, err = sshHostCmd(worker.IpAddress(), fmt.Sprintf(`ctr -n k8s.io images import "%s"`, path))
 		return err
 	}
 	return exec.Command("ctr", "-n", "k8s.io", "images", "import", path).Run()
@@ -201,6 +265,70 @@ func listCrictlImages() ([]ContainerImage, error) {
 		return nil, fmt.Errorf("crictl images: %w", err)
 	}
 
+	// CRI-O reports the size of the unpacked image in its storage
+	images, err := parseCrictlImages(output, "linux", true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing crictl output: %w", err)
+	}
+	addImageTimes(images, func(args ...string) ([]byte, error) {
+		return exec.Command("crictl", args...).Output()
+	})
+	return images, nil
+}
+
+// listWindowsVMImages lists the images of the Windows worker, labeled with its node name.
+func listWindowsVMImages(worker *contracts.K2sWindowsWorkerConfig) ([]ContainerImage, error) {
+	output, err := sshHostCmd(worker.IpAddress(), "crictl images -o json")
+	if err != nil {
+		return nil, err
+	}
+
+	// containerd reports the size of the compressed image content
+	images, err := parseCrictlImages([]byte(output), worker.NodeName(), false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Windows VM crictl output: %w", err)
+	}
+	addImageTimes(images, func(args ...string) ([]byte, error) {
+		output, err := sshHostCmd(worker.IpAddress(), "crictl "+strings.Join(args, " "))
+		return []byte(output), err
+	})
+	return images, nil
+}
+
+// addImageTimes sets the creation and last use times of the images as known to the container runtime; crictl runs
+// crictl with the given arguments on the images' node. Times that cannot be determined stay unknown.
+func addImageTimes(containerImages []ContainerImage, crictl func(args ...string) ([]byte, error)) {
+	if len(containerImages) == 0 {
+		return
+	}
+
+	args := []string{"inspecti", "-o", "json"}
+	for _, img := range containerImages {
+		args = append(args, img.ImageId)
+	}
+	created := map[string]time.Time{}
+	if output, err := crictl(args...); err != nil {
+		slog.Debug("[Image] Could not inspect images, creation times are unknown", "error", err)
+	} else if created, err = images.ParseCrictlImageCreation(output); err != nil {
+		slog.Debug("[Image] Could not determine image creation times", "error", err)
+	}
+
+	lastUse := map[string]time.Time{}
+	if output, err := crictl("ps", "-a", "-o", "json"); err != nil {
+		slog.Debug("[Image] Could not list containers, last use of images is unknown", "error", err)
+	} else if lastUse, err = images.ParseCrictlImageLastUse(output); err != nil {
+		slog.Debug("[Image] Could not determine last use of images", "error", err)
+	}
+
+	for i := range containerImages {
+		containerImages[i].Created = images.LookupImageTime(created, containerImages[i].ImageId, "")
+		containerImages[i].LastUsed = images.LookupImageTime(lastUse, containerImages[i].ImageId, containerImages[i].Digest)
+	}
+}
+
+// parseCrictlImages parses the output of 'crictl images -o json'; uncompressed tells whether the container runtime
+// reports the size of the unpacked or of the compressed image.
+func parseCrictlImages(output []byte, node string, uncompressed bool) ([]ContainerImage, error) {
 	var result struct {
 		Images []struct {
 			Id          string   `json:"id"`
@@ -211,10 +339,10 @@ func listCrictlImages() ([]ContainerImage, error) {
 	}
 
 	if err := json.Unmarshal(output, &result); err != nil {
-		return nil, fmt.Errorf("parsing crictl output: %w", err)
+		return nil, err
 	}
 
-	var images []ContainerImage
+	var containerImages []ContainerImage
 	for _, img := range result.Images {
 		repo := "<none>"
 		tag := "<none>"
@@ -225,65 +353,64 @@ func listCrictlImages() ([]ContainerImage, error) {
 				tag = parts[1]
 			}
 		}
-		shortId := img.Id
+		shortId := strings.TrimPrefix(img.Id, "sha256:")
 		if len(shortId) > 12 {
 			shortId = shortId[:12]
 		}
-		images = append(images, ContainerImage{
+		digest := ""
+		if len(img.RepoDigests) > 0 {
+			_, digest, _ = strings.Cut(img.RepoDigests[0], "@")
+		}
+		size, err := images.ParseSize(img.Size)
+		if err != nil {
+			slog.Debug("[Image] Could not parse image size", "id", shortId, "size", img.Size, "error", err)
+		}
+
+		containerImage := ContainerImage{
 			ImageId:    shortId,
 			Repository: repo,
 			Tag:        tag,
-			Node:       "linux",
+			Node:       node,
 			Size:       img.Size,
-		})
+			Digest:     digest,
+		}
+		if uncompressed {
+			containerImage.UncompressedSize = size
+		} else {
+			containerImage.CompressedSize = size
+		}
+		containerImages = append(containerImages, containerImage)
 	}
 
-	return images, nil
+	return containerImages, nil
 }
 
-func listWindowsVMImages() ([]ContainerImage, error) {
-	output, err := sshCmd("crictl images -o json")
-	if err != nil {
-		return nil, err
-	}
-
-	var result struct {
-		Images []struct {
-			Id       string   `json:"id"`
-			RepoTags []string `json:"repoTags"`
-			Size     string   `json:"size"`
-		} `json:"images"`
-	}
-
-	if err := json.Unmarshal([]byte(output), &result); err != nil {
-		return nil, fmt.Errorf("parsing Windows VM crictl output: %w", err)
+// selectImageNodes resolves the comma-separated node selection to the local Linux node and the Windows worker; all
+// nodes are selected if the selection is empty. The Linux node is selected by its host name or by 'linux', the label
+// of its images, the Windows worker by its node name or by 'windows'.
+func selectImageNodes(selection, linuxNode, windowsNode string) (linux, windows bool, err error) {
+	if strings.TrimSpace(selection) == "" {
+		return true, windowsNode != "", nil
 	}
-
-	var images []ContainerImage
-	for _, img := range result.Images {
-		repo := "<none>"
-		tag := "<none>"
-		if len(img.RepoTags) > 0 && img.RepoTags[0] != "" {
-			parts := strings.SplitN(img.RepoTags[0], ":", 2)
-			repo = parts[0]
-			if len(parts) > 1 {
-				tag = parts[1]
-			}
-		}
-		shortId := img.Id
-		if len(shortId) > 12 {
-			shortId = shortId[:12]
+	for node := range strings.SplitSeq(selection, ",") {
+		node = strings.TrimSpace(node)
+		switch {
+		case node == "":
+		case strings.EqualFold(node, linuxNode) || strings.EqualFold(node, "linux"):
+			linux = true
+		case windowsNode != "" && (strings.EqualFold(node, windowsNode) || strings.EqualFold(node, windowsNodeAlias)):
+			windows = true
+		default:
+			return false, false, fmt.Errorf("node '%s' not found", node)
 		}
-		images = append(images, ContainerImage{
-			ImageId:    shortId,
-			Repository: repo,
-			Tag:        tag,
-			Node:       "windows",
-			Size:       img.Size,
-		})
 	}
+	return linux, windows, nil
+}
 
-	return images, nil
+// isWindowsWorkerNode returns whether the node name denotes the installed Windows worker.
+func isWindowsWorkerNode(configDir, node string) bool {
+	worker := setuporchestration.InstalledWindowsWorker(configDir)
+	return worker != nil && worker.NodeName() == node
 }
 
 func isK8sImage(repo string) bool {
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package provider
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("image_linux", func() {
+	Describe("selectImageNodes", func() {
+		DescribeTable("selects the nodes to list images on",
+			func(selection, windowsNode string, expectedLinux, expectedWindows bool) {
+				linux, windows, err := selectImageNodes(selection, "kubemaster", windowsNode)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(linux).To(Equal(expectedLinux))
+				Expect(windows).To(Equal(expectedWindows))
+			},
+			Entry("all nodes without selection", "", "winnode", true, true),
+			Entry("Linux node only without Windows worker", "", "", true, false),
+			Entry("Windows worker by node name", "WinNode", "winnode", false, true),
+			Entry("Windows worker by alias", "windows", "winnode", false, true),
+			Entry("Linux node by host name", "kubemaster", "winnode", true, false),
+			Entry("Linux node by image label", "linux", "winnode", true, false),
+			Entry("both nodes", "kubemaster, winnode", "winnode", true, true),
+		)
+
+		It("returns an error on unknown nodes", func() {
+			_, _, err := selectImageNodes("worker-1", "kubemaster", "winnode")
+
+			Expect(err).To(MatchError("node 'worker-1' not found"))
+		})
+
+		It("returns an error on the Windows alias without Windows worker", func() {
+			_, _, err := selectImageNodes("windows", "kubemaster", "")
+
+			Expect(err).To(MatchError("node 'windows' not found"))
+		})
+	})
+})
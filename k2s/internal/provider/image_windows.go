@@ -7,10 +7,13 @@ package provider
 
 import (
 	"fmt"
+	"log/slog"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/utils"
+	"github.com/siemens-healthineers/k2s/internal/core/images"
 	k2sos "github.com/siemens-healthineers/k2s/internal/os"
 	"github.com/siemens-healthineers/k2s/internal/powershell"
 )
@@ -53,11 +56,15 @@ func (p *windowsImageProvider) List(cfg ImageListConfig) (*ImageListResult, erro
 	type psImages struct {
 		psCmdResult
 		ContainerImages []struct {
-			ImageId    string `json:"imageid"`
-			Repository string `json:"repository"`
-			Tag        string `json:"tag"`
-			Node       string `json:"node"`
-			Size       string `json:"size"`
+			ImageId      string `json:"imageid"`
+			Repository   string `json:"repository"`
+			Tag          string `json:"tag"`
+			Node         string `json:"node"`
+			Size         string `json:"size"`
+			Uncompressed bool   `json:"uncompressed"`
+			Digest       string `json:"digest"`
+			Created      string `json:"created"`
+			LastUsed     string `json:"lastused"`
 		} `json:"containerimages"`
 		ContainerRegistry *string `json:"containerregistry"`
 		PushedImages      []struct {
@@ -81,13 +88,26 @@ func (p *windowsImageProvider) List(cfg ImageListConfig) (*ImageListResult, erro
 	}
 
 	for _, img := range result.ContainerImages {
-		listResult.ContainerImages = append(listResult.ContainerImages, ContainerImage{
+		containerImage := ContainerImage{
 			ImageId:    img.ImageId,
 			Repository: img.Repository,
 			Tag:        img.Tag,
 			Node:       img.Node,
 			Size:       img.Size,
-		})
+			Digest:     img.Digest,
+			Created:    parseImageTime(img.Created),
+			LastUsed:   parseImageTime(img.LastUsed),
+		}
+		size, err := images.ParseSize(img.Size)
+		if err != nil {
+			slog.Debug("[Image] Could not parse image size", "id", img.ImageId, "size", img.Size, "error", err)
+		}
+		if img.Uncompressed {
+			containerImage.UncompressedSize = size
+		} else {
+			containerImage.CompressedSize = size
+		}
+		listResult.ContainerImages = append(listResult.ContainerImages, containerImage)
 	}
 
 	for _, img := range result.PushedImages {
@@ -98,6 +118,15 @@ func (p *windowsImageProvider) List(cfg ImageListConfig) (*ImageListResult, erro
 		})
 	}
 
+	podImages, err := listPodImages(filepath.Join(p.installDir, "bin", "kube", "kubectl.exe"))
+	if err != nil {
+		if cfg.RequirePodImages {
+			return nil, fmt.Errorf("failed to determine images used by Pods: %w", err)
+		}
+		slog.Warn("[Image] Could not list Pod images, usage of images is unknown", "error", err)
+	}
+	listResult.PodImages = podImages
+
 	return listResult, nil
 }
 
@@ -287,3 +316,16 @@ func (p *windowsImageProvider) Clean(cfg ImageCleanConfig) error {
 
 	return p.execPS(psCmd, params...)
 }
+
+// parseImageTime parses the ISO 8601 times reported by the image scripts; zero if unknown.
+func parseImageTime(value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		slog.Debug("[Image] Could not parse image time", "value", value, "error", err)
+		return time.Time{}
+	}
+	return t
+}
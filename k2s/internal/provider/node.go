@@ -12,6 +12,9 @@ type NodeProvider interface {
 
 	// Remove removes a worker node from the cluster.
 	Remove(config NodeRemoveConfig) error
+
+	// Resize changes CPU, memory and disk size of a VM-hosted node.
+	Resize(config NodeResizeConfig) error
 }
 
 // NodeAddConfig holds parameters for adding a node.
@@ -30,3 +33,13 @@ type NodeRemoveConfig struct {
 	NodeName   string
 	ShowOutput bool
 }
+
+// NodeResizeConfig holds parameters for resizing a node; zero values keep the current setting.
+type NodeResizeConfig struct {
+	NodeName    string
+	CPUCount    int
+	MemoryMB    int
+	MaxMemoryMB int // enables dynamic memory
+	DiskSizeGB  int
+	ShowOutput  bool
+}
@@ -10,14 +10,17 @@ import (
 	"log/slog"
 	"os/exec"
 	"strings"
+
+	"github.com/siemens-healthineers/k2s/internal/setuporchestration"
 )
 
 type linuxNodeProvider struct {
 	installDir string
+	configDir  string
 }
 
 func newLinuxNodeProvider(cfg ProviderConfig) *linuxNodeProvider {
-	return &linuxNodeProvider{installDir: cfg.InstallDir}
+	return &linuxNodeProvider{installDir: cfg.InstallDir, configDir: cfg.ConfigDir}
 }
 
 func (p *linuxNodeProvider) Add(cfg NodeAddConfig) error {
@@ -56,3 +59,21 @@ func (p *linuxNodeProvider) Remove(cfg NodeRemoveConfig) error {
 	slog.Info("[Node] Node removed", "name", cfg.NodeName)
 	return nil
 }
+
+func (p *linuxNodeProvider) Resize(cfg NodeResizeConfig) error {
+	slog.Info("[Node] Resizing node", "name", cfg.NodeName, "cpus", cfg.CPUCount, "memoryMB", cfg.MemoryMB, "maxMemoryMB", cfg.MaxMemoryMB, "diskGB", cfg.DiskSizeGB)
+
+	if err := setuporchestration.ResizeNode(setuporchestration.ResizeNodeConfig{
+		NodeName:    cfg.NodeName,
+		CPUCount:    cfg.CPUCount,
+		MemoryMB:    cfg.MemoryMB,
+		MaxMemoryMB: cfg.MaxMemoryMB,
+		DiskSizeGB:  cfg.DiskSizeGB,
+		ConfigDir:   p.configDir,
+	}); err != nil {
+		return err
+	}
+
+	slog.Info("[Node] Node resized", "name", cfg.NodeName)
+	return nil
+}
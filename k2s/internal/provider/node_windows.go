@@ -66,3 +66,7 @@ func (p *windowsNodeProvider) Remove(cfg NodeRemoveConfig) error {
 
 	return powershell.ExecutePs(psCmd+params, p.stdWriter)
 }
+
+func (p *windowsNodeProvider) Resize(cfg NodeResizeConfig) error {
+	return NotSupportedError("node resize", "resizing is available for libvirt-managed VMs on native Linux hosts only")
+}
@@ -3,12 +3,19 @@
 
 package provider
 
+import (
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/core/certificates"
+	"github.com/siemens-healthineers/k2s/internal/core/doctor"
+)
+
 // SystemProvider abstracts system-level operations (dump, upgrade, packaging, etc.).
 // On Windows: delegates to PowerShell scripts.
 // On Linux: uses native commands (kubectl, qemu-img, etc.).
 type SystemProvider interface {
-	// Dump collects diagnostic information from the cluster.
-	Dump(config SystemDumpConfig) error
+	// Dump collects redacted diagnostic information from the cluster into an archive and returns its path.
+	Dump(config SystemDumpConfig) (string, error)
 
 	// Upgrade upgrades the K2s cluster from a package.
 	Upgrade(config SystemUpgradeConfig) error
@@ -34,16 +41,48 @@ type SystemProvider interface {
 	// CertificateRenew renews the Kubernetes certificates.
 	CertificateRenew(config SystemCertRenewConfig) error
 
+	// CertificateStatus collects the certificates K2s depends on.
+	CertificateStatus(config SystemCertStatusConfig) (*CertificateStatus, error)
+
 	// CertificateAutoRotation manages kubelet certificate auto-rotation configuration.
 	CertificateAutoRotation(config SystemCertAutoRotationConfig) error
+
+	// SnapshotCreate checkpoints the cluster state and the disks of the VM-hosted nodes.
+	SnapshotCreate(config SystemSnapshotConfig) (*SystemSnapshot, error)
+
+	// SnapshotList returns the system snapshots, oldest first.
+	SnapshotList() ([]SystemSnapshot, error)
+
+	// SnapshotRestore resets the cluster to a system snapshot. It returns false if the restore was cancelled.
+	SnapshotRestore(config SystemSnapshotConfig) (bool, error)
+
+	// SnapshotDelete removes a system snapshot.
+	SnapshotDelete(config SystemSnapshotConfig) error
+
+	// DoctorChecks returns the health checks of the platform followed by the given pods checks, e.g. contributed by addons.
+	DoctorChecks(config SystemDoctorConfig) []doctor.Check
 }
 
 // SystemDumpConfig holds parameters for the dump operation.
 type SystemDumpConfig struct {
-	OutputDir    string
-	SkipOpenDump bool
-	ShowOutput   bool
-	Nodes        string
+	// OutputDir is the dir to write the archive to; defaults to LogsDir.
+	OutputDir             string
+	LogsDir               string
+	ControlPlaneName      string
+	ControlPlaneIpAddress string
+	SshPrivateKeyPath     string
+	SkipOpenDump          bool
+	ShowOutput            bool
+	Nodes                 string
+}
+
+// SystemDoctorConfig holds parameters for the health checks.
+type SystemDoctorConfig struct {
+	ControlPlaneName      string
+	ControlPlaneIpAddress string
+	SshPrivateKeyPath     string
+	LinuxOnly             bool
+	PodsChecks            []doctor.PodsCheck
 }
 
 // SystemUpgradeConfig holds parameters for the upgrade operation.
@@ -110,12 +149,46 @@ type SystemRestoreConfig struct {
 	ShowOutput         bool
 }
 
+// SystemSnapshotConfig holds parameters for the snapshot operations.
+type SystemSnapshotConfig struct {
+	Name string
+	// Yes skips the confirmation prompt of the restore.
+	Yes        bool
+	ShowOutput bool
+}
+
+// SystemSnapshot describes a checkpoint of the cluster state and the disks of the VM-hosted nodes.
+type SystemSnapshot struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Etcd is set if the snapshot contains the etcd database of the control plane.
+	Etcd bool     `json:"etcd"`
+	VMs  []string `json:"vms,omitempty"`
+}
+
 // SystemCertRenewConfig holds parameters for certificate renewal.
 type SystemCertRenewConfig struct {
-	Force      bool
+	Force bool
+	// Threshold is the remaining validity below which certificates are renewed; ignored when forced.
+	Threshold  time.Duration
 	ShowOutput bool
 }
 
+// SystemCertStatusConfig holds parameters for the certificate inventory.
+type SystemCertStatusConfig struct {
+	// ControlPlaneName is the node name of the control-plane.
+	ControlPlaneName string
+	// ControlPlaneIpAddress and SshPrivateKeyPath are used to read the control-plane certificates remotely.
+	ControlPlaneIpAddress string
+	SshPrivateKeyPath     string
+}
+
+// CertificateStatus holds the certificates K2s depends on and the ones that could not be inspected.
+type CertificateStatus struct {
+	Certificates []certificates.Certificate
+	Warnings     []string
+}
+
 // SystemCertAutoRotationConfig holds parameters for kubelet certificate auto-rotation management.
 type SystemCertAutoRotationConfig struct {
 	Enable     bool
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/core/certificates"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
+)
+
+const (
+	kubeletPort        = "10250"
+	webhookNamespace   = "k2s-webhook"
+	webhookName        = "k2s-webhook"
+	webhookPodSelector = "app.kubernetes.io/name=clusterip-webhook"
+	webhookPort        = "8443"
+	registryNamespace  = "nginx-gw"
+	registryTlsSecret  = "k2s-registry-local-tls"
+	tlsProbeTimeout    = 5 * time.Second
+)
+
+// collectClusterCertificates adds the certificates served or stored in the cluster: kubelet serving certificates
+// of all nodes, the clusterip-webhook serving certificate and CA and the registry addon TLS certificate.
+// It is shared by the platform providers, which only differ in the kubectl binary and its base arguments.
+func collectClusterCertificates(inventory *certificates.Inventory, kubectl string, kubectlArgs ...string) {
+	get := func(args ...string) (string, error) {
+		args = append(append([]string{}, kubectlArgs...), args...)
+		slog.Debug("[System] Querying certificate info", "kubectl", kubectl, "args", args)
+
+		output, err := exec.Command(kubectl, args...).Output()
+		return strings.TrimSpace(string(output)), err
+	}
+
+	nodes, err := get("get", "nodes", "-o", "json")
+	if err != nil {
+		inventory.Warn("Could not list nodes to inspect kubelet serving certificates: %v", err)
+	} else if err := collectKubeletServingCertificates(inventory, []byte(nodes)); err != nil {
+		inventory.Warn("Could not inspect kubelet serving certificates: %v", err)
+	}
+
+	caBundle, err := get("get", "mutatingwebhookconfiguration", webhookName, "--ignore-not-found", "-o", "jsonpath={.webhooks[0].clientConfig.caBundle}")
+	switch {
+	case err != nil:
+		inventory.Warn("Could not read clusterip-webhook CA: %v", err)
+	case caBundle != "":
+		inventory.AddBase64PEM(certificates.SourceWebhook, "", "clusterip-webhook CA", caBundle)
+
+		podIp, err := get("get", "pods", "-n", webhookNamespace, "-l", webhookPodSelector, "--field-selector=status.phase=Running", "-o", "jsonpath={.items[0].status.podIP}")
+		if err != nil || podIp == "" {
+			inventory.Warn("Could not find a running clusterip-webhook Pod to inspect its serving certificate")
+			break
+		}
+		addServedCertificate(inventory, certificates.SourceWebhook, "", "clusterip-webhook", net.JoinHostPort(podIp, webhookPort))
+	}
+
+	registryCert, err := get("get", "secret", "-n", registryNamespace, registryTlsSecret, "--ignore-not-found", "-o", `jsonpath={.data.tls\.crt}`)
+	switch {
+	case err != nil:
+		inventory.Warn("Could not read registry TLS certificate: %v", err)
+	case registryCert != "":
+		inventory.AddBase64PEM(certificates.SourceRegistry, "", registryTlsSecret, registryCert)
+	}
+}
+
+// collectUserCertificates adds the client certificates embedded in the kubeconfig files of the K2s users.
+func collectUserCertificates(inventory *certificates.Inventory, configDir string) {
+	users, err := registry.NewRegistry(configDir).Load()
+	if err != nil {
+		inventory.Warn("Could not load K2s users: %v", err)
+		return
+	}
+	for _, user := range users.Users {
+		kubeconfig, err := os.ReadFile(user.KubeconfigPath)
+		if err != nil {
+			inventory.Warn("Could not read kubeconfig of user '%s': %v", user.K2sUserName, err)
+			continue
+		}
+		inventory.AddKubeconfig(certificates.SourceUser, "", user.K2sUserName, kubeconfig)
+	}
+}
+
+func collectKubeletServingCertificates(inventory *certificates.Inventory, nodeList []byte) error {
+	var nodes struct {
+		Items []struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			Status struct {
+				Addresses []struct {
+					Type    string `json:"type"`
+					Address string `json:"address"`
+				} `json:"addresses"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(nodeList, &nodes); err != nil {
+		return fmt.Errorf("parsing node list: %w", err)
+	}
+
+	for _, node := range nodes.Items {
+		for _, address := range node.Status.Addresses {
+			if address.Type == "InternalIP" {
+				addServedCertificate(inventory, certificates.SourceKubelet, node.Metadata.Name, "kubelet serving", net.JoinHostPort(address.Address, kubeletPort))
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// addServedCertificate adds the leaf certificate presented by the TLS endpoint; it is inspected only, hence not verified.
+func addServedCertificate(inventory *certificates.Inventory, source certificates.Source, node, name, address string) {
+	cert, err := probeTLS(address)
+	if err != nil {
+		inventory.Warn("Could not inspect certificate '%s' served at '%s': %v", name, address, err)
+		return
+	}
+	inventory.AddCertificate(source, node, name, cert)
+}
+
+func probeTLS(address string) (*x509.Certificate, error) {
+	dialer := &net.Dialer{Timeout: tlsProbeTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	peerCerts := conn.ConnectionState().PeerCertificates
+	if len(peerCerts) == 0 {
+		return nil, errors.New("no certificate presented")
+	}
+	return peerCerts[0], nil
+}
@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/core/certificates"
+	"github.com/siemens-healthineers/k2s/internal/core/doctor"
+)
+
+const (
+	apiServerCheckID   = "api-server"
+	diskUsageThreshold = 85
+	doctorProbeTimeout = 3 * time.Second
+	clusterDnsTestName = "kubernetes.default.svc.cluster.local"
+)
+
+// doctorEnv supplies the platform-specific parts of the health checks.
+type doctorEnv struct {
+	kubectl commandKubectl
+	// controlPlane runs a shell command on the control-plane node.
+	controlPlane     func(command string) (string, error)
+	proxyAddress     string
+	proxyRemediation string
+	certificates     func() (*CertificateStatus, error)
+}
+
+var systemPodsChecks = []doctor.PodsCheck{
+	{
+		ID:          "flannel",
+		Description: "Flannel pods are running and ready",
+		Severity:    doctor.SeverityCritical,
+		Remediation: "Inspect the pods with 'kubectl describe pods -n kube-flannel -l app=flannel'; restart the cluster with 'k2s stop' and 'k2s start'",
+		Namespace:   "kube-flannel",
+		Selector:    "app=flannel",
+	},
+	{
+		ID:          "kube-proxy",
+		Description: "kube-proxy pods are running and ready",
+		Severity:    doctor.SeverityCritical,
+		Remediation: "Inspect the pods with 'kubectl describe pods -n kube-system -l k8s-app=kube-proxy'; restart the cluster with 'k2s stop' and 'k2s start'",
+		Namespace:   "kube-system",
+		Selector:    "k8s-app=kube-proxy",
+	},
+}
+
+// doctorChecks returns the checks shared by all platforms; cluster checks depend on the API server check.
+func doctorChecks(env doctorEnv, cfg SystemDoctorConfig) []doctor.Check {
+	certificateStatus := sync.OnceValues(env.certificates)
+
+	checks := []doctor.Check{
+		{
+			ID:          apiServerCheckID,
+			Description: "Kubernetes API server is reachable and ready",
+			Severity:    doctor.SeverityCritical,
+			Remediation: "Start the cluster with 'k2s start'; if it is running, collect diagnostics with 'k2s system dump'",
+			Run: func() error {
+				_, err := env.kubectl.ExecWithOutput("get", "--raw", "/readyz")
+				return err
+			},
+		},
+		{
+			ID:          "certificates-expired",
+			Description: "No certificate has expired",
+			Severity:    doctor.SeverityCritical,
+			Remediation: "Renew the certificates with 'k2s system certificate renew'",
+			DependsOn:   []string{apiServerCheckID},
+			Run: func() error {
+				return checkCertificates(certificateStatus, 0)
+			},
+		},
+		{
+			ID:          "certificates-expiring",
+			Description: fmt.Sprintf("No certificate expires within %d days", int(certificates.DefaultThreshold.Hours()/24)),
+			Severity:    doctor.SeverityWarning,
+			Remediation: "Renew the certificates with 'k2s system certificate renew'; see 'k2s system certificate status' for details",
+			DependsOn:   []string{apiServerCheckID},
+			Run: func() error {
+				return checkCertificates(certificateStatus, certificates.DefaultThreshold)
+			},
+		},
+		{
+			ID:          "proxy",
+			Description: "K2s HTTP proxy is reachable",
+			Severity:    doctor.SeverityWarning,
+			Remediation: env.proxyRemediation,
+			Run: func() error {
+				connection, err := net.DialTimeout("tcp", env.proxyAddress, doctorProbeTimeout)
+				if err != nil {
+					return fmt.Errorf("proxy at '%s' not reachable: %w", env.proxyAddress, err)
+				}
+				return connection.Close()
+			},
+		},
+		{
+			ID:          "windows-pod-routes",
+			Description: "Control-plane has routes to the pod subnets of the Windows nodes",
+			Severity:    doctor.SeverityCritical,
+			Remediation: "Restart the cluster with 'k2s stop' and 'k2s start' to restore the routes",
+			DependsOn:   []string{apiServerCheckID},
+			Run: func() error {
+				if cfg.LinuxOnly {
+					return doctor.Skip("Linux-only setup")
+				}
+				return checkWindowsPodRoutes(env)
+			},
+		},
+		{
+			ID:          "cluster-dns-from-host",
+			Description: "Cluster DNS service resolves service names from the host",
+			Severity:    doctor.SeverityCritical,
+			Remediation: "Inspect the CoreDNS pods with 'kubectl get pods -n kube-system -l k8s-app=kube-dns' and restart them with 'kubectl rollout restart deployment coredns -n kube-system'; if they are ready, restore the host's route to the service network with 'k2s stop' and 'k2s start'",
+			DependsOn:   []string{apiServerCheckID},
+			Run: func() error {
+				return checkClusterDnsFromHost(env.kubectl)
+			},
+		},
+	}
+
+	for _, podsCheck := range append(append([]doctor.PodsCheck{}, systemPodsChecks...), cfg.PodsChecks...) {
+		checks = append(checks, newPodsCheck(env.kubectl, podsCheck))
+	}
+
+	return append(checks, doctor.Check{
+		ID:          "disk-space",
+		Description: fmt.Sprintf("Control-plane disk usage is below %d%%", diskUsageThreshold),
+		Severity:    doctor.SeverityWarning,
+		Remediation: "Free up disk space, e.g. remove unused images with 'k2s image prune'",
+		Run: func() error {
+			output, err := env.controlPlane("df -P /")
+			if err != nil {
+				return fmt.Errorf("could not determine disk usage: %w", err)
+			}
+			usage, err := doctor.ParseDiskUsage(output)
+			if err != nil {
+				return err
+			}
+			if usage >= diskUsageThreshold {
+				return fmt.Errorf("%d%% of the control-plane disk used", usage)
+			}
+			return nil
+		},
+	})
+}
+
+func newPodsCheck(kubectl commandKubectl, podsCheck doctor.PodsCheck) doctor.Check {
+	return doctor.Check{
+		ID:          podsCheck.ID,
+		Description: podsCheck.Description,
+		Severity:    podsCheck.Severity,
+		Remediation: podsCheck.Remediation,
+		DependsOn:   []string{apiServerCheckID},
+		Run: func() error {
+			output, err := kubectl.ExecWithOutput("get", "pods", "-n", podsCheck.Namespace, "-l", podsCheck.Selector, "-o", "json")
+			if err != nil {
+				return err
+			}
+			return doctor.EvaluatePods([]byte(output))
+		},
+	}
+}
+
+// checkCertificates fails if certificates expire within the given period; a period of 0 checks for expired certificates.
+func checkCertificates(status func() (*CertificateStatus, error), period time.Duration) error {
+	result, err := status()
+	if err != nil {
+		return fmt.Errorf("could not inspect certificates: %w", err)
+	}
+
+	expiring := certificates.ExpiringWithin(result.Certificates, period, time.Now())
+	if len(expiring) == 0 {
+		return nil
+	}
+
+	var names []string
+	for _, cert := range expiring {
+		name := cert.Name
+		if cert.Node != "" {
+			name += " (" + cert.Node + ")"
+		}
+		names = append(names, name)
+	}
+
+	if period == 0 {
+		return fmt.Errorf("%d certificates expired: %s", len(expiring), strings.Join(names, ", "))
+	}
+	return fmt.Errorf("%d certificates expire within %d days: %s", len(expiring), int(period.Hours()/24), strings.Join(names, ", "))
+}
+
+func checkWindowsPodRoutes(env doctorEnv) error {
+	nodes, err := env.kubectl.ExecWithOutput("get", "nodes", "-o", "json")
+	if err != nil {
+		return err
+	}
+
+	subnets, err := doctor.WindowsPodSubnets([]byte(nodes))
+	if err != nil {
+		return err
+	}
+	if len(subnets) == 0 {
+		return doctor.Skip("no Windows nodes")
+	}
+
+	var missing []string
+	for _, subnet := range subnets {
+		routes, err := env.controlPlane("ip route show " + subnet.Subnet)
+		if err != nil {
+			return fmt.Errorf("could not read routes of control-plane: %w", err)
+		}
+		if strings.TrimSpace(routes) == "" {
+			missing = append(missing, fmt.Sprintf("%s (%s)", subnet.Subnet, subnet.Node))
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("routes missing: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// checkClusterDnsFromHost resolves a service name from the host via the ClusterIP of the cluster DNS service. This
+// covers CoreDNS and the host's route to the service network, but not the DNS configuration of pods.
+func checkClusterDnsFromHost(kubectl commandKubectl) error {
+	dnsIp, err := kubectl.ExecWithOutput("get", "service", "kube-dns", "-n", "kube-system", "-o", "jsonpath={.spec.clusterIP}")
+	if err != nil {
+		return err
+	}
+	dnsAddress := net.JoinHostPort(strings.TrimSpace(dnsIp), "53")
+
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			dialer := net.Dialer{Timeout: doctorProbeTimeout}
+			return dialer.DialContext(ctx, network, dnsAddress)
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*doctorProbeTimeout)
+	defer cancel()
+
+	if _, err := resolver.LookupHost(ctx, clusterDnsTestName); err != nil {
+		return fmt.Errorf("could not resolve '%s' from the host via cluster DNS service at '%s': %w", clusterDnsTestName, dnsAddress, err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/siemens-healthineers/k2s/internal/core/dump"
+)
+
+// commandKubectl runs kubectl as a local process; stderr is part of the returned error.
+type commandKubectl struct {
+	binary   string
+	baseArgs []string
+}
+
+func (k commandKubectl) ExecWithOutput(args ...string) (string, error) {
+	args = append(append([]string{}, k.baseArgs...), args...)
+	output, err := exec.Command(k.binary, args...).Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", err
+	}
+	return string(output), nil
+}
+
+// selectDumpNodes resolves the control-plane and the named nodes via the cluster. If the cluster is not reachable,
+// the fallback nodes are used, provided no additional nodes were requested.
+func selectDumpNodes(kubectl commandKubectl, names []string, fallback ...dump.Node) ([]dump.Node, error) {
+	nodeList, err := kubectl.ExecWithOutput("get", "nodes", "-o", "json")
+	if err != nil {
+		if len(names) > 0 {
+			return nil, fmt.Errorf("failed to resolve nodes %v: %w", names, err)
+		}
+		slog.Warn("[System] Could not list cluster nodes, collecting diagnostics of local nodes only", "error", err)
+		return fallback, nil
+	}
+	return dump.SelectNodes([]byte(nodeList), names)
+}
+
+// dumpFiles returns the K2s config files and the log dir to include in the dump.
+func dumpFiles(installDir, configDir, logsDir string) []dump.File {
+	files := []dump.File{{Source: dump.SourceConfig, ArchiveDir: "config", Path: filepath.Join(installDir, "cfg", "config.json")}}
+
+	configFiles, _ := filepath.Glob(filepath.Join(configDir, "*.json"))
+	for _, configFile := range configFiles {
+		files = append(files, dump.File{Source: dump.SourceConfig, ArchiveDir: "config", Path: configFile})
+	}
+
+	if logsDir != "" {
+		files = append(files, dump.File{Source: dump.SourceLog, ArchiveDir: "logs", Path: logsDir})
+	}
+	return files
+}
+
+// encodedPowerShell returns the command line running the PowerShell command without quoting issues, e.g. via SSH.
+func encodedPowerShell(command string) []string {
+	script := utf16.Encode([]rune("& { " + command + " } 2>&1"))
+	encoded := make([]byte, 0, len(script)*2)
+	for _, char := range script {
+		encoded = append(encoded, byte(char), byte(char>>8))
+	}
+	return []string{"powershell", "-NoProfile", "-NonInteractive", "-EncodedCommand", base64.StdEncoding.EncodeToString(encoded)}
+}
+
+// mergedLinuxCommand redirects stderr of all commands of the line, e.g. to capture journalctl errors in the dump.
+func mergedLinuxCommand(command string) string {
+	return "{ " + command + "; } 2>&1"
+}
@@ -6,29 +6,102 @@
 package provider
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"log/slog"
+	"os"
 	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/core/certificates"
+	"github.com/siemens-healthineers/k2s/internal/core/doctor"
+	"github.com/siemens-healthineers/k2s/internal/core/dump"
+	k2sos "github.com/siemens-healthineers/k2s/internal/os"
+	"github.com/siemens-healthineers/k2s/internal/primitives/units"
+	"github.com/siemens-healthineers/k2s/internal/setuporchestration"
 )
 
 type linuxSystemProvider struct {
 	installDir string
+	configDir  string
+	stdWriter  k2sos.StdWriter
 }
 
 func newLinuxSystemProvider(cfg ProviderConfig) *linuxSystemProvider {
-	return &linuxSystemProvider{installDir: cfg.InstallDir}
+	return &linuxSystemProvider{installDir: cfg.InstallDir, configDir: cfg.ConfigDir, stdWriter: cfg.StdWriter}
 }
 
-func (p *linuxSystemProvider) Dump(cfg SystemDumpConfig) error {
+func (p *linuxSystemProvider) Dump(cfg SystemDumpConfig) (string, error) {
 	slog.Info("[System] Dumping cluster info")
-	cmd := exec.Command("kubectl", "cluster-info", "dump")
-	if cfg.OutputDir != "" {
-		cmd.Args = append(cmd.Args, "--output-directory="+cfg.OutputDir)
+
+	host, err := os.Hostname()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine host name: %w", err)
+	}
+
+	kubectl := commandKubectl{binary: "kubectl", baseArgs: linuxKubectlArgs()}
+	nodes, err := selectDumpNodes(kubectl, dump.ParseNodeNames(cfg.Nodes), dump.Node{Name: host, IpAddress: cfg.ControlPlaneIpAddress})
+	if err != nil {
+		return "", err
+	}
+
+	targetDir := cfg.OutputDir
+	if targetDir == "" {
+		targetDir = cfg.LogsDir
+	}
+
+	collector := dump.NewCollector(kubectl, linuxDumpShell{localNode: host}, time.Now)
+	return collector.Collect(dump.Options{
+		TargetDir: targetDir,
+		Host:      host,
+		Nodes:     nodes,
+		Files:     dumpFiles(p.installDir, p.configDir, cfg.LogsDir),
+	})
+}
+
+// linuxDumpShell runs the dump commands locally on the host node and via SSH on other nodes.
+type linuxDumpShell struct {
+	localNode string
+}
+
+func (s linuxDumpShell) Exec(node dump.Node, command string) (string, error) {
+	var cmd *exec.Cmd
+	switch {
+	case strings.EqualFold(node.Name, s.localNode):
+		cmd = exec.Command("bash", "-c", mergedLinuxCommand(command))
+	case node.Windows:
+		cmd = exec.Command("ssh", append(dumpSshArgs(node), encodedPowerShell(command)...)...)
+	default:
+		cmd = exec.Command("ssh", append(dumpSshArgs(node), mergedLinuxCommand(command))...)
+	}
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("command failed on node '%s': %w", node.Name, err)
 	}
-	cmd.Stdout = nil
-	cmd.Stderr = nil
-	return cmd.Run()
+	return string(output), nil
+}
+
+func dumpSshArgs(node dump.Node) []string {
+	return []string{"-o", "BatchMode=yes", "-o", "StrictHostKeyChecking=no", "-o", "UserKnownHostsFile=/dev/null", "-o", "ConnectTimeout=10",
+		fmt.Sprintf("%s@%s", sshUser, node.IpAddress)}
+}
+
+func (p *linuxSystemProvider) DoctorChecks(cfg SystemDoctorConfig) []doctor.Check {
+	return doctorChecks(doctorEnv{
+		kubectl: commandKubectl{binary: "kubectl", baseArgs: linuxKubectlArgs()},
+		controlPlane: func(command string) (string, error) {
+			output, err := exec.Command("bash", "-c", command).Output()
+			return string(output), err
+		},
+		proxyAddress:     "127.0.0.1:8181",
+		proxyRemediation: "Restart the proxy with 'sudo systemctl restart k2s-httpproxy'",
+		certificates: func() (*CertificateStatus, error) {
+			return p.CertificateStatus(SystemCertStatusConfig{ControlPlaneName: cfg.ControlPlaneName})
+		},
+	}, cfg)
 }
 
 func (p *linuxSystemProvider) Upgrade(_ SystemUpgradeConfig) error {
@@ -56,9 +129,39 @@ func (p *linuxSystemProvider) ResetNetwork(_ SystemResetNetworkConfig) error {
 	return nil
 }
 
-func (p *linuxSystemProvider) Compact(_ SystemCompactConfig) error {
-	return NotSupportedError("system compact",
-		"VHDX compaction is a Windows/Hyper-V operation; use 'qemu-img convert' to compact QCOW2 images")
+func (p *linuxSystemProvider) Compact(cfg SystemCompactConfig) error {
+	slog.Info("[System] Compacting VM disks")
+
+	compactConfig := setuporchestration.CompactConfig{NoRestart: cfg.NoRestart, ConfigDir: p.configDir}
+	if !cfg.Yes {
+		compactConfig.Confirm = p.confirm
+	}
+	result, err := setuporchestration.CompactWindowsWorker(compactConfig)
+	if err != nil {
+		return err
+	}
+	if result.Compaction == nil {
+		p.stdWriter.WriteStdOut("Compaction cancelled by user.")
+		return nil
+	}
+
+	before := result.Compaction.SizeBefore
+	saved := before - result.Compaction.SizeAfter
+	savedPercent := 0.0
+	if before > 0 {
+		savedPercent = float64(saved) * 100 / float64(before)
+	}
+	p.stdWriter.WriteStdOut(fmt.Sprintf("Compacted disk of VM '%s': %s", result.VMName, result.Compaction.Path))
+	p.stdWriter.WriteStdOut(fmt.Sprintf("Before: %s, after: %s, saved: %s (%.1f%%)",
+		units.BytesQuantity(before), units.BytesQuantity(result.Compaction.SizeAfter), units.BytesQuantity(saved), savedPercent))
+	return nil
+}
+
+// confirm asks a yes/no question on the terminal, like the confirmation prompts of the Windows scripts.
+func (p *linuxSystemProvider) confirm(question string) bool {
+	p.stdWriter.WriteStdOut(question + " (y/n)")
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	return strings.EqualFold(strings.TrimSpace(answer), "y")
 }
 
 func (p *linuxSystemProvider) Backup(_ SystemBackupConfig) error {
@@ -71,7 +174,68 @@ func (p *linuxSystemProvider) Restore(_ SystemRestoreConfig) error {
 		"cluster restore on Linux hosts is not yet implemented")
 }
 
-func (p *linuxSystemProvider) CertificateRenew(_ SystemCertRenewConfig) error {
+func (p *linuxSystemProvider) SnapshotCreate(cfg SystemSnapshotConfig) (*SystemSnapshot, error) {
+	snapshot, err := setuporchestration.CreateSnapshot(setuporchestration.SnapshotConfig{Name: cfg.Name, ConfigDir: p.configDir})
+	if err != nil {
+		return nil, err
+	}
+	result := toSystemSnapshot(*snapshot)
+	return &result, nil
+}
+
+func (p *linuxSystemProvider) SnapshotList() ([]SystemSnapshot, error) {
+	snapshots, err := setuporchestration.ListSnapshots(p.configDir)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SystemSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		result = append(result, toSystemSnapshot(snapshot))
+	}
+	return result, nil
+}
+
+func (p *linuxSystemProvider) SnapshotRestore(cfg SystemSnapshotConfig) (bool, error) {
+	snapshotConfig := setuporchestration.SnapshotConfig{Name: cfg.Name, ConfigDir: p.configDir}
+	if !cfg.Yes {
+		snapshotConfig.Confirm = p.confirm
+	}
+	return setuporchestration.RestoreSnapshot(snapshotConfig)
+}
+
+func (p *linuxSystemProvider) SnapshotDelete(cfg SystemSnapshotConfig) error {
+	return setuporchestration.DeleteSnapshot(setuporchestration.SnapshotConfig{Name: cfg.Name, ConfigDir: p.configDir})
+}
+
+func toSystemSnapshot(snapshot setuporchestration.Snapshot) SystemSnapshot {
+	return SystemSnapshot{
+		Name:      snapshot.Name,
+		CreatedAt: snapshot.CreatedAt,
+		Etcd:      snapshot.Etcd,
+		VMs:       snapshot.VMs,
+	}
+}
+
+func (p *linuxSystemProvider) CertificateRenew(cfg SystemCertRenewConfig) error {
+	if cfg.Force {
+		slog.Info("[System] Triggering forced certificate renewal")
+	} else {
+		output, err := exec.Command("kubeadm", "certs", "check-expiration", "-o", "json").Output()
+		if err != nil {
+			return fmt.Errorf("failed to check certificate expiration: %w", err)
+		}
+		kubeadmCerts, err := certificates.ParseKubeadmExpiration(output)
+		if err != nil {
+			return err
+		}
+		due := certificates.DueForRenewal(kubeadmCerts, cfg.Threshold, time.Now())
+		if len(due) == 0 {
+			slog.Info("[System] No certificate expires within the threshold, skipping renewal", "threshold", cfg.Threshold)
+			return nil
+		}
+		slog.Info("[System] Certificates expiring within the threshold", "threshold", cfg.Threshold, "certificates", due)
+	}
+
 	slog.Info("[System] Renewing Kubernetes certificates")
 	if err := exec.Command("kubeadm", "certs", "renew", "all").Run(); err != nil {
 		return err
@@ -108,6 +272,31 @@ func (p *linuxSystemProvider) CertificateRenew(_ SystemCertRenewConfig) error {
 	return nil
 }
 
+func (p *linuxSystemProvider) CertificateStatus(_ SystemCertStatusConfig) (*CertificateStatus, error) {
+	inventory := certificates.NewInventory(time.Now())
+
+	// the Linux host is the control-plane node
+	node, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine host name: %w", err)
+	}
+
+	output, err := exec.Command("bash", "-c", certificates.DumpScript(certificates.LinuxNodePaths...)).Output()
+	if err != nil {
+		inventory.Warn("Could not read control-plane certificates: %v", err)
+	} else {
+		inventory.AddDump(node, string(output))
+	}
+
+	collectClusterCertificates(inventory, "kubectl", linuxKubectlArgs()...)
+	collectUserCertificates(inventory, p.configDir)
+
+	return &CertificateStatus{
+		Certificates: inventory.Certificates(),
+		Warnings:     inventory.Warnings(),
+	}, nil
+}
+
 func (p *linuxSystemProvider) CertificateAutoRotation(cfg SystemCertAutoRotationConfig) error {
 	const kubeletConfigPath = "/var/lib/kubelet/config.yaml"
 
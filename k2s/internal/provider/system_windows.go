@@ -6,22 +6,39 @@
 package provider
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/siemens-healthineers/k2s/cmd/k2s/utils"
+	ssh_contracts "github.com/siemens-healthineers/k2s/internal/contracts/ssh"
+	"github.com/siemens-healthineers/k2s/internal/core/certificates"
+	"github.com/siemens-healthineers/k2s/internal/core/doctor"
+	"github.com/siemens-healthineers/k2s/internal/core/dump"
+	"github.com/siemens-healthineers/k2s/internal/definitions"
 	k2sos "github.com/siemens-healthineers/k2s/internal/os"
 	"github.com/siemens-healthineers/k2s/internal/powershell"
+	"github.com/siemens-healthineers/k2s/internal/providers/ssh"
 )
 
 type windowsSystemProvider struct {
 	installDir string
+	configDir  string
 	stdWriter  k2sos.StdWriter
 }
 
 func newWindowsSystemProvider(cfg ProviderConfig) *windowsSystemProvider {
 	return &windowsSystemProvider{
 		installDir: cfg.InstallDir,
+		configDir:  cfg.ConfigDir,
 		stdWriter:  cfg.StdWriter,
 	}
 }
@@ -38,19 +55,125 @@ func (p *windowsSystemProvider) execPS(psCmd string, params ...string) error {
 	return result.checkFailure()
 }
 
-func (p *windowsSystemProvider) Dump(cfg SystemDumpConfig) error {
-	psCmd := p.scriptPath("dump/dump.ps1")
-	var params string
-	if cfg.SkipOpenDump {
-		params += " -OpenDumpFolder `$false"
+func (p *windowsSystemProvider) Dump(cfg SystemDumpConfig) (string, error) {
+	slog.Info("[System] Dumping cluster info")
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine host name: %w", err)
 	}
-	if cfg.ShowOutput {
-		params += " -ShowLogs"
+	host := strings.ToLower(hostname)
+
+	kubectl := commandKubectl{binary: filepath.Join(p.installDir, "bin", "kube", "kubectl.exe")}
+	nodes, err := selectDumpNodes(kubectl, dump.ParseNodeNames(cfg.Nodes), dump.Node{Name: cfg.ControlPlaneName, IpAddress: cfg.ControlPlaneIpAddress})
+	if err != nil {
+		return "", err
+	}
+
+	// the Windows host is always collected locally, even if it has not joined the cluster
+	if !slices.ContainsFunc(nodes, func(node dump.Node) bool { return strings.EqualFold(node.Name, host) }) {
+		nodes = append(nodes, dump.Node{Name: host, Windows: true})
+	}
+
+	files := dumpFiles(p.installDir, p.configDir, cfg.LogsDir)
+
+	networkDir, err := os.MkdirTemp("", "k2s-network-dump-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create network dump dir: %w", err)
 	}
-	if cfg.Nodes != "" {
-		params += " -Nodes " + utils.EscapeWithSingleQuotes(cfg.Nodes)
+	defer os.RemoveAll(networkDir)
+
+	if err := powershell.ExecutePs(p.scriptPath("dump/network_dump.ps1")+" -DumpDir "+utils.EscapeWithSingleQuotes(networkDir), p.stdWriter); err != nil {
+		slog.Warn("[System] Network dump failed", "error", err)
+	} else {
+		files = append(files, dump.File{Source: dump.SourceNode, ArchiveDir: "nodes/" + host + "/network", Path: networkDir})
 	}
-	return powershell.ExecutePs(psCmd+params, p.stdWriter)
+
+	targetDir := cfg.OutputDir
+	if targetDir == "" {
+		targetDir = cfg.LogsDir
+	}
+
+	collector := dump.NewCollector(kubectl, windowsDumpShell{localNode: host, sshPrivateKeyPath: cfg.SshPrivateKeyPath}, time.Now)
+	archivePath, err := collector.Collect(dump.Options{
+		TargetDir: targetDir,
+		Host:      host,
+		Nodes:     nodes,
+		Files:     files,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if !cfg.SkipOpenDump {
+		if err := exec.Command("explorer.exe", "/select,", archivePath).Start(); err != nil {
+			slog.Warn("[System] Could not open dump folder", "error", err)
+		}
+	}
+	return archivePath, nil
+}
+
+// windowsDumpShell runs the dump commands locally on the Windows host node and via SSH on other nodes.
+type windowsDumpShell struct {
+	localNode         string
+	sshPrivateKeyPath string
+}
+
+func (s windowsDumpShell) Exec(node dump.Node, command string) (string, error) {
+	if strings.EqualFold(node.Name, s.localNode) {
+		commandLine := encodedPowerShell(command)
+		output, err := exec.Command(commandLine[0], commandLine[1:]...).Output()
+		if err != nil {
+			return "", fmt.Errorf("command failed on node '%s': %w", node.Name, err)
+		}
+		return string(output), nil
+	}
+
+	remoteCommand := mergedLinuxCommand(command)
+	if node.Windows {
+		remoteCommand = strings.Join(encodedPowerShell(command), " ")
+	}
+
+	var output bytes.Buffer
+	err := ssh.Exec(remoteCommand, ssh_contracts.ConnectionOptions{
+		RemoteUser:        definitions.SSHRemoteUser,
+		IpAddress:         node.IpAddress,
+		Port:              definitions.SSHDefaultPort,
+		SshPrivateKeyPath: s.sshPrivateKeyPath,
+		Timeout:           definitions.SSHDefaultTimeout,
+		StdOutWriter:      &output,
+	})
+	if err != nil {
+		return "", fmt.Errorf("command failed on node '%s': %w", node.Name, err)
+	}
+	return output.String(), nil
+}
+
+func (p *windowsSystemProvider) DoctorChecks(cfg SystemDoctorConfig) []doctor.Check {
+	return doctorChecks(doctorEnv{
+		kubectl: commandKubectl{binary: filepath.Join(p.installDir, "bin", "kube", "kubectl.exe")},
+		controlPlane: func(command string) (string, error) {
+			var output bytes.Buffer
+			err := ssh.Exec(command, ssh_contracts.ConnectionOptions{
+				RemoteUser:        definitions.SSHRemoteUser,
+				IpAddress:         cfg.ControlPlaneIpAddress,
+				Port:              definitions.SSHDefaultPort,
+				SshPrivateKeyPath: cfg.SshPrivateKeyPath,
+				Timeout:           definitions.SSHDefaultTimeout,
+				StdOutWriter:      &output,
+			})
+			return output.String(), err
+		},
+		proxyAddress:     "172.19.1.1:8181",
+		proxyRemediation: "Restart the proxy with 'Restart-Service httpproxy' in an elevated PowerShell",
+		certificates: func() (*CertificateStatus, error) {
+			return p.CertificateStatus(SystemCertStatusConfig{
+				ControlPlaneName:      cfg.ControlPlaneName,
+				ControlPlaneIpAddress: cfg.ControlPlaneIpAddress,
+				SshPrivateKeyPath:     cfg.SshPrivateKeyPath,
+			})
+		},
+	}, cfg)
 }
 
 func (p *windowsSystemProvider) Upgrade(cfg SystemUpgradeConfig) error {
@@ -184,18 +307,98 @@ func (p *windowsSystemProvider) Restore(cfg SystemRestoreConfig) error {
 	return powershell.ExecutePs(psCmd+params, p.stdWriter)
 }
 
+func (p *windowsSystemProvider) SnapshotCreate(_ SystemSnapshotConfig) (*SystemSnapshot, error) {
+	return nil, snapshotsNotSupported()
+}
+
+func (p *windowsSystemProvider) SnapshotList() ([]SystemSnapshot, error) {
+	return nil, snapshotsNotSupported()
+}
+
+func (p *windowsSystemProvider) SnapshotRestore(_ SystemSnapshotConfig) (bool, error) {
+	return false, snapshotsNotSupported()
+}
+
+func (p *windowsSystemProvider) SnapshotDelete(_ SystemSnapshotConfig) error {
+	return snapshotsNotSupported()
+}
+
+func snapshotsNotSupported() error {
+	return NotSupportedError("system snapshot",
+		"snapshots are available on native Linux hosts only; use 'k2s system backup' and 'k2s system restore' instead")
+}
+
 func (p *windowsSystemProvider) CertificateRenew(cfg SystemCertRenewConfig) error {
 	psCmd := p.scriptPath("certificate/renew.ps1")
 	var params []string
 	if cfg.Force {
 		params = append(params, "-Force")
 	}
+	params = append(params, "-ThresholdDays", strconv.Itoa(certificates.ThresholdDays(cfg.Threshold)))
 	if cfg.ShowOutput {
 		params = append(params, "-ShowLogs")
 	}
 	return p.execPS(psCmd, params...)
 }
 
+func (p *windowsSystemProvider) CertificateStatus(cfg SystemCertStatusConfig) (*CertificateStatus, error) {
+	inventory := certificates.NewInventory(time.Now())
+
+	var output bytes.Buffer
+	err := ssh.Exec(certificates.DumpScript(certificates.LinuxNodePaths...), ssh_contracts.ConnectionOptions{
+		RemoteUser:        definitions.SSHRemoteUser,
+		IpAddress:         cfg.ControlPlaneIpAddress,
+		Port:              definitions.SSHDefaultPort,
+		SshPrivateKeyPath: cfg.SshPrivateKeyPath,
+		Timeout:           definitions.SSHDefaultTimeout,
+		StdOutWriter:      &output,
+	})
+	if err != nil {
+		inventory.Warn("Could not read control-plane certificates: %v", err)
+	} else {
+		inventory.AddDump(cfg.ControlPlaneName, output.String())
+	}
+
+	p.collectHostKubeletCertificates(inventory)
+	collectClusterCertificates(inventory, filepath.Join(p.installDir, "bin", "kube", "kubectl.exe"))
+	collectUserCertificates(inventory, p.configDir)
+
+	return &CertificateStatus{
+		Certificates: inventory.Certificates(),
+		Warnings:     inventory.Warnings(),
+	}, nil
+}
+
+// collectHostKubeletCertificates adds the kubelet client and serving certificates of the Windows node, if any. The
+// serving certificate is the rotated one if server certificate rotation is enabled, else the self-signed one.
+func (p *windowsSystemProvider) collectHostKubeletCertificates(inventory *certificates.Inventory) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		inventory.Warn("Could not determine host name: %v", err)
+		return
+	}
+	node := strings.ToLower(hostname)
+
+	pkiDir := filepath.Join(os.Getenv("SystemDrive")+`\`, "var", "lib", "kubelet", "pki")
+	for _, candidates := range [][]string{
+		{"kubelet-client-current.pem"},
+		{"kubelet-server-current.pem", "kubelet.crt"},
+	} {
+		for _, name := range candidates {
+			certPath := filepath.Join(pkiDir, name)
+			cert, err := os.ReadFile(certPath)
+			if err != nil {
+				if !errors.Is(err, os.ErrNotExist) {
+					inventory.Warn("Could not read kubelet certificate '%s': %v", certPath, err)
+				}
+				continue
+			}
+			inventory.AddPEM(certificates.SourceKubelet, node, name, cert)
+			break
+		}
+	}
+}
+
 func (p *windowsSystemProvider) CertificateAutoRotation(cfg SystemCertAutoRotationConfig) error {
 	psCmd := p.scriptPath("certificate/autorotation.ps1")
 	var params []string
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"path/filepath"
+	"strings"
 
 	"github.com/siemens-healthineers/k2s/internal/contracts/config"
 	"github.com/siemens-healthineers/k2s/internal/os"
@@ -35,3 +36,19 @@ func (k *Kubectl) Exec(args ...string) error {
 	}
 	return nil
 }
+
+// ExecWithOutput executes kubectl and returns its standard output.
+func (k *Kubectl) ExecWithOutput(args ...string) (string, error) {
+	slog.Debug("Executing kubectl command with output")
+
+	var output strings.Builder
+	cmd := os.NewCmd(k.kubectlCmd).
+		WithArgs(args...).
+		WithStdOutWriter(func(msg string, _ ...any) { output.WriteString(msg + "\n") }).
+		WithStdErrWriter(slog.Error)
+
+	if err := cmd.Exec(); err != nil {
+		return "", fmt.Errorf("failed to execute kubectl command: %w", err)
+	}
+	return output.String(), nil
+}
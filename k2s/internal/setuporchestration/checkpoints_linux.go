@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+const installCheckpointsFileName = "install-checkpoints.json"
+
+// installStep is a checkpointed installation step; run must be idempotent so that a failed step can be repeated on
+// resume, undo reverts the step on rollback and is nil if there is nothing to revert.
+type installStep struct {
+	name string
+	run  func() error
+	undo func() error
+}
+
+// installCheckpoints is the progress of an installation that has not completed yet.
+type installCheckpoints struct {
+	CompletedSteps []string  `json:"completedSteps"`
+	FailedStep     string    `json:"failedStep,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+func checkpointsPath(configDir string) string {
+	return filepath.Join(configDir, installCheckpointsFileName)
+}
+
+// loadCheckpoints returns the checkpoints of an interrupted installation, nil if there are none.
+func loadCheckpoints(configDir string) (*installCheckpoints, error) {
+	data, err := os.ReadFile(checkpointsPath(configDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read installation checkpoints: %w", err)
+	}
+
+	var checkpoints installCheckpoints
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, fmt.Errorf("installation checkpoints at %s are corrupted: %w", checkpointsPath(configDir), err)
+	}
+	return &checkpoints, nil
+}
+
+func saveCheckpoints(configDir string, checkpoints *installCheckpoints) error {
+	checkpoints.UpdatedAt = time.Now().UTC()
+
+	data, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal installation checkpoints: %w", err)
+	}
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+	if err := os.WriteFile(checkpointsPath(configDir), data, 0600); err != nil {
+		return fmt.Errorf("write installation checkpoints: %w", err)
+	}
+	return nil
+}
+
+func removeCheckpoints(configDir string) error {
+	if err := os.Remove(checkpointsPath(configDir)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove installation checkpoints: %w", err)
+	}
+	return nil
+}
+
+// runInstallSteps runs the steps not completed yet in order and records a checkpoint after each of them. The
+// checkpoints are removed once all steps have completed.
+func runInstallSteps(configDir string, steps []installStep, checkpoints *installCheckpoints) error {
+	for _, step := range steps {
+		if slices.Contains(checkpoints.CompletedSteps, step.name) {
+			slog.Info("[Install] Skipping completed step", "step", step.name)
+			continue
+		}
+
+		slog.Info("[Install] Running step", "step", step.name)
+		if err := step.run(); err != nil {
+			checkpoints.FailedStep = step.name
+			checkpoints.Error = err.Error()
+			if saveErr := saveCheckpoints(configDir, checkpoints); saveErr != nil {
+				return errors.Join(err, saveErr)
+			}
+			return fmt.Errorf("installation step '%s' failed: %w; run 'k2s install --resume' to continue or 'k2s install --rollback' to undo the completed steps", step.name, err)
+		}
+
+		checkpoints.CompletedSteps = append(checkpoints.CompletedSteps, step.name)
+		checkpoints.FailedStep = ""
+		checkpoints.Error = ""
+		if err := saveCheckpoints(configDir, checkpoints); err != nil {
+			return err
+		}
+	}
+	return removeCheckpoints(configDir)
+}
+
+// rollbackInstallSteps undoes the completed steps and the failed step, which may have been applied partially, in
+// reverse order. Steps that could not be undone remain in the checkpoints so that the rollback can be repeated.
+func rollbackInstallSteps(configDir string, steps []installStep, checkpoints *installCheckpoints) error {
+	var errs []error
+	var remaining []string
+	var failedStep string
+	for _, step := range slices.Backward(steps) {
+		completed := slices.Contains(checkpoints.CompletedSteps, step.name)
+		if !completed && step.name != checkpoints.FailedStep {
+			continue
+		}
+		if step.undo == nil {
+			continue
+		}
+
+		slog.Info("[Install] Rolling back step", "step", step.name)
+		if err := step.undo(); err != nil {
+			errs = append(errs, fmt.Errorf("roll back step '%s': %w", step.name, err))
+			if completed {
+				remaining = append([]string{step.name}, remaining...)
+			} else {
+				failedStep = step.name
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		checkpoints.CompletedSteps = remaining
+		checkpoints.FailedStep = failedStep
+		checkpoints.Error = ""
+		if err := saveCheckpoints(configDir, checkpoints); err != nil {
+			errs = append(errs, err)
+		}
+		return errors.Join(errs...)
+	}
+	return removeCheckpoints(configDir)
+}
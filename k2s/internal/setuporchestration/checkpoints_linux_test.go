@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("install checkpoints", func() {
+	var configDir string
+	var calls []string
+	var failingRun string
+	var failingUndo string
+
+	newSteps := func(names ...string) []installStep {
+		var steps []installStep
+		for _, name := range names {
+			steps = append(steps, installStep{
+				name: name,
+				run: func() error {
+					calls = append(calls, "run "+name)
+					if name == failingRun {
+						return errors.New("oops")
+					}
+					return nil
+				},
+				undo: func() error {
+					calls = append(calls, "undo "+name)
+					if name == failingUndo {
+						return errors.New("stuck")
+					}
+					return nil
+				},
+			})
+		}
+		return steps
+	}
+
+	BeforeEach(func() {
+		configDir = GinkgoT().TempDir()
+		calls = nil
+		failingRun = ""
+		failingUndo = ""
+	})
+
+	Describe("loadCheckpoints", func() {
+		It("returns nil if there is no interrupted installation", func() {
+			checkpoints, err := loadCheckpoints(configDir)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpoints).To(BeNil())
+		})
+	})
+
+	Describe("runInstallSteps", func() {
+		It("runs all steps and removes the checkpoints on success", func() {
+			err := runInstallSteps(configDir, newSteps("a", "b", "c"), &installCheckpoints{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal([]string{"run a", "run b", "run c"}))
+			Expect(loadCheckpoints(configDir)).To(BeNil())
+		})
+
+		It("records the failed step and stops", func() {
+			failingRun = "b"
+
+			err := runInstallSteps(configDir, newSteps("a", "b", "c"), &installCheckpoints{})
+
+			Expect(err).To(MatchError(ContainSubstring("installation step 'b' failed: oops; run 'k2s install --resume'")))
+			Expect(calls).To(Equal([]string{"run a", "run b"}))
+
+			checkpoints, err := loadCheckpoints(configDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpoints.CompletedSteps).To(Equal([]string{"a"}))
+			Expect(checkpoints.FailedStep).To(Equal("b"))
+			Expect(checkpoints.Error).To(Equal("oops"))
+		})
+
+		It("resumes from the failed step", func() {
+			failingRun = "b"
+			Expect(runInstallSteps(configDir, newSteps("a", "b", "c"), &installCheckpoints{})).ToNot(Succeed())
+			checkpoints, err := loadCheckpoints(configDir)
+			Expect(err).ToNot(HaveOccurred())
+			calls = nil
+			failingRun = ""
+
+			Expect(runInstallSteps(configDir, newSteps("a", "b", "c"), checkpoints)).To(Succeed())
+
+			Expect(calls).To(Equal([]string{"run b", "run c"}))
+			Expect(loadCheckpoints(configDir)).To(BeNil())
+		})
+	})
+
+	Describe("rollbackInstallSteps", func() {
+		It("undoes the completed and the failed steps in reverse order", func() {
+			failingRun = "c"
+			Expect(runInstallSteps(configDir, newSteps("a", "b", "c", "d"), &installCheckpoints{})).ToNot(Succeed())
+			checkpoints, err := loadCheckpoints(configDir)
+			Expect(err).ToNot(HaveOccurred())
+			calls = nil
+
+			Expect(rollbackInstallSteps(configDir, newSteps("a", "b", "c", "d"), checkpoints)).To(Succeed())
+
+			Expect(calls).To(Equal([]string{"undo c", "undo b", "undo a"}))
+			Expect(loadCheckpoints(configDir)).To(BeNil())
+		})
+
+		It("skips steps without undo", func() {
+			steps := newSteps("a", "b")
+			steps[0].undo = nil
+
+			Expect(rollbackInstallSteps(configDir, steps, &installCheckpoints{CompletedSteps: []string{"a", "b"}})).To(Succeed())
+
+			Expect(calls).To(Equal([]string{"undo b"}))
+		})
+
+		It("keeps steps that could not be undone for another rollback", func() {
+			failingUndo = "b"
+
+			err := rollbackInstallSteps(configDir, newSteps("a", "b", "c"), &installCheckpoints{CompletedSteps: []string{"a", "b"}, FailedStep: "c"})
+
+			Expect(err).To(MatchError("roll back step 'b': stuck"))
+			Expect(calls).To(Equal([]string{"undo c", "undo b", "undo a"}))
+
+			checkpoints, err := loadCheckpoints(configDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpoints.CompletedSteps).To(Equal([]string{"b"}))
+			Expect(checkpoints.FailedStep).To(BeEmpty())
+		})
+	})
+})
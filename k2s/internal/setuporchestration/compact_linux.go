@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// windowsTrimCommand makes Windows report the free space of its system volume as unused, so that the QEMU disk
+// (with discard='unmap') deallocates it and the compaction can skip it.
+const windowsTrimCommand = `Optimize-Volume -DriveLetter C -ReTrim`
+
+// CompactWindowsWorker compacts the disk of the Windows worker VM, the only VM disk on Linux hosts. A running VM is
+// trimmed, stopped after confirmation and started again afterwards unless NoRestart is set; the native control plane
+// keeps running.
+func CompactWindowsWorker(cfg CompactConfig) (*CompactResult, error) {
+	worker := InstalledWindowsWorker(cfg.ConfigDir)
+	if worker == nil {
+		return nil, fmt.Errorf("no VM disk to compact: the control plane runs natively on the host and no Windows worker VM is installed")
+	}
+	result := &CompactResult{VMName: worker.VMName()}
+
+	vmManager := NewVMManager()
+	// fail before the VM is stopped on disks that must not be compacted
+	snapshots, err := vmManager.ListSnapshots(worker.VMName())
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshots) > 0 {
+		return nil, fmt.Errorf("disk of VM '%s' has %d snapshot(s), which compaction would drop; delete them with 'k2s system snapshot delete' first", worker.VMName(), len(snapshots))
+	}
+
+	running, err := vmManager.VMIsRunning(worker.VMName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine state of Windows worker VM: %w", err)
+	}
+
+	if running {
+		slog.Info("[Compact] Trimming Windows system volume", "vm", worker.VMName())
+		if err := sshExecOnVM(worker.IpAddress(), windowsTrimCommand); err != nil {
+			slog.Warn("[Compact] Could not trim Windows system volume, continuing with compaction", "error", err)
+		}
+
+		if cfg.Confirm != nil && !cfg.Confirm(fmt.Sprintf("Windows worker VM '%s' must be stopped for compaction. Stop it now?", worker.VMName())) {
+			slog.Info("[Compact] Compaction cancelled by user")
+			return result, nil
+		}
+		if err := stopWindowsWorker(worker); err != nil {
+			return nil, fmt.Errorf("failed to stop Windows worker: %w", err)
+		}
+	} else {
+		slog.Info("[Compact] Windows worker VM is stopped, skipping trim", "vm", worker.VMName())
+	}
+
+	compaction, compactErr := vmManager.CompactDisk(worker.VMName())
+	result.Compaction = compaction
+
+	if running && !cfg.NoRestart {
+		if err := startWindowsWorker(worker); err != nil {
+			return result, errors.Join(compactErr, fmt.Errorf("failed to restart Windows worker, start it with 'k2s start': %w", err))
+		}
+		result.Restarted = true
+	} else if running {
+		slog.Info("[Compact] Windows worker VM stays stopped, start it with 'k2s start'", "vm", worker.VMName())
+	}
+	return result, compactErr
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("disk compaction", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	Describe("allocatedSize", func() {
+		It("returns the bytes occupied on disk instead of the size of sparse files", func() {
+			path := filepath.Join(dir, "sparse.qcow2")
+			Expect(os.WriteFile(path, nil, 0600)).To(Succeed())
+			Expect(os.Truncate(path, 1<<30)).To(Succeed())
+
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(info.Size()).To(BeEquivalentTo(1 << 30))
+			Expect(allocatedSize(info)).To(BeNumerically("<", 1<<20))
+		})
+	})
+
+	Describe("checkFreeSpace", func() {
+		It("succeeds if the required space is available", func() {
+			Expect(checkFreeSpace(dir, 0)).To(Succeed())
+		})
+
+		It("fails if the required space is not available", func() {
+			err := checkFreeSpace(dir, math.MaxInt64)
+
+			Expect(err).To(MatchError(ContainSubstring("insufficient free space in " + dir)))
+		})
+	})
+
+	Describe("replaceDiskImage", func() {
+		It("swaps the new image in with the mode of the original", func() {
+			original := filepath.Join(dir, "disk.qcow2")
+			compacted := original + ".compact"
+			Expect(os.WriteFile(original, []byte("original"), 0600)).To(Succeed())
+			Expect(os.WriteFile(compacted, []byte("compacted"), 0644)).To(Succeed())
+			info, err := os.Stat(original)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(replaceDiskImage(compacted, original, info)).To(Succeed())
+
+			Expect(os.ReadFile(original)).To(Equal([]byte("compacted")))
+			Expect(compacted).ToNot(BeAnExistingFile())
+			replaced, err := os.Stat(original)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(replaced.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+	})
+})
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/core/config"
+)
+
+// Hook names of the Linux-host lifecycle operations; 'Before' hooks abort the operation on failure.
+const (
+	hookBeforeInstall   = "BeforeInstall"
+	hookAfterInstall    = "AfterInstall"
+	hookBeforeStart     = "BeforeStart"
+	hookAfterStart      = "AfterStart"
+	hookBeforeStop      = "BeforeStop"
+	hookAfterStop       = "AfterStop"
+	hookBeforeUninstall = "BeforeUninstall"
+	hookAfterUninstall  = "AfterUninstall"
+)
+
+const (
+	// localHooksDirName is the directory of hooks inside the install directory, like on Windows hosts.
+	localHooksDirName  = "LocalHooks"
+	defaultHookTimeout = 5 * time.Minute
+)
+
+var kubeconfigServerPattern = regexp.MustCompile(`(?m)^\s*server:\s*(\S+)\s*$`)
+
+// hookRunner executes the hooks of a lifecycle operation found in the local and the additional hooks directory.
+type hookRunner struct {
+	dirs        []string
+	installDir  string
+	configDir   string
+	clusterName string
+	timeout     time.Duration
+}
+
+// newHookRunner returns a runner for the hooks in '<installDir>/LocalHooks' and the additional hooks directory.
+func newHookRunner(installDir, configDir, additionalHooksDir, clusterName string) *hookRunner {
+	var dirs []string
+	if installDir != "" {
+		dirs = append(dirs, filepath.Join(installDir, localHooksDirName))
+	}
+	if additionalHooksDir != "" {
+		dirs = append(dirs, additionalHooksDir)
+	}
+
+	return &hookRunner{
+		dirs:        dirs,
+		installDir:  installDir,
+		configDir:   configDir,
+		clusterName: clusterName,
+		timeout:     defaultHookTimeout,
+	}
+}
+
+// environment returns the variables passed to the hook; the control-plane IP is empty before the control plane is
+// initialized.
+func (r *hookRunner) environment(hook string) []string {
+	return []string{
+		"K2S_HOOK_NAME=" + hook,
+		"K2S_CLUSTER_NAME=" + r.clusterName,
+		"K2S_CONTROL_PLANE_IP=" + controlPlaneIP(kubeconfigSrc),
+		"K2S_POD_NETWORK_CIDR=" + podNetworkCIDR,
+		"K2S_SERVICES_CIDR=" + servicesCIDR,
+		"K2S_KUBECONFIG=" + kubeconfigSrc,
+		"KUBECONFIG=" + kubeconfigSrc,
+		"K2S_INSTALL_DIR=" + r.installDir,
+		"K2S_CONFIG_DIR=" + r.configDir,
+	}
+}
+
+// runBefore runs the hooks of the given name and returns the first failure, which aborts the operation.
+func (r *hookRunner) runBefore(hook string) error {
+	if err := r.run(hook); err != nil {
+		return fmt.Errorf("'%s' hook failed: %w", hook, err)
+	}
+	return nil
+}
+
+// runAfter runs the hooks of the given name; failures are logged only since the operation has completed already.
+func (r *hookRunner) runAfter(hook string) {
+	if err := r.run(hook); err != nil {
+		slog.Warn("[Hooks] Hook failed", "hook", hook, "error", err)
+	}
+}
+
+func (r *hookRunner) run(hook string) error {
+	executed := 0
+	for _, dir := range r.dirs {
+		hooks, err := discoverHooks(dir, hook)
+		if err != nil {
+			return err
+		}
+		for _, hookPath := range hooks {
+			if err := r.execute(hook, hookPath); err != nil {
+				return err
+			}
+			executed++
+		}
+	}
+
+	if executed == 0 {
+		slog.Debug("[Hooks] No hooks found", "hook", hook)
+	}
+	return nil
+}
+
+func (r *hookRunner) execute(hook, hookPath string) error {
+	slog.Info("[Hooks] Executing hook", "hook", hook, "path", hookPath)
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	output := &hookLogWriter{path: hookPath}
+	cmd := exec.CommandContext(ctx, hookPath)
+	cmd.Dir = filepath.Dir(hookPath)
+	cmd.Env = append(os.Environ(), r.environment(hook)...)
+	cmd.Stdout = output
+	cmd.Stderr = output
+	// kill the whole process group on timeout so that child processes do not keep running
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	cmd.WaitDelay = 5 * time.Second
+
+	err := cmd.Run()
+	output.flush()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("hook %s timed out after %s", hookPath, r.timeout)
+	}
+	if err != nil {
+		return fmt.Errorf("hook %s failed: %w", hookPath, err)
+	}
+	return nil
+}
+
+// discoverHooks returns the executable files in the directory named like the hook, with or without extension, e.g.
+// 'BeforeStart' or 'BeforeStart.sh', in lexical order. A missing directory contains no hooks.
+func discoverHooks(dir, hook string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read hooks directory %s: %w", dir, err)
+	}
+
+	var hooks []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if name != hook && !strings.HasPrefix(name, hook+".") {
+			continue
+		}
+
+		hookPath := filepath.Join(dir, name)
+		info, err := os.Stat(hookPath)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		if info.Mode().Perm()&0111 == 0 {
+			slog.Warn("[Hooks] Skipping hook that is not executable", "path", hookPath)
+			continue
+		}
+		hooks = append(hooks, hookPath)
+	}
+	return hooks, nil
+}
+
+// controlPlaneIP returns the API server address of the kubeconfig, empty if there is no control plane yet.
+func controlPlaneIP(kubeconfigPath string) string {
+	data, err := os.ReadFile(kubeconfigPath)
+	if err != nil {
+		return ""
+	}
+	match := kubeconfigServerPattern.FindSubmatch(data)
+	if match == nil {
+		return ""
+	}
+	server, err := url.Parse(string(match[1]))
+	if err != nil {
+		return ""
+	}
+	return server.Hostname()
+}
+
+// runtimeClusterName returns the cluster name of the installed setup, empty if it cannot be determined.
+func runtimeClusterName(configDir string) string {
+	runtimeConfig, err := config.ReadRuntimeConfig(configDir)
+	if runtimeConfig == nil {
+		slog.Debug("[Hooks] Cluster name not available", "error", err)
+		return ""
+	}
+	return runtimeConfig.ClusterConfig().Name()
+}
+
+// hookLogWriter writes the output of a hook line by line into the K2s log.
+type hookLogWriter struct {
+	path    string
+	pending []byte
+}
+
+func (w *hookLogWriter) Write(data []byte) (int, error) {
+	w.pending = append(w.pending, data...)
+	for {
+		index := bytes.IndexByte(w.pending, '\n')
+		if index < 0 {
+			return len(data), nil
+		}
+		w.log(w.pending[:index])
+		w.pending = w.pending[index+1:]
+	}
+}
+
+func (w *hookLogWriter) flush() {
+	if len(w.pending) > 0 {
+		w.log(w.pending)
+		w.pending = nil
+	}
+}
+
+func (w *hookLogWriter) log(line []byte) {
+	slog.Info("[Hooks] Hook output", "hook", w.path, "line", strings.TrimRight(string(line), "\r"))
+}
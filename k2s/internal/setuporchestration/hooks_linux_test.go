@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("hooks", func() {
+	writeHook := func(dir, name, script string, mode os.FileMode) string {
+		Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+		hookPath := filepath.Join(dir, name)
+		Expect(os.WriteFile(hookPath, []byte("#!/bin/sh\n"+script+"\n"), mode)).To(Succeed())
+		return hookPath
+	}
+
+	Describe("discoverHooks", func() {
+		It("returns executable hooks named like the hook in lexical order", func() {
+			dir := GinkgoT().TempDir()
+			withExtension := writeHook(dir, "BeforeStart.sh", "true", 0755)
+			withoutExtension := writeHook(dir, "BeforeStart", "true", 0755)
+			writeHook(dir, "BeforeStartK8sNetwork.sh", "true", 0755)
+			writeHook(dir, "AfterStart.sh", "true", 0755)
+			writeHook(dir, "BeforeStart.txt", "true", 0644)
+			Expect(os.Mkdir(filepath.Join(dir, "BeforeStart.d"), 0755)).To(Succeed())
+
+			hooks, err := discoverHooks(dir, hookBeforeStart)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hooks).To(Equal([]string{withoutExtension, withExtension}))
+		})
+
+		It("returns no hooks for a missing directory", func() {
+			hooks, err := discoverHooks(filepath.Join(GinkgoT().TempDir(), "missing"), hookBeforeStart)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hooks).To(BeEmpty())
+		})
+	})
+
+	Describe("hookRunner", func() {
+		var installDir, additionalDir, resultFile string
+
+		BeforeEach(func() {
+			installDir = GinkgoT().TempDir()
+			additionalDir = GinkgoT().TempDir()
+			resultFile = filepath.Join(GinkgoT().TempDir(), "result")
+		})
+
+		It("runs local hooks before additional hooks with the cluster environment", func() {
+			writeHook(filepath.Join(installDir, localHooksDirName), "AfterInstall.sh", `echo "local $K2S_HOOK_NAME $K2S_CLUSTER_NAME $K2S_INSTALL_DIR" >> `+resultFile, 0755)
+			writeHook(additionalDir, "AfterInstall.sh", `echo "additional $K2S_KUBECONFIG $K2S_POD_NETWORK_CIDR" >> `+resultFile, 0755)
+
+			runner := newHookRunner(installDir, "/var/lib/k2s", additionalDir, "my-cluster")
+			runner.runAfter(hookAfterInstall)
+
+			content, err := os.ReadFile(resultFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(strings.Split(strings.TrimSpace(string(content)), "\n")).To(Equal([]string{
+				"local AfterInstall my-cluster " + installDir,
+				"additional " + kubeconfigSrc + " " + podNetworkCIDR,
+			}))
+		})
+
+		It("fails 'before' hooks and stops at the first failing hook", func() {
+			writeHook(additionalDir, "BeforeStop.1.sh", "echo failing; exit 3", 0755)
+			writeHook(additionalDir, "BeforeStop.2.sh", "echo second >> "+resultFile, 0755)
+
+			err := newHookRunner(installDir, "", additionalDir, "").runBefore(hookBeforeStop)
+
+			Expect(err).To(MatchError(And(ContainSubstring("'BeforeStop' hook failed"), ContainSubstring("exit status 3"))))
+			Expect(resultFile).ToNot(BeAnExistingFile())
+		})
+
+		It("does not fail on failing 'after' hooks", func() {
+			writeHook(additionalDir, "AfterStop.sh", "exit 1", 0755)
+
+			Expect(func() { newHookRunner(installDir, "", additionalDir, "").runAfter(hookAfterStop) }).ToNot(Panic())
+		})
+
+		It("kills hooks exceeding the timeout", func() {
+			writeHook(additionalDir, "BeforeStart.sh", "sleep 30", 0755)
+			runner := newHookRunner(installDir, "", additionalDir, "")
+			runner.timeout = 200 * time.Millisecond
+
+			start := time.Now()
+			err := runner.runBefore(hookBeforeStart)
+
+			Expect(err).To(MatchError(ContainSubstring("timed out after 200ms")))
+			Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+		})
+
+		It("succeeds without hooks", func() {
+			Expect(newHookRunner("", "", "", "").runBefore(hookBeforeInstall)).To(Succeed())
+		})
+	})
+
+	Describe("controlPlaneIP", func() {
+		It("returns the API server host of the kubeconfig", func() {
+			kubeconfig := filepath.Join(GinkgoT().TempDir(), "admin.conf")
+			Expect(os.WriteFile(kubeconfig, []byte("clusters:\n- cluster:\n    server: https://192.168.1.20:6443\n  name: kubernetes\n"), 0600)).To(Succeed())
+
+			Expect(controlPlaneIP(kubeconfig)).To(Equal("192.168.1.20"))
+		})
+
+		It("returns nothing without kubeconfig", func() {
+			Expect(controlPlaneIP(filepath.Join(GinkgoT().TempDir(), "admin.conf"))).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const osReleasePath = "/etc/os-release"
+
+type distroFamily string
+
+const (
+	debianFamily distroFamily = "debian"
+	rhelFamily   distroFamily = "rhel"
+)
+
+// packageManager adapts the package format and tooling of a host distribution family.
+type packageManager struct {
+	name             string
+	format           string
+	tools            []string
+	fileExtension    string
+	versionSeparator string
+}
+
+var (
+	aptPackageManager = packageManager{
+		name:             "apt",
+		format:           "Debian",
+		tools:            []string{"apt-get", "dpkg"},
+		fileExtension:    ".deb",
+		versionSeparator: "_",
+	}
+	dnfPackageManager = packageManager{
+		name:             "dnf",
+		format:           "RPM",
+		tools:            []string{"dnf", "rpm"},
+		fileExtension:    ".rpm",
+		versionSeparator: "-",
+	}
+)
+
+// isPackageFile returns whether the file name denotes a package of the given name and, unless empty, of the given
+// upstream version, e.g. 'kubeadm_1.35.0-1.1_amd64.deb' or 'kubeadm-1.35.0-150500.1.1.x86_64.rpm'.
+func (p packageManager) isPackageFile(file, name, version string) bool {
+	if !strings.HasSuffix(file, p.fileExtension) {
+		return false
+	}
+	rest, found := strings.CutPrefix(file, name+p.versionSeparator)
+	if !found || rest == "" || rest[0] < '0' || rest[0] > '9' {
+		return false
+	}
+	return version == "" || strings.HasPrefix(rest, version+"-")
+}
+
+// supportedHostDistro is a distribution release the native Linux installation has been validated on.
+type supportedHostDistro struct {
+	ids      []string
+	versions []string
+	family   distroFamily
+	// scripts is the directory below 'cfg/nodeextension' containing the provisioning scripts
+	scripts string
+}
+
+var supportedHostDistros = []supportedHostDistro{
+	{ids: []string{"debian"}, versions: []string{"13"}, family: debianFamily, scripts: "debian13"},
+	// Ubuntu shares the apt-based provisioning scripts of Debian 13
+	{ids: []string{"ubuntu"}, versions: []string{"22.04", "24.04"}, family: debianFamily, scripts: "debian13"},
+	{ids: []string{"rhel", "rocky", "almalinux", "centos"}, versions: []string{"9"}, family: rhelFamily, scripts: "rhel9"},
+}
+
+const supportedHostDistrosDescription = "Debian 13, Ubuntu 22.04/24.04 LTS and RHEL 9 compatible distributions (RHEL, Rocky Linux, AlmaLinux, CentOS Stream)"
+
+// hostDistro is the detected distribution of the Linux host.
+type hostDistro struct {
+	name    string
+	family  distroFamily
+	scripts string
+}
+
+// detectHostDistro identifies the host distribution from /etc/os-release.
+func detectHostDistro() (*hostDistro, error) {
+	file, err := os.Open(osReleasePath)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", osReleasePath, err)
+	}
+	defer file.Close()
+
+	release, err := parseOSRelease(file)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", osReleasePath, err)
+	}
+	return matchHostDistro(release)
+}
+
+// parseOSRelease parses the 'KEY=value' lines of an os-release file, removing quotes from the values.
+func parseOSRelease(reader io.Reader) (map[string]string, error) {
+	values := map[string]string{}
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		values[key] = strings.Trim(value, `"'`)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// matchHostDistro returns the supported distribution matching the os-release values. Derivatives not listed
+// explicitly are matched by their ID_LIKE entries, e.g. 'rhel' for RHEL rebuilds, if their version matches.
+func matchHostDistro(release map[string]string) (*hostDistro, error) {
+	id := release["ID"]
+	versionID := release["VERSION_ID"]
+	name := release["PRETTY_NAME"]
+	if name == "" {
+		name = strings.TrimSpace(id + " " + versionID)
+	}
+
+	candidates := append([]string{id}, strings.Fields(release["ID_LIKE"])...)
+	for _, candidate := range candidates {
+		for _, supported := range supportedHostDistros {
+			if !slices.Contains(supported.ids, candidate) {
+				continue
+			}
+			if !slices.ContainsFunc(supported.versions, func(version string) bool {
+				return versionID == version || strings.HasPrefix(versionID, version+".")
+			}) {
+				continue
+			}
+			return &hostDistro{name: name, family: supported.family, scripts: supported.scripts}, nil
+		}
+	}
+	return nil, fmt.Errorf("native Linux installation supports %s only; found %s", supportedHostDistrosDescription, name)
+}
+
+func (d *hostDistro) packageManager() packageManager {
+	if d.family == rhelFamily {
+		return dnfPackageManager
+	}
+	return aptPackageManager
+}
+
+// scriptPath returns the path of the provisioning script for this distribution inside the install directory.
+func (d *hostDistro) scriptPath(installDir, script string) (string, error) {
+	scriptPath := filepath.Join(installDir, "cfg", "nodeextension", d.scripts, "scripts", script)
+	if _, err := os.Stat(scriptPath); err != nil {
+		return "", fmt.Errorf("required %s provisioning script is missing at %s: %w", d.name, scriptPath, err)
+	}
+	return scriptPath, nil
+}
+
+// checkPrerequisites checks the distribution-specific host tools and the mandatory access control system.
+func (d *hostDistro) checkPrerequisites() error {
+	for _, bin := range d.packageManager().tools {
+		if _, err := exec.LookPath(bin); err != nil {
+			return fmt.Errorf("required host tool %q was not found in PATH: %w", bin, err)
+		}
+	}
+
+	switch d.family {
+	case rhelFamily:
+		if err := checkSELinux(); err != nil {
+			return err
+		}
+		warnActiveFirewall("firewalld")
+	default:
+		if err := checkAppArmor(); err != nil {
+			return err
+		}
+		warnActiveFirewall("ufw")
+	}
+	return nil
+}
+
+// checkSELinux rejects hosts with SELinux in enforcing mode, since kubeadm requires containers to access host paths
+// like the etcd data directory that are not labeled for container access.
+func checkSELinux() error {
+	if _, err := exec.LookPath("getenforce"); err != nil {
+		return nil
+	}
+	mode, err := runCommandOutput("getenforce")
+	if err != nil {
+		return fmt.Errorf("read SELinux mode: %w", err)
+	}
+	if strings.EqualFold(strings.TrimSpace(mode), "Enforcing") {
+		return fmt.Errorf("SELinux is in enforcing mode; switch to permissive mode before installing K2s ('setenforce 0' and SELINUX=permissive in /etc/selinux/config)")
+	}
+	slog.Info("[Install] SELinux mode", "mode", strings.TrimSpace(mode))
+	return nil
+}
+
+// checkAppArmor requires the AppArmor parser if AppArmor is enabled, since CRI-O loads its default container profile
+// with it.
+func checkAppArmor() error {
+	data, err := os.ReadFile("/sys/module/apparmor/parameters/enabled")
+	if err != nil || strings.TrimSpace(string(data)) != "Y" {
+		return nil
+	}
+	if _, err := exec.LookPath("apparmor_parser"); err != nil {
+		return fmt.Errorf("AppArmor is enabled but 'apparmor_parser' was not found in PATH; install the apparmor package so that CRI-O can load its container profile: %w", err)
+	}
+	slog.Info("[Install] AppArmor is enabled; CRI-O applies its default container profile")
+	return nil
+}
+
+func warnActiveFirewall(service string) {
+	if err := exec.Command("systemctl", "is-active", "--quiet", service).Run(); err != nil {
+		return
+	}
+	slog.Warn("[Install] Host firewall is active; make sure it allows the Kubernetes API server (6443/tcp), the kubelet (10250/tcp) and Flannel VXLAN (8472/udp)", "service", service)
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("host distribution", func() {
+	Describe("parseOSRelease", func() {
+		It("parses quoted and unquoted values and skips comments", func() {
+			release, err := parseOSRelease(strings.NewReader(`# comment
+PRETTY_NAME="Ubuntu 24.04.1 LTS"
+ID=ubuntu
+ID_LIKE=debian
+VERSION_ID='24.04'
+invalid line
+`))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(release).To(Equal(map[string]string{
+				"PRETTY_NAME": "Ubuntu 24.04.1 LTS",
+				"ID":          "ubuntu",
+				"ID_LIKE":     "debian",
+				"VERSION_ID":  "24.04",
+			}))
+		})
+	})
+
+	Describe("matchHostDistro", func() {
+		DescribeTable("detects supported distributions",
+			func(release map[string]string, expectedFamily distroFamily, expectedScripts string) {
+				distro, err := matchHostDistro(release)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(distro.family).To(Equal(expectedFamily))
+				Expect(distro.scripts).To(Equal(expectedScripts))
+			},
+			Entry("Debian 13", map[string]string{"ID": "debian", "VERSION_ID": "13"}, debianFamily, "debian13"),
+			Entry("Ubuntu 24.04", map[string]string{"ID": "ubuntu", "ID_LIKE": "debian", "VERSION_ID": "24.04"}, debianFamily, "debian13"),
+			Entry("Ubuntu 22.04", map[string]string{"ID": "ubuntu", "ID_LIKE": "debian", "VERSION_ID": "22.04"}, debianFamily, "debian13"),
+			Entry("RHEL 9", map[string]string{"ID": "rhel", "ID_LIKE": "fedora", "VERSION_ID": "9.4"}, rhelFamily, "rhel9"),
+			Entry("Rocky Linux 9", map[string]string{"ID": "rocky", "ID_LIKE": "rhel centos fedora", "VERSION_ID": "9.5"}, rhelFamily, "rhel9"),
+			Entry("CentOS Stream 9", map[string]string{"ID": "centos", "ID_LIKE": "rhel fedora", "VERSION_ID": "9"}, rhelFamily, "rhel9"),
+			Entry("RHEL 9 rebuild by ID_LIKE", map[string]string{"ID": "eurolinux", "ID_LIKE": "rhel fedora centos", "VERSION_ID": "9.2"}, rhelFamily, "rhel9"),
+		)
+
+		DescribeTable("rejects unsupported distributions",
+			func(release map[string]string) {
+				_, err := matchHostDistro(release)
+
+				Expect(err).To(MatchError(ContainSubstring("native Linux installation supports Debian 13, Ubuntu 22.04/24.04 LTS and RHEL 9")))
+			},
+			Entry("Debian 12", map[string]string{"ID": "debian", "VERSION_ID": "12", "PRETTY_NAME": "Debian GNU/Linux 12 (bookworm)"}),
+			Entry("Ubuntu interim release", map[string]string{"ID": "ubuntu", "ID_LIKE": "debian", "VERSION_ID": "24.10"}),
+			Entry("RHEL 8", map[string]string{"ID": "rhel", "VERSION_ID": "8.10"}),
+			Entry("RHEL 90 lookalike", map[string]string{"ID": "rhel", "VERSION_ID": "90"}),
+			Entry("Fedora", map[string]string{"ID": "fedora", "VERSION_ID": "41"}),
+			Entry("Ubuntu derivative with own versioning", map[string]string{"ID": "linuxmint", "ID_LIKE": "ubuntu debian", "VERSION_ID": "22"}),
+		)
+
+		It("names the found distribution in the error", func() {
+			_, err := matchHostDistro(map[string]string{"ID": "arch"})
+
+			Expect(err).To(MatchError(HaveSuffix("found arch")))
+		})
+	})
+
+	Describe("packageManager", func() {
+		It("uses apt for Debian-based and dnf for RHEL-based distributions", func() {
+			Expect((&hostDistro{family: debianFamily}).packageManager()).To(Equal(aptPackageManager))
+			Expect((&hostDistro{family: rhelFamily}).packageManager()).To(Equal(dnfPackageManager))
+		})
+
+		DescribeTable("isPackageFile",
+			func(packages packageManager, file, name, version string, expected bool) {
+				Expect(packages.isPackageFile(file, name, version)).To(Equal(expected))
+			},
+			Entry("deb of any version", aptPackageManager, "cri-o_1.35.1-1.1_amd64.deb", "cri-o", "", true),
+			Entry("deb of the required version", aptPackageManager, "kubeadm_1.35.0-1.1_amd64.deb", "kubeadm", "1.35.0", true),
+			Entry("deb of another version", aptPackageManager, "kubeadm_1.35.10-1.1_amd64.deb", "kubeadm", "1.35.1", false),
+			Entry("rpm for apt", aptPackageManager, "kubeadm-1.35.0-150500.1.1.x86_64.rpm", "kubeadm", "1.35.0", false),
+			Entry("rpm of the required version", dnfPackageManager, "kubeadm-1.35.0-150500.1.1.x86_64.rpm", "kubeadm", "1.35.0", true),
+			Entry("rpm of a package with a longer name", dnfPackageManager, "cri-o-selinux-1.0-1.noarch.rpm", "cri-o", "", false),
+			Entry("deb for dnf", dnfPackageManager, "cri-o_1.35.1-1.1_amd64.deb", "cri-o", "", false),
+		)
+	})
+
+	Describe("scriptPath", func() {
+		It("returns the script of the distribution's script directory", func() {
+			installDir := GinkgoT().TempDir()
+			scriptsDir := filepath.Join(installDir, "cfg", "nodeextension", "rhel9", "scripts")
+			Expect(os.MkdirAll(scriptsDir, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(scriptsDir, "install-k8s-packages.sh"), []byte{}, 0644)).To(Succeed())
+			distro := &hostDistro{name: "Rocky Linux 9.5", family: rhelFamily, scripts: "rhel9"}
+
+			scriptPath, err := distro.scriptPath(installDir, "install-k8s-packages.sh")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(scriptPath).To(Equal(filepath.Join(scriptsDir, "install-k8s-packages.sh")))
+		})
+
+		It("fails if the script is missing", func() {
+			distro := &hostDistro{name: "Rocky Linux 9.5", family: rhelFamily, scripts: "rhel9"}
+
+			_, err := distro.scriptPath(GinkgoT().TempDir(), "install-k8s-packages.sh")
+
+			Expect(err).To(MatchError(ContainSubstring("required Rocky Linux 9.5 provisioning script is missing")))
+		})
+	})
+})
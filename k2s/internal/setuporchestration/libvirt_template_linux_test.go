@@ -129,6 +129,7 @@ var _ = Describe("loadLibvirtTemplateFromDir", Ordered, func() {
 			data := domainTemplateData{
 				Name:          "k2s-win-test",
 				MemoryKB:      4194304,
+				CurrentMemoryKB: 4194304,
 				CPUCount:      2,
 				DiskPath:      "/var/lib/k2s/images/win.qcow2",
 				NetworkBridge:  "k2s",
@@ -140,12 +141,34 @@ var _ = Describe("loadLibvirtTemplateFromDir", Ordered, func() {
 			rendered := buf.String()
 			Expect(rendered).To(ContainSubstring("<name>k2s-win-test</name>"))
 			Expect(rendered).To(ContainSubstring("<memory unit='KiB'>4194304</memory>"))
+			Expect(rendered).To(ContainSubstring("<currentMemory unit='KiB'>4194304</currentMemory>"))
+			Expect(rendered).To(ContainSubstring("<memballoon model='virtio'/>"))
 			Expect(rendered).To(ContainSubstring("<vcpu placement='static'>2</vcpu>"))
 			Expect(rendered).To(ContainSubstring("/var/lib/k2s/images/win.qcow2"))
 			Expect(rendered).To(ContainSubstring("network='k2s'"))
 			Expect(rendered).To(ContainSubstring("OVMF_CODE.fd"))
 		})
 
+		It("renders dynamic memory with the balloon driver returning free memory to the host", func() {
+			tmpl, err := loadLibvirtTemplateFromDir(GinkgoT().TempDir(), "domain.xml.tmpl", domainXMLTemplate)
+			Expect(err).ToNot(HaveOccurred())
+
+			var buf bytes.Buffer
+			data := domainTemplateData{
+				Name:            "k2s-win-test",
+				MemoryKB:        8388608,
+				CurrentMemoryKB: 4194304,
+				DynamicMemory:   true,
+				CPUCount:        2,
+			}
+			Expect(tmpl.Execute(&buf, data)).To(Succeed())
+
+			rendered := buf.String()
+			Expect(rendered).To(ContainSubstring("<memory unit='KiB'>8388608</memory>"))
+			Expect(rendered).To(ContainSubstring("<currentMemory unit='KiB'>4194304</currentMemory>"))
+			Expect(rendered).To(ContainSubstring("<memballoon model='virtio' autodeflate='on' freePageReporting='on'/>"))
+		})
+
 		It("parses the embedded network XML template without error", func() {
 			nonExistentDir := filepath.Join(GinkgoT().TempDir(), "no-such-dir")
 
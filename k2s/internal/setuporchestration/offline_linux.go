@@ -0,0 +1,319 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"maps"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Layout of the offline package set for air-gapped Linux-host installations:
+//
+//	linux-offline/
+//	  SHA256SUMS   checksums of all other files in 'sha256sum' format with relative paths
+//	  k8s/         Kubernetes, CRI-O and cri-tools packages of the host's package format incl. dependencies
+//	  buildah/     buildah packages incl. dependencies (only required if buildah is not installed)
+//	  images/      control-plane and Flannel images as OCI archives
+const (
+	offlinePackageSetName     = "linux-offline"
+	offlinePackageArchiveName = offlinePackageSetName + ".tar.gz"
+	offlineChecksumsFileName  = "SHA256SUMS"
+	offlineK8sPackagesDir     = "k8s"
+	offlineBuildahPackagesDir = "buildah"
+	offlineImagesDir          = "images"
+)
+
+var (
+	checksumLinePattern  = regexp.MustCompile(`^([0-9a-fA-F]{64}) [ *](.+)$`)
+	manifestImagePattern = regexp.MustCompile(`(?m)^\s*image:\s*["']?([^\s"']+)["']?\s*$`)
+
+	// requiredOfflinePackages are the packages that must be part of the offline package set, versioned ones in the
+	// Kubernetes version.
+	requiredOfflinePackages  = []string{"cri-o", "cri-tools", "kubectl", "kubelet", "kubeadm"}
+	versionedOfflinePackages = []string{"kubectl", "kubelet", "kubeadm"}
+)
+
+// locateOfflinePackageSet returns the pre-staged package set directory inside the install directory, or the
+// offline package archive if no directory is staged; both are empty if neither exists.
+func locateOfflinePackageSet(installDir string) (dir string, archive string) {
+	dir = filepath.Join(installDir, "bin", offlinePackageSetName)
+	if info, err := os.Stat(dir); err == nil && info.IsDir() {
+		return dir, ""
+	}
+
+	archive = filepath.Join(installDir, "bin", offlinePackageArchiveName)
+	if info, err := os.Stat(archive); err == nil && !info.IsDir() {
+		return "", archive
+	}
+	return "", ""
+}
+
+// stageOfflinePackageSet returns the directory of the offline package set, extracting the offline package archive
+// into the config directory if required; found is false if neither a staged directory nor an archive exists.
+func stageOfflinePackageSet(cfg InstallConfig) (dir string, found bool, err error) {
+	dir, archive := locateOfflinePackageSet(cfg.InstallDir)
+	if dir != "" {
+		return dir, true, nil
+	}
+	if archive == "" {
+		return "", false, nil
+	}
+
+	dir = filepath.Join(cfg.ConfigDir, offlinePackageSetName)
+	if err := os.RemoveAll(dir); err != nil {
+		return "", false, fmt.Errorf("clean offline package directory: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", false, fmt.Errorf("create offline package directory: %w", err)
+	}
+
+	slog.Info("[Install] Extracting offline package", "archive", archive, "target", dir)
+	if err := runCommand("tar", "--extract", "--gzip", "--no-same-owner", "--file", archive, "--directory", dir); err != nil {
+		return "", false, fmt.Errorf("extract offline package %s: %w", archive, err)
+	}
+
+	// archives may contain the set directory itself instead of its content
+	nested := filepath.Join(dir, offlinePackageSetName)
+	if _, err := os.Stat(filepath.Join(nested, offlineChecksumsFileName)); err == nil {
+		return nested, true, nil
+	}
+	return dir, true, nil
+}
+
+// verifyOfflinePackageSet checks that every file of the package set is listed in the checksum file with a matching
+// SHA-256 checksum, and that the packages of the given Kubernetes version in the format of the package manager as
+// well as images are contained.
+func verifyOfflinePackageSet(dir, k8sVersion string, packages packageManager) error {
+	checksums, err := readChecksums(filepath.Join(dir, offlineChecksumsFileName))
+	if err != nil {
+		return err
+	}
+
+	err = filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if relPath == offlineChecksumsFileName {
+			return nil
+		}
+		if _, listed := checksums[relPath]; !listed {
+			return fmt.Errorf("file '%s' is not listed in %s", relPath, offlineChecksumsFileName)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("offline package set at %s is invalid: %w", dir, err)
+	}
+
+	for _, relPath := range slices.Sorted(maps.Keys(checksums)) {
+		expected := checksums[relPath]
+		actual, err := fileChecksum(filepath.Join(dir, filepath.FromSlash(relPath)))
+		if err != nil {
+			return fmt.Errorf("offline package set at %s is incomplete: %w", dir, err)
+		}
+		if actual != expected {
+			return fmt.Errorf("checksum mismatch for '%s' in offline package set at %s: expected %s, got %s", relPath, dir, expected, actual)
+		}
+	}
+
+	return checkOfflinePackageContent(checksums, k8sVersion, packages)
+}
+
+func checkOfflinePackageContent(checksums map[string]string, k8sVersion string, packages packageManager) error {
+	upstreamVersion := strings.TrimPrefix(k8sVersion, "v")
+
+	var missing []string
+	for _, required := range requiredOfflinePackages {
+		version := ""
+		if slices.Contains(versionedOfflinePackages, required) {
+			version = upstreamVersion
+		}
+		found := false
+		for relPath := range checksums {
+			if dir, file := path.Split(relPath); dir == offlineK8sPackagesDir+"/" && packages.isPackageFile(file, required, version) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, required)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("offline package set does not contain the %s packages for Kubernetes %s: %s", packages.format, k8sVersion, strings.Join(missing, ", "))
+	}
+
+	if len(offlineImageArchives(checksums)) == 0 {
+		return fmt.Errorf("offline package set does not contain any images in '%s'", offlineImagesDir)
+	}
+	return nil
+}
+
+// offlineImageArchives returns the sorted relative paths of the image archives listed in the checksums.
+func offlineImageArchives(checksums map[string]string) []string {
+	var archives []string
+	for relPath := range checksums {
+		if dir, file := path.Split(relPath); dir == offlineImagesDir+"/" && strings.HasSuffix(file, ".tar") {
+			archives = append(archives, relPath)
+		}
+	}
+	slices.Sort(archives)
+	return archives
+}
+
+// readChecksums parses a 'sha256sum' file into checksums by slash-separated relative path.
+func readChecksums(checksumsPath string) (map[string]string, error) {
+	file, err := os.Open(checksumsPath)
+	if err != nil {
+		return nil, fmt.Errorf("read offline package checksums: %w", err)
+	}
+	defer file.Close()
+
+	checksums := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		match := checksumLinePattern.FindStringSubmatch(line)
+		if match == nil {
+			return nil, fmt.Errorf("invalid checksum entry in %s at line %d", checksumsPath, lineNumber)
+		}
+
+		relPath := path.Clean(strings.TrimPrefix(match[2], "./"))
+		if path.IsAbs(relPath) || relPath == ".." || strings.HasPrefix(relPath, "../") {
+			return nil, fmt.Errorf("checksum entry in %s at line %d points outside of the package set: %s", checksumsPath, lineNumber, match[2])
+		}
+		checksums[relPath] = strings.ToLower(match[1])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read offline package checksums: %w", err)
+	}
+	if len(checksums) == 0 {
+		return nil, fmt.Errorf("offline package checksums in %s are empty", checksumsPath)
+	}
+	return checksums, nil
+}
+
+func fileChecksum(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("compute checksum of %s: %w", filePath, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// preloadOfflineImages imports the image archives of the offline package set into the containers storage shared
+// with CRI-O and verifies that all images required by kubeadm and Flannel are available afterwards.
+func (o *LinuxOrchestrator) preloadOfflineImages(cfg InstallConfig, distro *hostDistro, dir, k8sVersion string) error {
+	if _, err := exec.LookPath("buildah"); err != nil {
+		installScript, err := distro.scriptPath(cfg.InstallDir, "install-buildah-packages.sh")
+		if err != nil {
+			return err
+		}
+		packagesDir := filepath.Join(dir, offlineBuildahPackagesDir)
+		if _, err := os.Stat(packagesDir); err != nil {
+			return fmt.Errorf("buildah is not installed and the offline package set does not contain '%s': %w", offlineBuildahPackagesDir, err)
+		}
+
+		slog.Info("[Install] Installing buildah packages from offline package set")
+		if err := runCommandWithLogs(cfg.ShowLogs, "bash", installScript, packagesDir); err != nil {
+			return fmt.Errorf("install buildah packages: %w", err)
+		}
+	}
+
+	checksums, err := readChecksums(filepath.Join(dir, offlineChecksumsFileName))
+	if err != nil {
+		return err
+	}
+	for _, relPath := range offlineImageArchives(checksums) {
+		slog.Info("[Install] Loading image from offline package set", "archive", relPath)
+		if err := runCommand("buildah", "pull", "oci-archive:"+filepath.Join(dir, filepath.FromSlash(relPath))); err != nil {
+			return fmt.Errorf("load image archive '%s': %w", relPath, err)
+		}
+	}
+
+	required, err := requiredImages(cfg.InstallDir, k8sVersion)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, image := range required {
+		if err := runCommand("crictl", "--runtime-endpoint", crioSocket, "inspecti", image); err != nil {
+			missing = append(missing, image)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("offline package set does not provide the required images: %s", strings.Join(missing, ", "))
+	}
+
+	slog.Info("[Install] Images preloaded into CRI-O", "count", len(required))
+	return nil
+}
+
+// requiredImages returns the control-plane images of the given Kubernetes version and the Flannel images.
+func requiredImages(installDir, k8sVersion string) ([]string, error) {
+	output, err := runCommandOutput("kubeadm", "config", "images", "list", "--kubernetes-version", k8sVersion)
+	if err != nil {
+		return nil, fmt.Errorf("list control-plane images: %w", err)
+	}
+	images := strings.Fields(output)
+
+	templatePath := filepath.Join(installDir, flannelTemplateRelPath)
+	template, err := os.ReadFile(templatePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read flannel template from %s: %w", templatePath, err)
+	}
+	for _, image := range manifestImages(template) {
+		if !slices.Contains(images, image) {
+			images = append(images, image)
+		}
+	}
+	return images, nil
+}
+
+// manifestImages returns the distinct container images referenced in a Kubernetes manifest in order of appearance.
+func manifestImages(manifest []byte) []string {
+	var images []string
+	for _, match := range manifestImagePattern.FindAllSubmatch(manifest, -1) {
+		image := string(match[1])
+		if !slices.Contains(images, image) {
+			images = append(images, image)
+		}
+	}
+	return images
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("offline package set", func() {
+	const k8sVersion = "v1.35.0"
+
+	var packageFiles map[string]string
+
+	// writePackageSet writes the given files and a matching checksum file
+	writePackageSet := func(files map[string]string) string {
+		dir := GinkgoT().TempDir()
+		var checksums strings.Builder
+		for relPath, content := range files {
+			filePath := filepath.Join(dir, filepath.FromSlash(relPath))
+			Expect(os.MkdirAll(filepath.Dir(filePath), 0755)).To(Succeed())
+			Expect(os.WriteFile(filePath, []byte(content), 0644)).To(Succeed())
+
+			hash := sha256.Sum256([]byte(content))
+			fmt.Fprintf(&checksums, "%s  %s\n", hex.EncodeToString(hash[:]), relPath)
+		}
+		Expect(os.WriteFile(filepath.Join(dir, offlineChecksumsFileName), []byte(checksums.String()), 0644)).To(Succeed())
+		return dir
+	}
+
+	BeforeEach(func() {
+		packageFiles = map[string]string{
+			"k8s/cri-o_1.35.1-1.1_amd64.deb":     "cri-o",
+			"k8s/cri-tools_1.35.0-1.1_amd64.deb": "cri-tools",
+			"k8s/kubectl_1.35.0-1.1_amd64.deb":   "kubectl",
+			"k8s/kubelet_1.35.0-1.1_amd64.deb":   "kubelet",
+			"k8s/kubeadm_1.35.0-1.1_amd64.deb":   "kubeadm",
+			"k8s/conntrack_1.4.8-2_amd64.deb":    "conntrack",
+			"images/kube-apiserver.tar":          "kube-apiserver",
+		}
+	})
+
+	Describe("locateOfflinePackageSet", func() {
+		It("prefers the staged directory over the archive", func() {
+			installDir := GinkgoT().TempDir()
+			Expect(os.MkdirAll(filepath.Join(installDir, "bin", offlinePackageSetName), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(installDir, "bin", offlinePackageArchiveName), []byte{}, 0644)).To(Succeed())
+
+			dir, archive := locateOfflinePackageSet(installDir)
+
+			Expect(dir).To(Equal(filepath.Join(installDir, "bin", offlinePackageSetName)))
+			Expect(archive).To(BeEmpty())
+		})
+
+		It("returns the archive if no directory is staged", func() {
+			installDir := GinkgoT().TempDir()
+			Expect(os.MkdirAll(filepath.Join(installDir, "bin"), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(installDir, "bin", offlinePackageArchiveName), []byte{}, 0644)).To(Succeed())
+
+			dir, archive := locateOfflinePackageSet(installDir)
+
+			Expect(dir).To(BeEmpty())
+			Expect(archive).To(Equal(filepath.Join(installDir, "bin", offlinePackageArchiveName)))
+		})
+
+		It("returns nothing if neither exists", func() {
+			dir, archive := locateOfflinePackageSet(GinkgoT().TempDir())
+
+			Expect(dir).To(BeEmpty())
+			Expect(archive).To(BeEmpty())
+		})
+	})
+
+	Describe("verifyOfflinePackageSet", func() {
+		It("accepts a complete package set with matching checksums", func() {
+			dir := writePackageSet(packageFiles)
+
+			Expect(verifyOfflinePackageSet(dir, k8sVersion, aptPackageManager)).To(Succeed())
+		})
+
+		It("fails on checksum mismatch", func() {
+			dir := writePackageSet(packageFiles)
+			Expect(os.WriteFile(filepath.Join(dir, "k8s", "kubelet_1.35.0-1.1_amd64.deb"), []byte("tampered"), 0644)).To(Succeed())
+
+			err := verifyOfflinePackageSet(dir, k8sVersion, aptPackageManager)
+
+			Expect(err).To(MatchError(ContainSubstring("checksum mismatch for 'k8s/kubelet_1.35.0-1.1_amd64.deb'")))
+		})
+
+		It("fails on files not listed in the checksums", func() {
+			dir := writePackageSet(packageFiles)
+			Expect(os.WriteFile(filepath.Join(dir, "k8s", "extra_1.0_amd64.deb"), []byte("extra"), 0644)).To(Succeed())
+
+			err := verifyOfflinePackageSet(dir, k8sVersion, aptPackageManager)
+
+			Expect(err).To(MatchError(ContainSubstring("file 'k8s/extra_1.0_amd64.deb' is not listed in SHA256SUMS")))
+		})
+
+		It("fails on listed files that are missing", func() {
+			dir := writePackageSet(packageFiles)
+			Expect(os.Remove(filepath.Join(dir, "images", "kube-apiserver.tar"))).To(Succeed())
+
+			err := verifyOfflinePackageSet(dir, k8sVersion, aptPackageManager)
+
+			Expect(err).To(MatchError(ContainSubstring("is incomplete")))
+		})
+
+		It("fails if the Kubernetes packages do not match the required version", func() {
+			delete(packageFiles, "k8s/kubeadm_1.35.0-1.1_amd64.deb")
+			packageFiles["k8s/kubeadm_1.34.2-1.1_amd64.deb"] = "kubeadm"
+			dir := writePackageSet(packageFiles)
+
+			err := verifyOfflinePackageSet(dir, k8sVersion, aptPackageManager)
+
+			Expect(err).To(MatchError("offline package set does not contain the Debian packages for Kubernetes v1.35.0: kubeadm"))
+		})
+
+		It("accepts a package set in the RPM format for dnf-based hosts", func() {
+			dir := writePackageSet(map[string]string{
+				"k8s/cri-o-1.35.1-150500.1.1.x86_64.rpm":     "cri-o",
+				"k8s/cri-tools-1.35.0-150500.1.1.x86_64.rpm": "cri-tools",
+				"k8s/kubectl-1.35.0-150500.1.1.x86_64.rpm":   "kubectl",
+				"k8s/kubelet-1.35.0-150500.1.1.x86_64.rpm":   "kubelet",
+				"k8s/kubeadm-1.35.0-150500.1.1.x86_64.rpm":   "kubeadm",
+				"images/kube-apiserver.tar":                  "kube-apiserver",
+			})
+
+			Expect(verifyOfflinePackageSet(dir, k8sVersion, dnfPackageManager)).To(Succeed())
+		})
+
+		It("fails if the packages do not match the host's package format", func() {
+			dir := writePackageSet(packageFiles)
+
+			err := verifyOfflinePackageSet(dir, k8sVersion, dnfPackageManager)
+
+			Expect(err).To(MatchError("offline package set does not contain the RPM packages for Kubernetes v1.35.0: cri-o, cri-tools, kubectl, kubelet, kubeadm"))
+		})
+
+		It("fails if no images are contained", func() {
+			delete(packageFiles, "images/kube-apiserver.tar")
+			dir := writePackageSet(packageFiles)
+
+			err := verifyOfflinePackageSet(dir, k8sVersion, aptPackageManager)
+
+			Expect(err).To(MatchError("offline package set does not contain any images in 'images'"))
+		})
+
+		It("fails on checksum entries pointing outside of the package set", func() {
+			dir := writePackageSet(packageFiles)
+			Expect(os.WriteFile(filepath.Join(dir, offlineChecksumsFileName), []byte(strings.Repeat("a", 64)+"  ../etc/passwd\n"), 0644)).To(Succeed())
+
+			err := verifyOfflinePackageSet(dir, k8sVersion, aptPackageManager)
+
+			Expect(err).To(MatchError(ContainSubstring("points outside of the package set")))
+		})
+	})
+
+	Describe("manifestImages", func() {
+		It("returns distinct images in order of appearance", func() {
+			manifest := `containers:
+  - name: kube-flannel
+    image: docker.io/flannel/flannel:0.28.9
+initContainers:
+  - name: install-cni-plugin
+    image: "docker.io/flannel/flannel-cni-plugin:v1.9.1-flannel3"
+  - name: install-cni
+    image: docker.io/flannel/flannel:0.28.9
+`
+
+			Expect(manifestImages([]byte(manifest))).To(Equal([]string{
+				"docker.io/flannel/flannel:0.28.9",
+				"docker.io/flannel/flannel-cni-plugin:v1.9.1-flannel3",
+			}))
+		})
+	})
+})
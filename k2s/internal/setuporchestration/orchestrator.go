@@ -3,6 +3,12 @@
 
 package setuporchestration
 
+import (
+	"time"
+
+	"github.com/siemens-healthineers/k2s/internal/core/kubeadmconfig"
+)
+
 // Orchestrator is the platform abstraction for cluster lifecycle operations.
 // Each host operating system provides its own implementation.
 type Orchestrator interface {
@@ -42,6 +48,29 @@ type VMManager interface {
 
 	// VMIsRunning checks whether a VM with the given name is currently running.
 	VMIsRunning(name string) (bool, error)
+
+	// ValidateUpdate checks the resource changes of UpdateVM without applying them, so that invalid changes are
+	// rejected before the virtual machine is stopped.
+	ValidateUpdate(config VMConfig) error
+
+	// UpdateVM changes the resources of a stopped virtual machine; zero values keep the current setting. If a
+	// change fails, the previous resources are restored.
+	UpdateVM(config VMConfig) error
+
+	// CreateSnapshot records the disk state of a stopped virtual machine under the given name.
+	CreateSnapshot(vmName, snapshotName string) error
+
+	// ListSnapshots returns the names of the disk snapshots of a virtual machine.
+	ListSnapshots(vmName string) ([]string, error)
+
+	// RevertSnapshot resets the disk of a stopped virtual machine to the named snapshot.
+	RevertSnapshot(vmName, snapshotName string) error
+
+	// DeleteSnapshot removes the named disk snapshot of a virtual machine.
+	DeleteSnapshot(vmName, snapshotName string) error
+
+	// CompactDisk rewrites the disk image of a stopped virtual machine without its unused space.
+	CompactDisk(vmName string) (*DiskCompaction, error)
 }
 
 // ServiceManager abstracts host-level service management.
@@ -65,6 +94,9 @@ type ServiceManager interface {
 
 	// RemoveService uninstalls a named service.
 	RemoveService(name string) error
+
+	// Status returns the state of a named service including its restart count and last error.
+	Status(name string) (*ServiceStatus, error)
 }
 
 // InstallConfig holds parameters for cluster installation.
@@ -85,6 +117,11 @@ type InstallConfig struct {
 	Version                 string // K2s version string
 	ClusterName             string // Kubernetes cluster name
 	ControlPlaneHostname    string // hostname of the control plane node
+	Resume                  bool   // continue an interrupted installation from the failed step (Linux only)
+	Rollback                bool   // undo the completed steps of an interrupted installation (Linux only)
+	WindowsWorker           bool   // provision a Windows worker VM via libvirt/KVM (Linux only)
+	// Kubeadm is merged into the generated kubeadm and kubelet configuration (Linux only)
+	Kubeadm *kubeadmconfig.Customization
 }
 
 // UninstallConfig holds parameters for cluster uninstallation.
@@ -94,6 +131,7 @@ type UninstallConfig struct {
 	DeleteFilesForOfflineInstallation bool
 	AdditionalHooksDir                string
 	ConfigDir                         string // K2s setup config dir
+	InstallDir                        string // K2s install dir
 }
 
 // StartConfig holds parameters for cluster start.
@@ -101,12 +139,16 @@ type StartConfig struct {
 	ShowLogs            bool
 	AdditionalHooksDir  string
 	UseCachedK2sVSwitch bool
+	ConfigDir           string // K2s setup config dir
+	InstallDir          string // K2s install dir
 }
 
 // StopConfig holds parameters for cluster stop.
 type StopConfig struct {
 	ShowLogs           bool
 	AdditionalHooksDir string
+	ConfigDir          string // K2s setup config dir
+	InstallDir         string // K2s install dir
 }
 
 // VMConfig holds parameters for virtual machine creation.
@@ -118,12 +160,90 @@ type VMConfig struct {
 	DiskSizeGB    int
 	NetworkBridge string
 	DynamicMemory bool
+	MaxMemoryMB   int // upper memory limit with dynamic memory; MemoryMB is the startup memory
+}
+
+// ResizeNodeConfig holds parameters for changing the resources of a VM-hosted node; zero values keep the current
+// setting.
+type ResizeNodeConfig struct {
+	NodeName    string
+	CPUCount    int
+	MemoryMB    int
+	MaxMemoryMB int // enables dynamic memory
+	DiskSizeGB  int
+	ConfigDir   string // K2s setup config dir
+}
+
+// SnapshotConfig holds parameters for creating, restoring and deleting a system snapshot.
+type SnapshotConfig struct {
+	Name      string
+	ConfigDir string // K2s setup config dir
+	// Confirm asks whether the cluster may be reset to the snapshot; without it, the snapshot is restored without asking.
+	Confirm func(question string) bool
+}
+
+// Snapshot describes a system snapshot: the etcd snapshot of the control plane and the disk snapshots of the
+// VM-hosted nodes, taken together.
+type Snapshot struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	Etcd      bool      `json:"etcd"`
+	VMs       []string  `json:"vms,omitempty"`
+}
+
+// DiskCompaction reports the space a disk image occupied on the host before and after its compaction.
+type DiskCompaction struct {
+	Path       string
+	SizeBefore int64 // allocated bytes
+	SizeAfter  int64 // allocated bytes
+}
+
+// CompactConfig holds parameters for compacting the disks of the VM-hosted nodes.
+type CompactConfig struct {
+	NoRestart bool
+	ConfigDir string // K2s setup config dir
+	// Confirm asks whether a running VM may be stopped; without it, the VM is stopped without asking.
+	Confirm func(question string) bool
+}
+
+// CompactResult holds the outcome of a compaction; Compaction is nil if the compaction was cancelled.
+type CompactResult struct {
+	VMName     string
+	Compaction *DiskCompaction
+	Restarted  bool
 }
 
 // ServiceConfig holds parameters for service installation.
 type ServiceConfig struct {
-	Name       string
-	BinaryPath string
-	Args       []string
-	WorkingDir string
+	Name        string
+	Description string // defaults to "K2s <Name> service"
+	BinaryPath  string
+	Args        []string
+	WorkingDir  string
+	Environment []string // KEY=value pairs
+	// NetworkOnline orders the service after the network is fully configured instead of after network setup started.
+	NetworkOnline bool
+	// DynamicUser runs the service as a transient unprivileged user instead of root (Linux only).
+	DynamicUser bool
+	// ReadWritePaths are the only host paths the service may write to besides its private /tmp (Linux only).
+	ReadWritePaths []string
+	// Notify expects the service to signal readiness to the service manager (Linux only).
+	Notify bool
+	// Watchdog restarts the service if it does not signal liveness within this interval; requires Notify (Linux only).
+	Watchdog time.Duration
+}
+
+// ServiceStatus is the state of a host service as reported by the service manager.
+type ServiceStatus struct {
+	Name         string
+	Installed    bool
+	ActiveState  string // e.g. active, activating, failed, inactive
+	SubState     string // e.g. running, auto-restart, dead
+	RestartCount int    // automatic restarts since the service was started explicitly
+	LastError    string // empty if the service has not failed
+}
+
+// IsActive reports whether the service is up.
+func (s *ServiceStatus) IsActive() bool {
+	return s.ActiveState == "active"
 }
@@ -17,6 +17,8 @@ import (
 	"time"
 
 	"github.com/siemens-healthineers/k2s/internal/core/config"
+	"github.com/siemens-healthineers/k2s/internal/core/kubeadmconfig"
+	"github.com/siemens-healthineers/k2s/internal/definitions"
 )
 
 const (
@@ -41,89 +43,221 @@ func NewOrchestrator(_ interface{}) Orchestrator {
 }
 
 func (o *LinuxOrchestrator) Install(cfg InstallConfig) error {
-	slog.Info("[Install] Installing K2s on Linux host", "linuxOnly", cfg.LinuxOnly)
+	slog.Info("[Install] Installing K2s on Linux host", "linuxOnly", cfg.LinuxOnly, "windowsWorker", cfg.WindowsWorker, "resume", cfg.Resume, "rollback", cfg.Rollback)
 
-	if !cfg.LinuxOnly {
-		return fmt.Errorf("Linux host installation currently supports only --linux-only; Windows worker provisioning is not supported yet")
-	}
-
-	// Step 1: Validate the host before Kubernetes packages exist.
-	if err := o.checkHostPrerequisites(cfg); err != nil {
-		return fmt.Errorf("prerequisite check failed: %w", err)
-	}
-
-	// Step 2: Install the version-pinned Kubernetes and CRI-O package set.
-	k8sVersion, err := o.provisionKubernetes(cfg)
+	checkpoints, err := loadCheckpoints(cfg.ConfigDir)
 	if err != nil {
-		return fmt.Errorf("package provisioning failed: %w", err)
+		return err
 	}
 
-	// Step 3: Install control plane natively via kubeadm.
-	if err := o.installControlPlane(cfg, k8sVersion); err != nil {
-		return fmt.Errorf("failed to install control plane: %w", err)
+	if cfg.Rollback {
+		if checkpoints == nil {
+			return fmt.Errorf("no interrupted installation found to roll back")
+		}
+		if err := rollbackInstallSteps(cfg.ConfigDir, o.installSteps(cfg), checkpoints); err != nil {
+			return fmt.Errorf("rollback of interrupted installation failed: %w", err)
+		}
+		slog.Info("[Install] Interrupted installation rolled back")
+		return nil
 	}
 
-	// Step 4: Set up kubeconfig for the user that invoked sudo.
-	if err := o.setupKubeconfig(); err != nil {
-		return fmt.Errorf("failed to setup kubeconfig: %w", err)
+	if cfg.LinuxOnly == cfg.WindowsWorker {
+		return fmt.Errorf("Linux host installation requires either --linux-only or --windows-worker")
 	}
 
-	// Step 5: Deploy flannel CNI.
-	if err := o.deployFlannel(cfg); err != nil {
-		return fmt.Errorf("failed to deploy flannel: %w", err)
+	switch {
+	case cfg.Resume && checkpoints == nil:
+		return fmt.Errorf("no interrupted installation found to resume")
+	case !cfg.Resume && checkpoints != nil:
+		return fmt.Errorf("a previous installation was interrupted at step '%s'; run 'k2s install --resume' to continue or 'k2s install --rollback' to undo it", checkpoints.FailedStep)
+	case checkpoints == nil:
+		checkpoints = &installCheckpoints{}
+	default:
+		slog.Info("[Install] Resuming interrupted installation", "completedSteps", checkpoints.CompletedSteps, "failedStep", checkpoints.FailedStep)
 	}
 
-	// Step 6: Wait for control plane node to be Ready.
-	if err := o.waitForNodeReady(120 * time.Second); err != nil {
-		slog.Warn("[Install] Control plane node not ready yet (may take a moment)", "error", err)
+	hooks := newHookRunner(cfg.InstallDir, cfg.ConfigDir, cfg.AdditionalHooksDir, installClusterName(cfg))
+	if err := hooks.runBefore(hookBeforeInstall); err != nil {
+		return err
 	}
 
-	// Step 7: Persist setup.json only after successful provisioning.
-	hostname, _ := os.Hostname()
-	clusterName := cfg.ClusterName
-	if clusterName == "" {
-		clusterName = "k2s-cluster"
-	}
-	if err := config.WriteRuntimeConfig(cfg.ConfigDir, "k2s", cfg.LinuxOnly, cfg.Version, clusterName, hostname, false); err != nil {
-		return fmt.Errorf("failed to write runtime config: %w", err)
+	if err := runInstallSteps(cfg.ConfigDir, o.installSteps(cfg), checkpoints); err != nil {
+		return err
 	}
 
 	if cfg.SkipStart {
-		if err := o.Stop(StopConfig{}); err != nil {
+		if err := o.Stop(StopConfig{ConfigDir: cfg.ConfigDir}); err != nil {
 			return fmt.Errorf("stop cluster after --skip-start: %w", err)
 		}
 	}
 
+	hooks.runAfter(hookAfterInstall)
+
 	slog.Info("[Install] K2s installation complete")
 	return nil
 }
 
+// installSteps returns the checkpointed installation steps in order. setup.json is persisted by the last step only,
+// i.e. after successful provisioning. The Windows worker step is part of a rollback in any case, since the steps to
+// undo are determined by the checkpoints.
+func (o *LinuxOrchestrator) installSteps(cfg InstallConfig) []installStep {
+	steps := []installStep{
+		{
+			// Validate the host before Kubernetes packages exist.
+			name: "prerequisites",
+			run: func() error {
+				if err := o.checkHostPrerequisites(cfg); err != nil {
+					return fmt.Errorf("prerequisite check failed: %w", err)
+				}
+				if cfg.WindowsWorker {
+					if err := checkWindowsWorkerPrerequisites(cfg.InstallDir); err != nil {
+						return fmt.Errorf("prerequisite check failed: %w", err)
+					}
+				}
+				return nil
+			},
+		},
+		{
+			// Install the version-pinned Kubernetes and CRI-O package set.
+			name: "packages",
+			run: func() error {
+				if err := o.provisionKubernetes(cfg); err != nil {
+					return fmt.Errorf("package provisioning failed: %w", err)
+				}
+				return nil
+			},
+			undo: func() error {
+				o.removeProvisioning(cfg)
+				return nil
+			},
+		},
+		{
+			// Install control plane natively via kubeadm.
+			name: "control-plane",
+			run: func() error {
+				if err := o.installControlPlane(cfg); err != nil {
+					return fmt.Errorf("failed to install control plane: %w", err)
+				}
+				return nil
+			},
+			undo: func() error {
+				if err := os.Remove(filepath.Join(cfg.ConfigDir, kubeadmconfig.FileName)); err != nil && !os.IsNotExist(err) {
+					return fmt.Errorf("remove kubeadm customization: %w", err)
+				}
+				return o.resetControlPlane()
+			},
+		},
+		{
+			// Set up kubeconfig for the user that invoked sudo.
+			name: "kubeconfig",
+			run: func() error {
+				if err := o.setupKubeconfig(); err != nil {
+					return fmt.Errorf("failed to setup kubeconfig: %w", err)
+				}
+				return nil
+			},
+			undo: removeUserKubeconfig,
+		},
+		{
+			// Deploy flannel CNI.
+			name: "cni",
+			run: func() error {
+				if err := o.deployFlannel(cfg); err != nil {
+					return fmt.Errorf("failed to deploy flannel: %w", err)
+				}
+				return nil
+			},
+			undo: func() error {
+				removeFlannelInterfaces()
+				return nil
+			},
+		},
+		{
+			// Wait for control plane node to be Ready.
+			name: "node-ready",
+			run: func() error {
+				if err := o.waitForNodeReady(120 * time.Second); err != nil {
+					slog.Warn("[Install] Control plane node not ready yet (may take a moment)", "error", err)
+				}
+				return nil
+			},
+		},
+	}
+
+	if cfg.WindowsWorker || cfg.Rollback {
+		steps = append(steps, installStep{
+			// Provision the Windows worker VM and join it to the cluster.
+			name: "windows-worker",
+			run: func() error {
+				if err := o.provisionWindowsVM(cfg); err != nil {
+					return fmt.Errorf("Windows worker provisioning failed: %w", err)
+				}
+				return nil
+			},
+			undo: func() error {
+				return removeWindowsWorker(cfg.ConfigDir)
+			},
+		})
+	}
+
+	return append(steps, installStep{
+		// Persist setup.json only after successful provisioning.
+		name: "runtime-config",
+		run: func() error {
+			hostname, _ := os.Hostname()
+			if err := config.WriteRuntimeConfig(cfg.ConfigDir, "k2s", cfg.LinuxOnly, cfg.Version, installClusterName(cfg), hostname, false); err != nil {
+				return fmt.Errorf("failed to write runtime config: %w", err)
+			}
+			if cfg.WindowsWorker {
+				return recordWindowsWorker(cfg.ConfigDir)
+			}
+			return nil
+		},
+		undo: func() error {
+			if err := os.Remove(filepath.Join(cfg.ConfigDir, definitions.K2sRuntimeConfigFileName)); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("remove runtime config: %w", err)
+			}
+			return nil
+		},
+	})
+}
+
+// installClusterName returns the configured cluster name or the default one.
+func installClusterName(cfg InstallConfig) string {
+	if cfg.ClusterName == "" {
+		return "k2s-cluster"
+	}
+	return cfg.ClusterName
+}
+
 func (o *LinuxOrchestrator) Uninstall(cfg UninstallConfig) error {
 	slog.Info("[Uninstall] Uninstalling K2s from Linux host")
 
+	hooks := newHookRunner(cfg.InstallDir, cfg.ConfigDir, cfg.AdditionalHooksDir, runtimeClusterName(cfg.ConfigDir))
+	if err := hooks.runBefore(hookBeforeUninstall); err != nil {
+		return err
+	}
+
+	// The Windows node is removed while the API server is still available.
+	if InstalledWindowsWorker(cfg.ConfigDir) != nil {
+		if err := removeWindowsWorker(cfg.ConfigDir); err != nil {
+			slog.Warn("[Uninstall] Could not remove Windows worker completely", "error", err)
+		}
+	}
+
 	// Reset kubeadm
 	if !cfg.SkipPurge {
-		_ = runCommand("systemctl", "stop", "kubelet")
-		if err := runCommand("kubeadm", "reset", "-f"); err != nil {
+		if err := o.resetControlPlane(); err != nil {
 			slog.Warn("[Uninstall] kubeadm reset failed (may already be clean)", "error", err)
 		}
-
-		o.cleanupCriOMirrorDropIns()
-		o.removeControlPlaneState()
 	}
 
-	// Clean up network interfaces created by flannel
-	_ = runCommand("ip", "link", "delete", "cni0")
-	_ = runCommand("ip", "link", "delete", "flannel.1")
+	removeFlannelInterfaces()
 
 	removeHTTPProxy()
 
-	// Remove kubeconfig
-	if u, err := invokingUser(); err == nil {
-		kubeconfigPath := filepath.Join(u.HomeDir, ".kube", "config")
-		if err := os.Remove(kubeconfigPath); err != nil && !os.IsNotExist(err) {
-			slog.Warn("[Uninstall] Could not remove kubeconfig", "path", kubeconfigPath, "error", err)
-		}
+	if err := removeUserKubeconfig(); err != nil {
+		slog.Warn("[Uninstall] Could not remove kubeconfig", "error", err)
 	}
 
 	// Remove setup.json
@@ -133,10 +267,41 @@ func (o *LinuxOrchestrator) Uninstall(cfg UninstallConfig) error {
 		}
 	}
 
+	hooks.runAfter(hookAfterUninstall)
+
 	slog.Info("[Uninstall] K2s uninstallation complete")
 	return nil
 }
 
+// resetControlPlane reverts 'kubeadm init' and removes the remaining control-plane state.
+func (o *LinuxOrchestrator) resetControlPlane() error {
+	_ = runCommand("systemctl", "stop", "kubelet")
+	err := runCommand("kubeadm", "reset", "-f")
+
+	o.cleanupCriOMirrorDropIns()
+	o.removeControlPlaneState()
+	return err
+}
+
+// removeFlannelInterfaces cleans up the network interfaces created by flannel.
+func removeFlannelInterfaces() {
+	_ = runCommand("ip", "link", "delete", "cni0")
+	_ = runCommand("ip", "link", "delete", "flannel.1")
+}
+
+func removeUserKubeconfig() error {
+	u, err := invokingUser()
+	if err != nil {
+		return fmt.Errorf("failed to determine current user: %w", err)
+	}
+
+	kubeconfigPath := filepath.Join(u.HomeDir, ".kube", "config")
+	if err := os.Remove(kubeconfigPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove kubeconfig %s: %w", kubeconfigPath, err)
+	}
+	return nil
+}
+
 // removeControlPlaneState removes files created by kubeadm and Flannel. It is
 // deliberately limited to K2s control-plane paths so uninstall can recover an
 // interrupted Linux installation without changing unrelated host state.
@@ -205,6 +370,11 @@ func (o *LinuxOrchestrator) cleanupCriOMirrorDropIns() {
 func (o *LinuxOrchestrator) Start(cfg StartConfig) error {
 	slog.Info("[Start] Starting K2s cluster on Linux host")
 
+	hooks := newHookRunner(cfg.InstallDir, cfg.ConfigDir, cfg.AdditionalHooksDir, runtimeClusterName(cfg.ConfigDir))
+	if err := hooks.runBefore(hookBeforeStart); err != nil {
+		return err
+	}
+
 	if err := runCommand("systemctl", "start", proxyService); err != nil {
 		return fmt.Errorf("failed to start local HTTP proxy: %w", err)
 	}
@@ -222,6 +392,14 @@ func (o *LinuxOrchestrator) Start(cfg StartConfig) error {
 		slog.Warn("[Start] API server not reachable yet", "error", err)
 	}
 
+	if worker := InstalledWindowsWorker(cfg.ConfigDir); worker != nil {
+		if err := startWindowsWorker(worker); err != nil {
+			return fmt.Errorf("failed to start Windows worker: %w", err)
+		}
+	}
+
+	hooks.runAfter(hookAfterStart)
+
 	slog.Info("[Start] K2s cluster started")
 	return nil
 }
@@ -229,6 +407,17 @@ func (o *LinuxOrchestrator) Start(cfg StartConfig) error {
 func (o *LinuxOrchestrator) Stop(cfg StopConfig) error {
 	slog.Info("[Stop] Stopping K2s cluster on Linux host")
 
+	hooks := newHookRunner(cfg.InstallDir, cfg.ConfigDir, cfg.AdditionalHooksDir, runtimeClusterName(cfg.ConfigDir))
+	if err := hooks.runBefore(hookBeforeStop); err != nil {
+		return err
+	}
+
+	if worker := InstalledWindowsWorker(cfg.ConfigDir); worker != nil {
+		if err := stopWindowsWorker(worker); err != nil {
+			return fmt.Errorf("failed to stop Windows worker: %w", err)
+		}
+	}
+
 	// Stop kubelet
 	if err := runCommand("systemctl", "stop", "kubelet"); err != nil {
 		return fmt.Errorf("failed to stop kubelet: %w", err)
@@ -240,36 +429,46 @@ func (o *LinuxOrchestrator) Stop(cfg StopConfig) error {
 		slog.Warn("[Stop] Could not stop local HTTP proxy", "error", err)
 	}
 
+	hooks.runAfter(hookAfterStop)
+
 	slog.Info("[Stop] K2s cluster stopped")
 	return nil
 }
 
 // ---------- control plane installation ----------
 
-func (o *LinuxOrchestrator) installControlPlane(cfg InstallConfig, k8sVersion string) error {
+func (o *LinuxOrchestrator) installControlPlane(cfg InstallConfig) error {
 	slog.Info("[Install] Installing Kubernetes control plane via kubeadm")
 
+	k8sVersion, err := resolveKubernetesVersion(cfg.InstallDir)
+	if err != nil {
+		return err
+	}
+
 	if err := runCommand("systemctl", "start", crioServiceName); err != nil {
 		return fmt.Errorf("failed to start CRI-O: %w", err)
 	}
 
-	// Generate kubeadm init config with KubeletConfiguration
-	initConfig := fmt.Sprintf(`apiVersion: kubeadm.k8s.io/v1beta4
-kind: InitConfiguration
-nodeRegistration:
-  criSocket: %s
----
-apiVersion: kubeadm.k8s.io/v1beta4
-kind: ClusterConfiguration
-kubernetesVersion: %s
-networking:
-  podSubnet: %s
-  serviceSubnet: %s
----
-apiVersion: kubelet.config.k8s.io/v1beta1
-kind: KubeletConfiguration
-failCgroupV1: false
-`, crioSocket, k8sVersion, podNetworkCIDR, servicesCIDR)
+	// A previous attempt may have initialized the control plane already; a partial initialization is reset.
+	if _, err := os.Stat(kubeconfigSrc); err == nil {
+		if err := runCommand("systemctl", "start", "kubelet"); err == nil && o.waitForAPIServer(60*time.Second) == nil {
+			slog.Info("[Install] Control plane already initialized")
+			return nil
+		}
+		slog.Info("[Install] Resetting partially initialized control plane")
+		if err := o.resetControlPlane(); err != nil {
+			return fmt.Errorf("failed to reset partially initialized control plane: %w", err)
+		}
+	}
+
+	customization, err := o.kubeadmCustomization(cfg)
+	if err != nil {
+		return err
+	}
+	initConfig, err := renderKubeadmConfig(k8sVersion, customization)
+	if err != nil {
+		return err
+	}
 
 	configDir := "/tmp/kubeadm-init"
 	configPath := filepath.Join(configDir, "kubeadm-init.yaml")
@@ -277,11 +476,15 @@ failCgroupV1: false
 	if err := os.MkdirAll(configDir, 0755); err != nil {
 		return fmt.Errorf("failed to create kubeadm init config directory: %w", err)
 	}
-	if err := os.WriteFile(configPath, []byte(initConfig), 0600); err != nil {
+	if err := os.WriteFile(configPath, initConfig, 0600); err != nil {
 		return fmt.Errorf("failed to write kubeadm init config: %w", err)
 	}
 	if cfg.ShowLogs {
-		slog.Info("[Install] Rendered kubeadm configuration", "path", configPath, "content", initConfig)
+		slog.Info("[Install] Rendered kubeadm configuration", "path", configPath, "content", string(initConfig))
+	}
+
+	if err := runCommand("kubeadm", "config", "validate", "--config", configPath); err != nil {
+		return fmt.Errorf("invalid kubeadm configuration: %w", err)
 	}
 
 	// Build kubeadm init arguments
@@ -306,6 +509,45 @@ failCgroupV1: false
 	return nil
 }
 
+// renderKubeadmConfig generates the kubeadm init configuration with KubeletConfiguration and merges the
+// customization from the install config file into it.
+func renderKubeadmConfig(k8sVersion string, customization *kubeadmconfig.Customization) ([]byte, error) {
+	initConfig := fmt.Sprintf(`apiVersion: kubeadm.k8s.io/v1beta4
+kind: InitConfiguration
+nodeRegistration:
+  criSocket: %s
+---
+apiVersion: kubeadm.k8s.io/v1beta4
+kind: ClusterConfiguration
+kubernetesVersion: %s
+networking:
+  podSubnet: %s
+  serviceSubnet: %s
+---
+apiVersion: kubelet.config.k8s.io/v1beta1
+kind: KubeletConfiguration
+failCgroupV1: false
+`, crioSocket, k8sVersion, podNetworkCIDR, servicesCIDR)
+
+	rendered, err := kubeadmconfig.Merge([]byte(initConfig), customization)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kubeadm customization: %w", err)
+	}
+	return rendered, nil
+}
+
+// kubeadmCustomization returns the customization from the install config file and persists it so that resumed
+// installations and upgrades reuse it; without customization in the install config file, the persisted one is used.
+func (o *LinuxOrchestrator) kubeadmCustomization(cfg InstallConfig) (*kubeadmconfig.Customization, error) {
+	if cfg.Kubeadm == nil {
+		return kubeadmconfig.Read(cfg.ConfigDir)
+	}
+	if err := kubeadmconfig.Write(cfg.ConfigDir, cfg.Kubeadm); err != nil {
+		return nil, err
+	}
+	return cfg.Kubeadm, nil
+}
+
 // ---------- kubeconfig setup ----------
 
 func (o *LinuxOrchestrator) setupKubeconfig() error {
@@ -428,6 +670,8 @@ func (o *LinuxOrchestrator) waitForAPIServer(timeout time.Duration) error {
 
 // ---------- Windows VM provisioning ----------
 
+// provisionWindowsVM creates the Windows worker VM and joins it to the cluster. Completed parts are detected and
+// skipped, so that an interrupted provisioning can be resumed.
 func (o *LinuxOrchestrator) provisionWindowsVM(cfg InstallConfig) error {
 	slog.Info("[Install] Provisioning Windows worker VM via libvirt/KVM")
 
@@ -459,18 +703,30 @@ func (o *LinuxOrchestrator) provisionWindowsVM(cfg InstallConfig) error {
 		NetworkBridge: k2sNetworkName,
 	}
 
-	if err := vmManager.CreateVM(vmConfig); err != nil {
+	exists, err := vmManager.VMExists(winVMName)
+	if err != nil {
+		return fmt.Errorf("failed to determine whether Windows VM exists: %w", err)
+	}
+	if exists {
+		slog.Info("[Install] Windows VM already defined", "name", winVMName)
+	} else if err := vmManager.CreateVM(vmConfig); err != nil {
 		return fmt.Errorf("failed to create Windows VM: %w", err)
 	}
 
 	// Step 4: Start the VM
-	if err := vmManager.StartVM(winVMName); err != nil {
-		return fmt.Errorf("failed to start Windows VM: %w", err)
+	running, err := vmManager.VMIsRunning(winVMName)
+	if err != nil {
+		return fmt.Errorf("failed to determine state of Windows VM: %w", err)
+	}
+	if !running {
+		if err := vmManager.StartVM(winVMName); err != nil {
+			return fmt.Errorf("failed to start Windows VM: %w", err)
+		}
 	}
 
 	// Step 5: Wait for the VM to become reachable via SSH
 	slog.Info("[Install] Waiting for Windows VM to become reachable", "ip", winVMIP)
-	if err := waitForSSH(winVMIP, 22, 300*time.Second); err != nil {
+	if err := waitForSSH(winVMIP, 22, windowsSSHTimeout); err != nil {
 		return fmt.Errorf("Windows VM not reachable via SSH within timeout: %w", err)
 	}
 
@@ -487,9 +743,17 @@ func (o *LinuxOrchestrator) provisionWindowsVM(cfg InstallConfig) error {
 	}
 
 	// Step 8: Generate kubeadm join token and join the Windows node
-	slog.Info("[Install] Joining Windows node to cluster")
-	if err := joinWindowsNode(winVMIP); err != nil {
-		return fmt.Errorf("failed to join Windows node to cluster: %w", err)
+	node, err := findClusterNode(winVMIP)
+	if err != nil {
+		return err
+	}
+	if node != nil {
+		slog.Info("[Install] Windows node already joined", "name", node.name)
+	} else {
+		slog.Info("[Install] Joining Windows node to cluster")
+		if err := joinWindowsNode(winVMIP); err != nil {
+			return fmt.Errorf("failed to join Windows node to cluster: %w", err)
+		}
 	}
 
 	// Step 9: Set up host routes for the Windows pod subnet
@@ -498,12 +762,12 @@ func (o *LinuxOrchestrator) provisionWindowsVM(cfg InstallConfig) error {
 	}
 
 	// Step 10: Wait for Windows node to be Ready
-	slog.Info("[Install] Waiting for Windows node to be Ready")
-	if err := waitForWindowsNodeReady(180 * time.Second); err != nil {
-		slog.Warn("[Install] Windows node not ready yet (may take a moment)", "error", err)
+	nodeName, err := waitForWindowsNodeReady(winVMIP, windowsNodeReadyTimeout)
+	if err != nil {
+		return err
 	}
 
-	slog.Info("[Install] Windows worker VM provisioned successfully")
+	slog.Info("[Install] Windows worker VM provisioned successfully", "node", nodeName)
 	return nil
 }
 
@@ -604,6 +868,11 @@ func installWindowsServices(vmIP string) error {
 	nssmPath := `C:\k2s\bin\nssm.exe`
 
 	for _, svc := range services {
+		// 'nssm status' succeeds for installed services only
+		if sshExecOnVM(vmIP, fmt.Sprintf(`%s status %s`, nssmPath, svc.name)) == nil {
+			slog.Info("[Install] Windows service already installed", "service", svc.name)
+			continue
+		}
 		cmd := fmt.Sprintf(`%s install %s "%s" %s`, nssmPath, svc.name, svc.binary, svc.args)
 		if err := sshExecOnVM(vmIP, cmd); err != nil {
 			return fmt.Errorf("failed to install service '%s': %w", svc.name, err)
@@ -646,29 +915,6 @@ func joinWindowsNode(vmIP string) error {
 	return nil
 }
 
-// waitForWindowsNodeReady waits until the Windows worker node reports Ready.
-func waitForWindowsNodeReady(timeout time.Duration) error {
-	deadline := time.Now().Add(timeout)
-	for time.Now().Before(deadline) {
-		output, err := runCommandOutput("kubectl", "get", "nodes", "-o", "jsonpath={range .items[*]}{.metadata.name}={.status.conditions[?(@.type=='Ready')].status}{','}{end}")
-		if err == nil {
-			for _, entry := range strings.Split(output, ",") {
-				parts := strings.SplitN(entry, "=", 2)
-				if len(parts) == 2 && parts[1] == "True" && parts[0] != "" {
-					// Check if this is the Windows node (not the control plane)
-					nodeOS, _ := runCommandOutput("kubectl", "get", "node", parts[0], "-o", "jsonpath={.status.nodeInfo.operatingSystem}")
-					if strings.TrimSpace(nodeOS) == "windows" {
-						slog.Info("[Install] Windows node is Ready", "name", parts[0])
-						return nil
-					}
-				}
-			}
-		}
-		time.Sleep(5 * time.Second)
-	}
-	return fmt.Errorf("Windows node not ready after %s", timeout)
-}
-
 // sshExecOnVM executes a command on the Windows VM via SSH.
 // Requires OpenSSH to be installed on the Windows worker image.
 func sshExecOnVM(vmIP, command string) error {
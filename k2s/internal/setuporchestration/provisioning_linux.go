@@ -6,7 +6,6 @@
 package setuporchestration
 
 import (
-	"bufio"
 	"fmt"
 	"log/slog"
 	"net/url"
@@ -17,6 +16,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -24,26 +24,32 @@ const (
 	crioSocket      = "unix:///var/run/crio/crio.sock"
 	localProxyURL   = "http://127.0.0.1:8181"
 	proxyService    = "k2s-httpproxy"
+	proxyWatchdog   = 30 * time.Second
 )
 
 var k8sVersionPattern = regexp.MustCompile(`(?m)return\s+['\"](v[0-9]+\.[0-9]+\.[0-9]+)['\"]`)
 
-func (o *LinuxOrchestrator) checkHostPrerequisites(_ InstallConfig) error {
+func (o *LinuxOrchestrator) checkHostPrerequisites(cfg InstallConfig) error {
 	slog.Info("[Install] Checking Linux host prerequisites")
 
 	if os.Geteuid() != 0 {
 		return fmt.Errorf("Linux installation must be run as root (for example: sudo ./k2s install --linux-only)")
 	}
 
-	if err := requireDebian13(); err != nil {
+	distro, err := detectHostDistro()
+	if err != nil {
 		return err
 	}
+	slog.Info("[Install] Detected host distribution", "distribution", distro.name)
 
-	for _, bin := range []string{"systemctl", "apt-get", "dpkg", "modprobe", "sysctl"} {
+	for _, bin := range []string{"systemctl", "modprobe", "sysctl"} {
 		if _, err := exec.LookPath(bin); err != nil {
 			return fmt.Errorf("required host tool %q was not found in PATH: %w", bin, err)
 		}
 	}
+	if err := distro.checkPrerequisites(); err != nil {
+		return err
+	}
 
 	if err := runCommand("systemctl", "is-system-running", "--wait"); err != nil {
 		slog.Warn("[Install] systemd is not fully running; continuing because package provisioning may finish pending startup work", "error", err)
@@ -69,6 +75,17 @@ func (o *LinuxOrchestrator) checkHostPrerequisites(_ InstallConfig) error {
 		}
 	}
 
+	// fail fast on customizations that would be rejected before 'kubeadm init'
+	if cfg.Kubeadm != nil {
+		k8sVersion, err := resolveKubernetesVersion(cfg.InstallDir)
+		if err != nil {
+			return err
+		}
+		if _, err := renderKubeadmConfig(k8sVersion, cfg.Kubeadm); err != nil {
+			return err
+		}
+	}
+
 	if _, err := os.Stat(kubeconfigSrc); err == nil {
 		return fmt.Errorf("an existing Kubernetes control plane was found at %s; run 'k2s uninstall' before installing", kubeconfigSrc)
 	}
@@ -76,52 +93,109 @@ func (o *LinuxOrchestrator) checkHostPrerequisites(_ InstallConfig) error {
 	return nil
 }
 
-func (o *LinuxOrchestrator) provisionKubernetes(cfg InstallConfig) (string, error) {
+func (o *LinuxOrchestrator) provisionKubernetes(cfg InstallConfig) error {
 	k8sVersion, err := resolveKubernetesVersion(cfg.InstallDir)
 	if err != nil {
-		return "", err
+		return err
+	}
+
+	distro, err := detectHostDistro()
+	if err != nil {
+		return err
 	}
 
 	if err := o.installHTTPProxy(cfg); err != nil {
-		return "", err
+		return err
 	}
 
-	stagingDir := filepath.Join(cfg.ConfigDir, "packages")
-	if err := os.MkdirAll(stagingDir, 0700); err != nil {
-		return "", fmt.Errorf("create package staging directory: %w", err)
+	installScript, err := distro.scriptPath(cfg.InstallDir, "install-k8s-packages.sh")
+	if err != nil {
+		return err
+	}
+
+	offlineDir, err := o.selectOfflinePackageSet(cfg, distro, k8sVersion)
+	if err != nil {
+		return err
 	}
 
-	downloadScript := filepath.Join(cfg.InstallDir, "cfg", "nodeextension", "debian13", "scripts", "download-k8s-packages.sh")
-	installScript := filepath.Join(cfg.InstallDir, "cfg", "nodeextension", "debian13", "scripts", "install-k8s-packages.sh")
-	for _, script := range []string{downloadScript, installScript} {
-		if _, err := os.Stat(script); err != nil {
-			return "", fmt.Errorf("required Debian 13 provisioning script is missing at %s: %w", script, err)
+	var packagesDir string
+	if offlineDir != "" {
+		packagesDir = filepath.Join(offlineDir, offlineK8sPackagesDir)
+	} else {
+		packagesDir, err = o.downloadKubernetesPackages(cfg, distro, k8sVersion)
+		if err != nil {
+			return err
 		}
 	}
 
-	slog.Info("[Install] Downloading Kubernetes and CRI-O packages", "version", k8sVersion)
-	if cfg.ShowLogs {
-		slog.Info("[Install] Package staging directory", "path", stagingDir)
+	registryToken, err := readRegistryToken(cfg.InstallDir)
+	if err != nil {
+		return err
 	}
-	if err := runCommandWithLogs(cfg.ShowLogs, "bash", downloadScript, stagingDir, k8sVersion, localProxyURL); err != nil {
-		return "", fmt.Errorf("download Kubernetes packages: %w", err)
+
+	slog.Info("[Install] Installing Kubernetes and CRI-O packages", "packageManager", distro.packageManager().name)
+	if err := runCommandWithLogs(cfg.ShowLogs, "bash", installScript, packagesDir, localProxyURL, registryToken, "false", mergeNoProxy(cfg.NoProxy)); err != nil {
+		return fmt.Errorf("install Kubernetes packages: %w", err)
 	}
 
-	registryToken, err := readRegistryToken(cfg.InstallDir)
+	if err := o.checkProvisionedRuntime(k8sVersion); err != nil {
+		return err
+	}
+
+	if offlineDir != "" {
+		if err := o.preloadOfflineImages(cfg, distro, offlineDir, k8sVersion); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// selectOfflinePackageSet returns the verified offline package set directory, or an empty string if the packages
+// are to be downloaded. Downloading requires --force-online-installation since target hosts may have no internet.
+func (o *LinuxOrchestrator) selectOfflinePackageSet(cfg InstallConfig, distro *hostDistro, k8sVersion string) (string, error) {
+	if cfg.ForceOnlineInstallation {
+		if dir, archive := locateOfflinePackageSet(cfg.InstallDir); dir != "" || archive != "" {
+			slog.Info("[Install] Ignoring offline package set due to forced online installation", "path", dir+archive)
+		}
+		return "", nil
+	}
+
+	dir, found, err := stageOfflinePackageSet(cfg)
 	if err != nil {
 		return "", err
 	}
+	if !found {
+		return "", fmt.Errorf("no offline package set found at %s or %s; stage the package set for an air-gapped installation or use --force-online-installation to download the packages",
+			filepath.Join(cfg.InstallDir, "bin", offlinePackageSetName), filepath.Join(cfg.InstallDir, "bin", offlinePackageArchiveName))
+	}
 
-	slog.Info("[Install] Installing Kubernetes and CRI-O packages")
-	if err := runCommandWithLogs(cfg.ShowLogs, "bash", installScript, stagingDir, localProxyURL, registryToken, "false", mergeNoProxy(cfg.NoProxy)); err != nil {
-		return "", fmt.Errorf("install Kubernetes packages: %w", err)
+	slog.Info("[Install] Verifying offline package set", "path", dir)
+	if err := verifyOfflinePackageSet(dir, k8sVersion, distro.packageManager()); err != nil {
+		return "", fmt.Errorf("%w; fix the offline package set or use --force-online-installation to download the packages", err)
 	}
+	return dir, nil
+}
 
-	if err := o.checkProvisionedRuntime(k8sVersion); err != nil {
+func (o *LinuxOrchestrator) downloadKubernetesPackages(cfg InstallConfig, distro *hostDistro, k8sVersion string) (string, error) {
+	stagingDir := filepath.Join(cfg.ConfigDir, "packages")
+	if err := os.MkdirAll(stagingDir, 0700); err != nil {
+		return "", fmt.Errorf("create package staging directory: %w", err)
+	}
+
+	downloadScript, err := distro.scriptPath(cfg.InstallDir, "download-k8s-packages.sh")
+	if err != nil {
 		return "", err
 	}
 
-	return k8sVersion, nil
+	slog.Info("[Install] Downloading Kubernetes and CRI-O packages", "version", k8sVersion)
+	if cfg.ShowLogs {
+		slog.Info("[Install] Package staging directory", "path", stagingDir)
+	}
+	if err := runCommandWithLogs(cfg.ShowLogs, "bash", downloadScript, stagingDir, k8sVersion, localProxyURL); err != nil {
+		return "", fmt.Errorf("download Kubernetes packages: %w", err)
+	}
+	return stagingDir, nil
 }
 
 func (o *LinuxOrchestrator) checkProvisionedRuntime(k8sVersion string) error {
@@ -162,39 +236,65 @@ func (o *LinuxOrchestrator) installHTTPProxy(cfg InstallConfig) error {
 		args = append(args, "--forwardproxy", cfg.Proxy)
 	}
 
-	unitPath := filepath.Join("/etc/systemd/system", proxyService+".service")
-	unit := fmt.Sprintf(`[Unit]
-Description=K2s local HTTP proxy
-After=network-online.target
-Wants=network-online.target
-
-[Service]
-Type=simple
-ExecStart=%s %s
-Environment="NO_PROXY=%s"
-Environment="no_proxy=%s"
-Restart=on-failure
-RestartSec=5
+	serviceManager := NewServiceManager()
+	if err := serviceManager.InstallService(ServiceConfig{
+		Name:          proxyService,
+		Description:   "K2s local HTTP proxy",
+		BinaryPath:    proxyBinary,
+		Args:          args,
+		Environment:   []string{"NO_PROXY=" + noProxy, "no_proxy=" + noProxy},
+		NetworkOnline: true,
+		// the proxy needs no privileges, but a transient user can only run it if the binary is accessible to others
+		DynamicUser: accessibleToOthers(proxyBinary),
+		Notify:      true,
+		Watchdog:    proxyWatchdog,
+	}); err != nil {
+		return fmt.Errorf("install local HTTP proxy service: %w", err)
+	}
+	// a restart applies a changed unit to a proxy that is already running
+	if err := serviceManager.RestartService(proxyService); err != nil {
+		return fmt.Errorf("start local HTTP proxy: %w", err)
+	}
+	return nil
+}
 
-[Install]
-WantedBy=multi-user.target
-`, proxyBinary, strings.Join(args, " "), systemdValue(noProxy), systemdValue(noProxy))
-	if err := os.WriteFile(unitPath, []byte(unit), 0644); err != nil {
-		return fmt.Errorf("write local HTTP proxy service: %w", err)
+// accessibleToOthers reports whether users other than the owner can execute the file at path, i.e. whether the file
+// and all its parent directories grant execute permission to others.
+func accessibleToOthers(path string) bool {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return false
 	}
-	if err := runCommand("systemctl", "daemon-reload"); err != nil {
-		return fmt.Errorf("reload systemd after HTTP proxy setup: %w", err)
+	for {
+		info, err := os.Stat(path)
+		if err != nil || info.Mode().Perm()&0001 == 0 {
+			return false
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			return true
+		}
+		path = parent
 	}
-	if err := runCommand("systemctl", "enable", "--now", proxyService); err != nil {
-		return fmt.Errorf("enable and start local HTTP proxy: %w", err)
+}
+
+// removeProvisioning reverts the host changes of the package provisioning except for the installed packages, which
+// are kept like on uninstall.
+func (o *LinuxOrchestrator) removeProvisioning(cfg InstallConfig) {
+	_ = runCommand("systemctl", "stop", crioServiceName)
+	removeHTTPProxy()
+
+	for _, dir := range []string{filepath.Join(cfg.ConfigDir, "packages"), filepath.Join(cfg.ConfigDir, offlinePackageSetName)} {
+		if err := os.RemoveAll(dir); err != nil {
+			slog.Warn("[Install] Could not remove package staging directory", "path", dir, "error", err)
+		}
 	}
-	return nil
 }
 
 func removeHTTPProxy() {
-	_ = runCommand("systemctl", "disable", "--now", proxyService)
-	_ = os.Remove(filepath.Join("/etc/systemd/system", proxyService+".service"))
-	_ = runCommand("systemctl", "daemon-reload")
+	if err := NewServiceManager().RemoveService(proxyService); err != nil {
+		slog.Warn("[Install] Could not remove local HTTP proxy service", "error", err)
+	}
 }
 
 func resolveKubernetesVersion(installDir string) (string, error) {
@@ -223,30 +323,6 @@ func readRegistryToken(installDir string) (string, error) {
 	return value, nil
 }
 
-func requireDebian13() error {
-	file, err := os.Open("/etc/os-release")
-	if err != nil {
-		return fmt.Errorf("read /etc/os-release: %w", err)
-	}
-	defer file.Close()
-
-	values := map[string]string{}
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		parts := strings.SplitN(scanner.Text(), "=", 2)
-		if len(parts) == 2 {
-			values[parts[0]] = strings.Trim(parts[1], "\"")
-		}
-	}
-	if err := scanner.Err(); err != nil {
-		return fmt.Errorf("read /etc/os-release: %w", err)
-	}
-	if values["ID"] != "debian" || !strings.HasPrefix(values["VERSION_ID"], "13") {
-		return fmt.Errorf("native Linux installation currently supports Debian 13 only; found %s %s", values["ID"], values["VERSION_ID"])
-	}
-	return nil
-}
-
 func swapEnabled() bool {
 	data, err := os.ReadFile("/proc/swaps")
 	if err != nil {
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+const drainTimeout = "300s"
+
+// ResizeNode changes CPU, memory and disk size of the Windows worker VM. The change is validated before a running node
+// is drained and stopped; the node is started and uncordoned after the change, a stopped node stays stopped. If the
+// change fails, the node is started with its previous resources.
+func ResizeNode(cfg ResizeNodeConfig) error {
+	worker := InstalledWindowsWorker(cfg.ConfigDir)
+	if worker == nil {
+		return fmt.Errorf("no libvirt-managed VM is installed; only a Windows worker installed with 'k2s install --windows-worker' can be resized")
+	}
+	if cfg.NodeName != "" && cfg.NodeName != worker.NodeName() {
+		return fmt.Errorf("node '%s' is not a libvirt-managed VM; only the Windows worker node '%s' can be resized", cfg.NodeName, worker.NodeName())
+	}
+
+	vmConfig := VMConfig{
+		Name:          worker.VMName(),
+		CPUCount:      cfg.CPUCount,
+		MemoryMB:      cfg.MemoryMB,
+		DiskSizeGB:    cfg.DiskSizeGB,
+		DynamicMemory: cfg.MaxMemoryMB > 0,
+		MaxMemoryMB:   cfg.MaxMemoryMB,
+	}
+	// fail before the node is drained on settings that can be rejected up front
+	if cfg.MaxMemoryMB > 0 && cfg.MemoryMB == 0 {
+		return fmt.Errorf("dynamic memory requires the startup memory")
+	}
+	if _, err := maxMemory(vmConfig); err != nil {
+		return err
+	}
+
+	vmManager := NewVMManager()
+	if err := vmManager.ValidateUpdate(vmConfig); err != nil {
+		return err
+	}
+	running, err := vmManager.VMIsRunning(worker.VMName())
+	if err != nil {
+		return fmt.Errorf("failed to determine state of Windows worker VM: %w", err)
+	}
+	if !running {
+		slog.Info("[Node] Windows worker VM is stopped; changes apply with the next start", "vm", worker.VMName())
+		return vmManager.UpdateVM(vmConfig)
+	}
+
+	slog.Info("[Node] Draining node", "name", worker.NodeName())
+	if err := runCommand("kubectl", "--kubeconfig", kubeconfigSrc, "drain", worker.NodeName(), "--ignore-daemonsets", "--delete-emptydir-data", "--timeout="+drainTimeout); err != nil {
+		uncordonNode(worker.NodeName())
+		return fmt.Errorf("failed to drain node '%s': %w", worker.NodeName(), err)
+	}
+
+	if err := vmManager.StopVM(worker.VMName()); err != nil {
+		uncordonNode(worker.NodeName())
+		return err
+	}
+
+	updateErr := vmManager.UpdateVM(vmConfig)
+	if updateErr != nil {
+		slog.Error("[Node] Resizing failed, restarting node with previous resources", "name", worker.NodeName(), "error", updateErr)
+	}
+
+	startErr := startWindowsWorker(worker)
+	if startErr == nil && updateErr == nil && cfg.DiskSizeGB > 0 {
+		extendWindowsSystemPartition(worker.IpAddress())
+	}
+	uncordonNode(worker.NodeName())
+
+	return errors.Join(updateErr, startErr)
+}
+
+// extendWindowsSystemPartition grows the system partition of the Windows VM to the size of its disk.
+func extendWindowsSystemPartition(vmIP string) {
+	slog.Info("[Node] Extending Windows system partition")
+	if err := sshExecOnVM(vmIP, `Resize-Partition -DriveLetter C -Size (Get-PartitionSupportedSize -DriveLetter C).SizeMax`); err != nil {
+		slog.Warn("[Node] Could not extend Windows system partition (may have its maximum size already)", "error", err)
+	}
+}
+
+func uncordonNode(name string) {
+	if err := runCommand("kubectl", "--kubeconfig", kubeconfigSrc, "uncordon", name); err != nil {
+		slog.Warn("[Node] Could not uncordon node", "name", name, "error", err)
+	}
+}
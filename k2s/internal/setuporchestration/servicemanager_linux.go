@@ -10,7 +10,9 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // SystemdServiceManager implements ServiceManager using systemd on Linux.
@@ -21,6 +23,11 @@ func NewServiceManager() ServiceManager {
 	return &SystemdServiceManager{}
 }
 
+// HostServices returns the host services the native control plane depends on.
+func HostServices() []string {
+	return []string{proxyService, crioServiceName, "kubelet"}
+}
+
 func (m *SystemdServiceManager) StartService(name string) error {
 	slog.Debug("[ServiceManager] Starting service", "name", name)
 	return runCommand("systemctl", "start", name)
@@ -48,6 +55,13 @@ func (m *SystemdServiceManager) IsServiceRunning(name string) (bool, error) {
 func (m *SystemdServiceManager) InstallService(config ServiceConfig) error {
 	slog.Info("[ServiceManager] Installing systemd service", "name", config.Name, "binary", config.BinaryPath)
 
+	if config.Watchdog > 0 && !config.Notify {
+		return fmt.Errorf("watchdog of service '%s' requires readiness notification", config.Name)
+	}
+	if config.Watchdog > 0 && config.Watchdog < time.Second {
+		return fmt.Errorf("watchdog interval of service '%s' must be at least 1s", config.Name)
+	}
+
 	unitContent := generateSystemdUnit(config)
 	unitPath := filepath.Join("/etc/systemd/system", config.Name+".service")
 
@@ -89,32 +103,120 @@ func (m *SystemdServiceManager) RemoveService(name string) error {
 	return nil
 }
 
-// generateSystemdUnit creates a systemd unit file content from a ServiceConfig.
-func generateSystemdUnit(config ServiceConfig) string {
-	args := ""
-	if len(config.Args) > 0 {
-		args = " " + strings.Join(config.Args, " ")
+func (m *SystemdServiceManager) Status(name string) (*ServiceStatus, error) {
+	output, err := runCommandOutput("systemctl", "show", name, "--property="+strings.Join(serviceStatusProperties, ","))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query state of service '%s': %w", name, err)
+	}
+	status, result, startedAt := parseServiceStatus(name, output)
+	args := journalErrorArgs(name, result, startedAt)
+	if !status.Installed || args == nil {
+		return status, nil
+	}
+
+	// systemd only keeps the result of the last run; the cause of a failure is in the journal
+	status.LastError = "service result: " + result
+	if journal, err := runCommandOutput("journalctl", args...); err != nil {
+		slog.Debug("[ServiceManager] Could not read journal of service", "name", name, "error", err)
+	} else if line := strings.TrimSpace(journal); line != "" {
+		status.LastError = line
+	}
+	return status, nil
+}
+
+// serviceStatusProperties are the unit properties read by Status.
+var serviceStatusProperties = []string{"LoadState", "ActiveState", "SubState", "NRestarts", "Result", "ExecMainStartTimestamp"}
+
+// parseServiceStatus parses the output of 'systemctl show' and returns the status, the result of the last run and
+// the start time of the last run in systemd's timestamp format; empty if the service has not been started.
+func parseServiceStatus(name, output string) (status *ServiceStatus, result, startedAt string) {
+	properties := map[string]string{}
+	for line := range strings.Lines(output) {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if found {
+			properties[key] = value
+		}
+	}
+
+	restarts, _ := strconv.Atoi(properties["NRestarts"])
+	return &ServiceStatus{
+		Name:         name,
+		Installed:    properties["LoadState"] != "" && properties["LoadState"] != "not-found",
+		ActiveState:  properties["ActiveState"],
+		SubState:     properties["SubState"],
+		RestartCount: restarts,
+	}, properties["Result"], properties["ExecMainStartTimestamp"]
+}
+
+// journalErrorArgs returns the journalctl arguments reading the last error of the service's last run; nil if the
+// last run succeeded, so that errors of earlier runs are not reported for a healthy service.
+func journalErrorArgs(name, result, startedAt string) []string {
+	if result == "" || result == "success" {
+		return nil
+	}
+	args := []string{"--unit", name, "--boot", "--priority", "err", "--lines", "1", "--output", "cat", "--no-pager"}
+	if startedAt != "" && startedAt != "n/a" {
+		args = append(args, "--since", startedAt)
 	}
+	return args
+}
 
+// generateSystemdUnit creates a systemd unit file content from a ServiceConfig. Every unit is sandboxed: it cannot
+// gain privileges, sees the host file system read-only except for ReadWritePaths and logs to the journal under its
+// own name.
+func generateSystemdUnit(config ServiceConfig) string {
+	description := config.Description
+	if description == "" {
+		description = fmt.Sprintf("K2s %s service", config.Name)
+	}
 	workDir := config.WorkingDir
 	if workDir == "" {
 		workDir = filepath.Dir(config.BinaryPath)
 	}
+	serviceType := "simple"
+	if config.Notify {
+		serviceType = "notify"
+	}
 
-	return fmt.Sprintf(`[Unit]
-Description=K2s %s service
-After=network.target
+	var unit strings.Builder
+	unit.WriteString("[Unit]\n")
+	fmt.Fprintf(&unit, "Description=%s\n", description)
+	if config.NetworkOnline {
+		unit.WriteString("After=network-online.target\nWants=network-online.target\n")
+	} else {
+		unit.WriteString("After=network.target\n")
+	}
 
-[Service]
-Type=simple
-ExecStart=%s%s
-WorkingDirectory=%s
-Restart=on-failure
-RestartSec=10
-StandardOutput=journal
-StandardError=journal
+	unit.WriteString("\n[Service]\n")
+	fmt.Fprintf(&unit, "Type=%s\n", serviceType)
+	fmt.Fprintf(&unit, "ExecStart=%s\n", strings.Join(append([]string{config.BinaryPath}, config.Args...), " "))
+	fmt.Fprintf(&unit, "WorkingDirectory=%s\n", workDir)
+	for _, env := range config.Environment {
+		fmt.Fprintf(&unit, "Environment=\"%s\"\n", systemdValue(env))
+	}
+	unit.WriteString("Restart=on-failure\nRestartSec=10\n")
+	if config.Watchdog > 0 {
+		fmt.Fprintf(&unit, "WatchdogSec=%d\n", int(config.Watchdog.Seconds()))
+	}
+	fmt.Fprintf(&unit, "StandardOutput=journal\nStandardError=journal\nSyslogIdentifier=%s\n", config.Name)
+
+	if config.DynamicUser {
+		unit.WriteString("DynamicUser=yes\n")
+	}
+	unit.WriteString(`NoNewPrivileges=yes
+ProtectSystem=strict
+ProtectHome=read-only
+PrivateTmp=yes
+ProtectKernelTunables=yes
+ProtectKernelModules=yes
+ProtectControlGroups=yes
+RestrictSUIDSGID=yes
+LockPersonality=yes
+`)
+	if len(config.ReadWritePaths) > 0 {
+		fmt.Fprintf(&unit, "ReadWritePaths=%s\n", strings.Join(config.ReadWritePaths, " "))
+	}
 
-[Install]
-WantedBy=multi-user.target
-`, config.Name, config.BinaryPath, args, workDir)
+	unit.WriteString("\n[Install]\nWantedBy=multi-user.target\n")
+	return unit.String()
 }
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("systemd service manager", func() {
+	Describe("generateSystemdUnit", func() {
+		It("generates a sandboxed unit logging to the journal", func() {
+			unit := generateSystemdUnit(ServiceConfig{Name: "k2s-test", BinaryPath: "/opt/k2s/bin/test", Args: []string{"--port", "80"}})
+
+			Expect(unit).To(ContainSubstring("Description=K2s k2s-test service\n"))
+			Expect(unit).To(ContainSubstring("After=network.target\n"))
+			Expect(unit).To(ContainSubstring("Type=simple\n"))
+			Expect(unit).To(ContainSubstring("ExecStart=/opt/k2s/bin/test --port 80\n"))
+			Expect(unit).To(ContainSubstring("WorkingDirectory=/opt/k2s/bin\n"))
+			Expect(unit).To(ContainSubstring("SyslogIdentifier=k2s-test\n"))
+			Expect(unit).To(ContainSubstring("NoNewPrivileges=yes\n"))
+			Expect(unit).To(ContainSubstring("ProtectSystem=strict\n"))
+			Expect(unit).To(ContainSubstring("ProtectHome=read-only\n"))
+			Expect(unit).ToNot(ContainSubstring("DynamicUser"))
+			Expect(unit).ToNot(ContainSubstring("WatchdogSec"))
+			Expect(unit).ToNot(ContainSubstring("ReadWritePaths"))
+		})
+
+		It("generates a notifying unit with watchdog, environment and dedicated user", func() {
+			unit := generateSystemdUnit(ServiceConfig{
+				Name:           "k2s-httpproxy",
+				Description:    "K2s local HTTP proxy",
+				BinaryPath:     "/opt/k2s/bin/httpproxy",
+				Environment:    []string{"NO_PROXY=localhost,.svc", `QUOTED="x"`},
+				NetworkOnline:  true,
+				DynamicUser:    true,
+				ReadWritePaths: []string{"/var/lib/k2s/cache", "/var/log/k2s"},
+				Notify:         true,
+				Watchdog:       30 * time.Second,
+			})
+
+			Expect(unit).To(ContainSubstring("Description=K2s local HTTP proxy\n"))
+			Expect(unit).To(ContainSubstring("After=network-online.target\nWants=network-online.target\n"))
+			Expect(unit).To(ContainSubstring("Type=notify\n"))
+			Expect(unit).To(ContainSubstring("WatchdogSec=30\n"))
+			Expect(unit).To(ContainSubstring("Environment=\"NO_PROXY=localhost,.svc\"\n"))
+			Expect(unit).To(ContainSubstring(`Environment="QUOTED=\"x\""` + "\n"))
+			Expect(unit).To(ContainSubstring("DynamicUser=yes\n"))
+			Expect(unit).To(ContainSubstring("ReadWritePaths=/var/lib/k2s/cache /var/log/k2s\n"))
+		})
+	})
+
+	Describe("InstallService", func() {
+		It("rejects a watchdog without readiness notification", func() {
+			err := (&SystemdServiceManager{}).InstallService(ServiceConfig{Name: "k2s-test", Watchdog: time.Minute})
+
+			Expect(err).To(MatchError(ContainSubstring("requires readiness notification")))
+		})
+	})
+
+	Describe("parseServiceStatus", func() {
+		It("parses a restarted service", func() {
+			status, result, startedAt := parseServiceStatus("k2s-httpproxy", "LoadState=loaded\nActiveState=active\nSubState=running\nNRestarts=2\nResult=success\nExecMainStartTimestamp=Sun 2026-10-18 09:12:01 UTC\n")
+
+			Expect(result).To(Equal("success"))
+			Expect(startedAt).To(Equal("Sun 2026-10-18 09:12:01 UTC"))
+			Expect(status.Name).To(Equal("k2s-httpproxy"))
+			Expect(status.Installed).To(BeTrue())
+			Expect(status.IsActive()).To(BeTrue())
+			Expect(status.SubState).To(Equal("running"))
+			Expect(status.RestartCount).To(Equal(2))
+		})
+
+		It("parses a failed service", func() {
+			status, result, _ := parseServiceStatus("kubelet", "LoadState=loaded\nActiveState=failed\nSubState=failed\nNRestarts=5\nResult=watchdog\n")
+
+			Expect(result).To(Equal("watchdog"))
+			Expect(status.IsActive()).To(BeFalse())
+			Expect(status.ActiveState).To(Equal("failed"))
+		})
+
+		It("parses a missing service", func() {
+			status, _, _ := parseServiceStatus("k2s-missing", "LoadState=not-found\nActiveState=inactive\nSubState=dead\nNRestarts=0\nResult=success\n")
+
+			Expect(status.Installed).To(BeFalse())
+			Expect(status.IsActive()).To(BeFalse())
+		})
+	})
+
+	Describe("journalErrorArgs", func() {
+		It("does not read the journal if the last run succeeded", func() {
+			Expect(journalErrorArgs("k2s-httpproxy", "success", "Sun 2026-10-18 09:12:01 UTC")).To(BeNil())
+		})
+
+		It("reads the errors since the start of the failed run", func() {
+			args := journalErrorArgs("kubelet", "exit-code", "Sun 2026-10-18 09:12:01 UTC")
+
+			Expect(args).To(ContainElements("--unit", "kubelet", "--priority", "err"))
+			Expect(args[len(args)-2:]).To(Equal([]string{"--since", "Sun 2026-10-18 09:12:01 UTC"}))
+		})
+
+		It("reads the errors of the current boot if the start time is unknown", func() {
+			Expect(journalErrorArgs("kubelet", "watchdog", "")).ToNot(ContainElement("--since"))
+		})
+	})
+
+	Describe("accessibleToOthers", func() {
+		var binary string
+
+		BeforeEach(func() {
+			dir := filepath.Join(GinkgoT().TempDir(), "bin")
+			Expect(os.Mkdir(dir, 0755)).To(Succeed())
+			binary = filepath.Join(dir, "httpproxy")
+			Expect(os.WriteFile(binary, []byte("#!/bin/sh\n"), 0755)).To(Succeed())
+			// TempDir creates its directories with mode 0700
+			for parent := filepath.Dir(dir); parent != os.TempDir() && parent != "/"; parent = filepath.Dir(parent) {
+				Expect(os.Chmod(parent, 0755)).To(Succeed())
+			}
+		})
+
+		It("accepts a binary reachable by others", func() {
+			if info, err := os.Stat(os.TempDir()); err != nil || info.Mode().Perm()&0001 == 0 {
+				Skip("temp directory is not accessible to others")
+			}
+
+			Expect(accessibleToOthers(binary)).To(BeTrue())
+		})
+
+		It("rejects a binary in a private directory", func() {
+			Expect(os.Chmod(filepath.Dir(binary), 0700)).To(Succeed())
+
+			Expect(accessibleToOthers(binary)).To(BeFalse())
+		})
+
+		It("rejects a binary not executable by others", func() {
+			Expect(os.Chmod(binary, 0750)).To(Succeed())
+
+			Expect(accessibleToOthers(binary)).To(BeFalse())
+		})
+
+		It("rejects a missing binary", func() {
+			Expect(accessibleToOthers(filepath.Join(filepath.Dir(binary), "missing"))).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,510 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	contracts "github.com/siemens-healthineers/k2s/internal/contracts/config"
+)
+
+const (
+	snapshotsDirName       = "snapshots"
+	snapshotMetadataFile   = "snapshot.json"
+	etcdSnapshotFile       = "etcd.db"
+	etcdutlFile            = "etcdutl"
+	etcdManifestPath       = "/etc/kubernetes/manifests/etcd.yaml"
+	etcdPKIDir             = "/etc/kubernetes/pki/etcd"
+	defaultEtcdDataDir     = "/var/lib/etcd"
+	snapshotAPIServerLimit = 120 * time.Second
+)
+
+var snapshotNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,62}$`)
+
+// etcdMember holds the settings of the local etcd member from its static Pod manifest, which a restored data
+// directory must match.
+type etcdMember struct {
+	name                     string
+	dataDir                  string
+	initialCluster           string
+	initialAdvertisePeerURLs string
+}
+
+// CreateSnapshot checkpoints the cluster: the etcd database of the native control plane and the disk of the
+// Windows worker VM. A running Windows worker is restarted, since its disk can only be snapshotted while stopped.
+func CreateSnapshot(cfg SnapshotConfig) (*Snapshot, error) {
+	if err := validateSnapshotName(cfg.Name); err != nil {
+		return nil, err
+	}
+	dir := snapshotDir(cfg.ConfigDir, cfg.Name)
+	if _, err := os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("snapshot '%s' already exists", cfg.Name)
+	}
+
+	member, err := readEtcdMember()
+	if err != nil {
+		return nil, err
+	}
+	etcdContainer, err := runningEtcdContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("[Snapshot] Creating snapshot", "name", cfg.Name)
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory %s: %w", dir, err)
+	}
+
+	snapshot := &Snapshot{Name: cfg.Name, CreatedAt: time.Now().UTC()}
+	if err := saveEtcdSnapshot(etcdContainer, member, dir); err != nil {
+		removeSnapshotDir(dir)
+		return nil, err
+	}
+	snapshot.Etcd = true
+
+	if worker := InstalledWindowsWorker(cfg.ConfigDir); worker != nil {
+		if err := snapshotWindowsWorker(worker, cfg.Name); err != nil {
+			removeSnapshotDir(dir)
+			return nil, err
+		}
+		snapshot.VMs = append(snapshot.VMs, worker.VMName())
+	}
+
+	if err := writeSnapshot(dir, snapshot); err != nil {
+		deleteVMSnapshots(snapshot)
+		removeSnapshotDir(dir)
+		return nil, err
+	}
+
+	slog.Info("[Snapshot] Snapshot created", "name", cfg.Name, "vms", snapshot.VMs)
+	return snapshot, nil
+}
+
+// ListSnapshots returns the snapshots in the setup config dir, oldest first.
+func ListSnapshots(configDir string) ([]Snapshot, error) {
+	entries, err := os.ReadDir(filepath.Join(configDir, snapshotsDirName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read snapshots: %w", err)
+	}
+
+	var snapshots []Snapshot
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		snapshot, err := readSnapshot(configDir, entry.Name())
+		if err != nil {
+			slog.Warn("[Snapshot] Skipping invalid snapshot", "name", entry.Name(), "error", err)
+			continue
+		}
+		snapshots = append(snapshots, *snapshot)
+	}
+	slices.SortFunc(snapshots, func(a, b Snapshot) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return snapshots, nil
+}
+
+// RestoreSnapshot resets the cluster to a snapshot. The Windows worker VM, kubelet and CRI-O are stopped, so that no
+// component writes to the cluster state while etcd and the VM disk are reset; the cluster is started afterwards.
+// It returns false if the restore was cancelled.
+func RestoreSnapshot(cfg SnapshotConfig) (bool, error) {
+	snapshot, err := readSnapshot(cfg.ConfigDir, cfg.Name)
+	if err != nil {
+		return false, err
+	}
+	dir := snapshotDir(cfg.ConfigDir, cfg.Name)
+
+	// fail before anything is stopped on snapshots that cannot be restored
+	var etcdutl string
+	var member *etcdMember
+	if snapshot.Etcd {
+		if etcdutl, err = findEtcdutl(dir); err != nil {
+			return false, err
+		}
+		if member, err = readEtcdMember(); err != nil {
+			return false, err
+		}
+	}
+	worker := InstalledWindowsWorker(cfg.ConfigDir)
+	vmManager := NewVMManager()
+	for _, vm := range snapshot.VMs {
+		if worker == nil || worker.VMName() != vm {
+			return false, fmt.Errorf("VM '%s' of snapshot '%s' is not installed anymore", vm, snapshot.Name)
+		}
+		tags, err := vmManager.ListSnapshots(vm)
+		if err != nil {
+			return false, err
+		}
+		if !slices.Contains(tags, snapshot.Name) {
+			return false, fmt.Errorf("disk snapshot '%s' of VM '%s' not found", snapshot.Name, vm)
+		}
+	}
+
+	question := fmt.Sprintf("Restoring snapshot '%s' stops the cluster and discards all changes since %s. Continue?", snapshot.Name, snapshot.CreatedAt.Local().Format(time.DateTime))
+	if cfg.Confirm != nil && !cfg.Confirm(question) {
+		slog.Info("[Snapshot] Restore cancelled by user", "name", snapshot.Name)
+		return false, nil
+	}
+
+	slog.Info("[Snapshot] Restoring snapshot", "name", snapshot.Name, "created", snapshot.CreatedAt)
+
+	if worker != nil {
+		if err := stopWindowsWorker(worker); err != nil {
+			return false, fmt.Errorf("failed to stop Windows worker: %w", err)
+		}
+	}
+	if err := stopControlPlaneForRestore(); err != nil {
+		return false, err
+	}
+
+	var errs []error
+	for _, vm := range snapshot.VMs {
+		if err := vmManager.RevertSnapshot(vm, snapshot.Name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if snapshot.Etcd {
+		if err := restoreEtcd(etcdutl, member, filepath.Join(dir, etcdSnapshotFile)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := startControlPlaneAfterRestore(); err != nil {
+		return false, errors.Join(append(errs, err)...)
+	}
+	if worker != nil {
+		if err := startWindowsWorker(worker); err != nil {
+			errs = append(errs, fmt.Errorf("failed to start Windows worker: %w", err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return false, err
+	}
+
+	slog.Info("[Snapshot] Snapshot restored", "name", snapshot.Name)
+	return true, nil
+}
+
+// DeleteSnapshot removes a snapshot including the disk snapshots of its VMs. If a disk snapshot cannot be deleted,
+// the snapshot is kept so that the deletion can be repeated.
+func DeleteSnapshot(cfg SnapshotConfig) error {
+	snapshot, err := readSnapshot(cfg.ConfigDir, cfg.Name)
+	if err != nil {
+		return err
+	}
+
+	slog.Info("[Snapshot] Deleting snapshot", "name", snapshot.Name)
+
+	if err := deleteVMSnapshots(snapshot); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(snapshotDir(cfg.ConfigDir, cfg.Name)); err != nil {
+		return fmt.Errorf("failed to remove snapshot '%s': %w", snapshot.Name, err)
+	}
+	return nil
+}
+
+func validateSnapshotName(name string) error {
+	if !snapshotNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid snapshot name '%s': use up to 63 letters, digits, '.', '_' or '-', starting with a letter or digit", name)
+	}
+	return nil
+}
+
+func snapshotDir(configDir, name string) string {
+	return filepath.Join(configDir, snapshotsDirName, name)
+}
+
+func readSnapshot(configDir, name string) (*Snapshot, error) {
+	if err := validateSnapshotName(name); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(snapshotDir(configDir, name), snapshotMetadataFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("snapshot '%s' not found", name)
+		}
+		return nil, fmt.Errorf("failed to read snapshot '%s': %w", name, err)
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot '%s': %w", name, err)
+	}
+	if snapshot.Name != name {
+		return nil, fmt.Errorf("snapshot directory '%s' holds snapshot '%s'", name, snapshot.Name)
+	}
+	return &snapshot, nil
+}
+
+func writeSnapshot(dir string, snapshot *Snapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize snapshot '%s': %w", snapshot.Name, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, snapshotMetadataFile), data, 0600); err != nil {
+		return fmt.Errorf("failed to write snapshot '%s': %w", snapshot.Name, err)
+	}
+	return nil
+}
+
+func removeSnapshotDir(dir string) {
+	if err := os.RemoveAll(dir); err != nil {
+		slog.Warn("[Snapshot] Could not remove incomplete snapshot", "path", dir, "error", err)
+	}
+}
+
+// deleteVMSnapshots deletes the disk snapshots of the snapshot; VMs or disk snapshots that do not exist anymore are
+// skipped.
+func deleteVMSnapshots(snapshot *Snapshot) error {
+	vmManager := NewVMManager()
+	var errs []error
+	for _, vm := range snapshot.VMs {
+		exists, err := vmManager.VMExists(vm)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("determine whether VM '%s' exists: %w", vm, err))
+			continue
+		}
+		if !exists {
+			continue
+		}
+		tags, err := vmManager.ListSnapshots(vm)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !slices.Contains(tags, snapshot.Name) {
+			continue
+		}
+		if err := vmManager.DeleteSnapshot(vm, snapshot.Name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// snapshotWindowsWorker creates the disk snapshot of the Windows worker VM, stopping and restarting a running VM.
+func snapshotWindowsWorker(worker *contracts.K2sWindowsWorkerConfig, name string) error {
+	vmManager := NewVMManager()
+	running, err := vmManager.VMIsRunning(worker.VMName())
+	if err != nil {
+		return fmt.Errorf("failed to determine state of Windows worker VM: %w", err)
+	}
+	if !running {
+		return vmManager.CreateSnapshot(worker.VMName(), name)
+	}
+
+	slog.Info("[Snapshot] Stopping Windows worker VM for its disk snapshot", "vm", worker.VMName())
+	if err := vmManager.StopVM(worker.VMName()); err != nil {
+		return err
+	}
+	snapshotErr := vmManager.CreateSnapshot(worker.VMName(), name)
+	return errors.Join(snapshotErr, startWindowsWorker(worker))
+}
+
+// readEtcdMember reads the settings of the local etcd member from its static Pod manifest.
+func readEtcdMember() (*etcdMember, error) {
+	manifest, err := os.ReadFile(etcdManifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read etcd manifest; snapshots require the native control plane: %w", err)
+	}
+	return parseEtcdManifest(string(manifest))
+}
+
+func parseEtcdManifest(manifest string) (*etcdMember, error) {
+	member := &etcdMember{dataDir: defaultEtcdDataDir}
+	for line := range strings.Lines(manifest) {
+		arg := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "-"))
+		flag, value, found := strings.Cut(arg, "=")
+		if !found {
+			continue
+		}
+		switch flag {
+		case "--name":
+			member.name = value
+		case "--data-dir":
+			member.dataDir = value
+		case "--initial-cluster":
+			member.initialCluster = value
+		case "--initial-advertise-peer-urls":
+			member.initialAdvertisePeerURLs = value
+		}
+	}
+	if member.name == "" || member.initialCluster == "" || member.initialAdvertisePeerURLs == "" {
+		return nil, fmt.Errorf("etcd manifest lacks the member name, initial cluster or peer URLs")
+	}
+	return member, nil
+}
+
+func runningEtcdContainer() (string, error) {
+	output, err := runCommandOutput("crictl", "--runtime-endpoint", crioSocket, "ps", "--name", "^etcd$", "--state", "running", "-q")
+	if err != nil {
+		return "", fmt.Errorf("failed to look up etcd container: %w", err)
+	}
+	ids := strings.Fields(output)
+	if len(ids) == 0 {
+		return "", fmt.Errorf("etcd is not running; start the cluster with 'k2s start'")
+	}
+	return ids[0], nil
+}
+
+// saveEtcdSnapshot saves the etcd database with etcdctl in the etcd container, together with the etcdutl binary of
+// the same etcd version, which restores the database while the container runtime is stopped.
+func saveEtcdSnapshot(containerID string, member *etcdMember, dir string) error {
+	slog.Info("[Snapshot] Saving etcd snapshot")
+
+	// the data dir is mounted into the etcd container at the same path
+	containerPath := filepath.Join(member.dataDir, "k2s-snapshot.db")
+	defer os.Remove(containerPath)
+
+	if err := runCommand("crictl", "--runtime-endpoint", crioSocket, "exec", containerID,
+		"etcdctl", "--endpoints=https://127.0.0.1:2379",
+		"--cacert="+filepath.Join(etcdPKIDir, "ca.crt"),
+		"--cert="+filepath.Join(etcdPKIDir, "healthcheck-client.crt"),
+		"--key="+filepath.Join(etcdPKIDir, "healthcheck-client.key"),
+		"snapshot", "save", containerPath); err != nil {
+		return fmt.Errorf("failed to save etcd snapshot: %w", err)
+	}
+	if err := copyFile(containerPath, filepath.Join(dir, etcdSnapshotFile)); err != nil {
+		return fmt.Errorf("failed to store etcd snapshot: %w", err)
+	}
+
+	rootfs, err := containerRootfs(containerID)
+	if err == nil {
+		err = copyFile(filepath.Join(rootfs, "usr", "local", "bin", etcdutlFile), filepath.Join(dir, etcdutlFile))
+	}
+	if err == nil {
+		err = os.Chmod(filepath.Join(dir, etcdutlFile), 0700)
+	}
+	if err != nil {
+		slog.Warn("[Snapshot] Could not store etcdutl with the snapshot; restoring requires etcdutl in PATH", "error", err)
+	}
+	return nil
+}
+
+func containerRootfs(containerID string) (string, error) {
+	output, err := runCommandOutput("crictl", "--runtime-endpoint", crioSocket, "inspect", containerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect container: %w", err)
+	}
+	var inspection struct {
+		Info struct {
+			RuntimeSpec struct {
+				Root struct {
+					Path string `json:"path"`
+				} `json:"root"`
+			} `json:"runtimeSpec"`
+		} `json:"info"`
+	}
+	if err := json.Unmarshal([]byte(output), &inspection); err != nil {
+		return "", fmt.Errorf("failed to parse container inspection: %w", err)
+	}
+	if inspection.Info.RuntimeSpec.Root.Path == "" {
+		return "", fmt.Errorf("root filesystem of container not found")
+	}
+	return inspection.Info.RuntimeSpec.Root.Path, nil
+}
+
+// findEtcdutl prefers the etcdutl stored with the snapshot over the one in PATH.
+func findEtcdutl(dir string) (string, error) {
+	stored := filepath.Join(dir, etcdutlFile)
+	if _, err := os.Stat(stored); err == nil {
+		return stored, nil
+	}
+	path, err := exec.LookPath(etcdutlFile)
+	if err != nil {
+		return "", fmt.Errorf("etcdutl is required to restore the etcd snapshot but neither stored with the snapshot nor found in PATH: %w", err)
+	}
+	return path, nil
+}
+
+// stopControlPlaneForRestore stops kubelet, all Pods including the static control-plane Pods and CRI-O; stopping
+// CRI-O alone leaves the containers running.
+func stopControlPlaneForRestore() error {
+	slog.Info("[Snapshot] Stopping kubelet and CRI-O")
+
+	if err := runCommand("systemctl", "stop", "kubelet"); err != nil {
+		return fmt.Errorf("failed to stop kubelet: %w", err)
+	}
+	if err := runCommand("systemctl", "is-active", "--quiet", crioServiceName); err != nil {
+		// a stopped cluster has no Pods running
+		return nil
+	}
+	output, err := runCommandOutput("crictl", "--runtime-endpoint", crioSocket, "pods", "-q")
+	if err != nil {
+		return fmt.Errorf("failed to list Pods: %w", err)
+	}
+	if pods := strings.Fields(output); len(pods) > 0 {
+		args := append([]string{"--runtime-endpoint", crioSocket, "stopp"}, pods...)
+		if err := runCommand("crictl", args...); err != nil {
+			return fmt.Errorf("failed to stop Pods: %w", err)
+		}
+	}
+	if err := runCommand("systemctl", "stop", crioServiceName); err != nil {
+		return fmt.Errorf("failed to stop CRI-O: %w", err)
+	}
+	return nil
+}
+
+func startControlPlaneAfterRestore() error {
+	slog.Info("[Snapshot] Starting CRI-O and kubelet")
+
+	if err := runCommand("systemctl", "start", crioServiceName); err != nil {
+		return fmt.Errorf("failed to start CRI-O: %w", err)
+	}
+	if err := runCommand("systemctl", "start", "kubelet"); err != nil {
+		return fmt.Errorf("failed to start kubelet: %w", err)
+	}
+	return (&LinuxOrchestrator{}).waitForAPIServer(snapshotAPIServerLimit)
+}
+
+// restoreEtcd replaces the etcd data dir with the restored snapshot. The replaced data dir is kept next to it until
+// the next restore, so that it can be recovered manually.
+func restoreEtcd(etcdutl string, member *etcdMember, snapshotPath string) error {
+	slog.Info("[Snapshot] Restoring etcd snapshot", "dataDir", member.dataDir)
+
+	restoreDir := member.dataDir + ".k2s-restore"
+	previousDir := member.dataDir + ".k2s-previous"
+	if err := os.RemoveAll(restoreDir); err != nil {
+		return fmt.Errorf("failed to clean up %s: %w", restoreDir, err)
+	}
+	if err := runCommand(etcdutl, "snapshot", "restore", snapshotPath,
+		"--data-dir", restoreDir,
+		"--name", member.name,
+		"--initial-cluster", member.initialCluster,
+		"--initial-advertise-peer-urls", member.initialAdvertisePeerURLs); err != nil {
+		return fmt.Errorf("failed to restore etcd snapshot: %w", err)
+	}
+
+	if err := os.RemoveAll(previousDir); err != nil {
+		return fmt.Errorf("failed to clean up %s: %w", previousDir, err)
+	}
+	if err := os.Rename(member.dataDir, previousDir); err != nil {
+		return fmt.Errorf("failed to move etcd data dir aside: %w", err)
+	}
+	if err := os.Rename(restoreDir, member.dataDir); err != nil {
+		if rollbackErr := os.Rename(previousDir, member.dataDir); rollbackErr != nil {
+			err = errors.Join(err, rollbackErr)
+		}
+		return fmt.Errorf("failed to replace etcd data dir: %w", err)
+	}
+	slog.Info("[Snapshot] Previous etcd data kept until the next restore", "path", previousDir)
+	return nil
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const etcdManifest = `apiVersion: v1
+kind: Pod
+metadata:
+  name: etcd
+  namespace: kube-system
+spec:
+  containers:
+  - command:
+    - etcd
+    - --advertise-client-urls=https://192.168.0.10:2379
+    - --data-dir=/var/lib/etcd
+    - --initial-advertise-peer-urls=https://192.168.0.10:2380
+    - --initial-cluster=my-host=https://192.168.0.10:2380
+    - --initial-cluster-state=new
+    - --name=my-host
+    image: registry.k8s.io/etcd:3.5.21-0
+`
+
+var _ = Describe("snapshots", func() {
+	Describe("parseEtcdManifest", func() {
+		It("reads the member settings from the static Pod manifest", func() {
+			member, err := parseEtcdManifest(etcdManifest)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*member).To(Equal(etcdMember{
+				name:                     "my-host",
+				dataDir:                  "/var/lib/etcd",
+				initialCluster:           "my-host=https://192.168.0.10:2380",
+				initialAdvertisePeerURLs: "https://192.168.0.10:2380",
+			}))
+		})
+
+		It("defaults the data dir", func() {
+			member, err := parseEtcdManifest("    - --name=my-host\n    - --initial-cluster=my-host=https://10.0.0.1:2380\n    - --initial-advertise-peer-urls=https://10.0.0.1:2380\n")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(member.dataDir).To(Equal(defaultEtcdDataDir))
+		})
+
+		It("fails if member settings are missing", func() {
+			_, err := parseEtcdManifest("    - --data-dir=/var/lib/etcd\n")
+
+			Expect(err).To(MatchError(ContainSubstring("etcd manifest lacks")))
+		})
+	})
+
+	Describe("parseDiskSnapshots", func() {
+		It("returns the snapshot tags", func() {
+			output := `Snapshot list:
+ID        TAG               VM SIZE                DATE        VM CLOCK     ICOUNT
+1         before-upgrade        0 B 2026-10-18 09:05:07  0000:00:00.000          0
+2         snapshot-20261018-100000      0 B 2026-10-18 10:00:00  0000:00:00.000          0
+`
+
+			Expect(parseDiskSnapshots(output)).To(Equal([]string{"before-upgrade", "snapshot-20261018-100000"}))
+		})
+
+		It("returns nothing for disks without snapshots", func() {
+			Expect(parseDiskSnapshots("")).To(BeEmpty())
+		})
+	})
+
+	Describe("validateSnapshotName", func() {
+		DescribeTable("accepts names usable as directory and disk snapshot tag",
+			func(name string, valid bool) {
+				err := validateSnapshotName(name)
+
+				if valid {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(ContainSubstring("invalid snapshot name")))
+				}
+			},
+			Entry("plain", "before-upgrade", true),
+			Entry("with dots and underscores", "v1.2_pre", true),
+			Entry("empty", "", false),
+			Entry("path", "../etc", false),
+			Entry("with spaces", "before upgrade", false),
+			Entry("leading dash", "-x", false),
+		)
+	})
+
+	Describe("ListSnapshots", func() {
+		var configDir string
+
+		BeforeEach(func() {
+			configDir = GinkgoT().TempDir()
+		})
+
+		It("returns no snapshots if none were created", func() {
+			Expect(ListSnapshots(configDir)).To(BeEmpty())
+		})
+
+		It("returns the snapshots oldest first and skips invalid ones", func() {
+			created := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+			for _, snapshot := range []Snapshot{
+				{Name: "newer", CreatedAt: created.Add(time.Hour), Etcd: true},
+				{Name: "older", CreatedAt: created, Etcd: true, VMs: []string{"k2s-win-worker"}},
+			} {
+				dir := snapshotDir(configDir, snapshot.Name)
+				Expect(os.MkdirAll(dir, 0700)).To(Succeed())
+				Expect(writeSnapshot(dir, &snapshot)).To(Succeed())
+			}
+			Expect(os.MkdirAll(snapshotDir(configDir, "incomplete"), 0700)).To(Succeed())
+
+			snapshots, err := ListSnapshots(configDir)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(snapshots).To(Equal([]Snapshot{
+				{Name: "older", CreatedAt: created, Etcd: true, VMs: []string{"k2s-win-worker"}},
+				{Name: "newer", CreatedAt: created.Add(time.Hour), Etcd: true},
+			}))
+		})
+
+		It("fails to read snapshots that do not exist", func() {
+			_, err := readSnapshot(configDir, "missing")
+
+			Expect(err).To(MatchError("snapshot 'missing' not found"))
+		})
+
+		It("rejects metadata of another snapshot", func() {
+			dir := snapshotDir(configDir, "copy")
+			Expect(os.MkdirAll(dir, 0700)).To(Succeed())
+			Expect(writeSnapshot(dir, &Snapshot{Name: "original"})).To(Succeed())
+
+			_, err := readSnapshot(configDir, "copy")
+
+			Expect(err).To(MatchError("snapshot directory 'copy' holds snapshot 'original'"))
+			Expect(filepath.Join(dir, snapshotMetadataFile)).To(BeAnExistingFile())
+		})
+	})
+})
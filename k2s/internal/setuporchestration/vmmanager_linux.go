@@ -6,11 +6,16 @@
 package setuporchestration
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	_ "embed"
@@ -19,6 +24,16 @@ import (
 //go:embed libvirt_domain.xml.tmpl
 var domainXMLTemplate string
 
+// Elements of the domain XML changed by UpdateVM, in the format written by 'virsh dumpxml'.
+var (
+	domainVCPUPattern          = regexp.MustCompile(`<vcpu([^>]*)>\d+</vcpu>`)
+	domainCoresPattern         = regexp.MustCompile(`(<topology[^>]*\scores=')\d+(')`)
+	domainMemoryPattern        = regexp.MustCompile(`<memory(?:\s+unit='\w+')?>\d+</memory>`)
+	domainCurrentMemoryPattern = regexp.MustCompile(`<currentMemory(?:\s+unit='\w+')?>\d+</currentMemory>`)
+	domainBalloonPattern       = regexp.MustCompile(`<memballoon model='virtio'[^>]*?(/?)>`)
+	domainDiskSourcePattern    = regexp.MustCompile(`(?s)<disk type='file' device='disk'>.*?<source file='([^']+)'`)
+)
+
 // LibvirtVMManager implements VMManager using libvirt/KVM via the virsh CLI.
 type LibvirtVMManager struct{}
 
@@ -29,13 +44,15 @@ func NewVMManager() VMManager {
 
 // domainTemplateData holds values substituted into the libvirt domain XML template.
 type domainTemplateData struct {
-	Name          string
-	MemoryKB      int
-	CPUCount      int
-	DiskPath      string
-	NetworkBridge string
-	FirmwarePath  string
-	NVRAMPath     string
+	Name            string
+	MemoryKB        int
+	CurrentMemoryKB int
+	DynamicMemory   bool
+	CPUCount        int
+	DiskPath        string
+	NetworkBridge   string
+	FirmwarePath    string
+	NVRAMPath       string
 }
 
 func (m *LibvirtVMManager) CreateVM(config VMConfig) error {
@@ -51,14 +68,21 @@ func (m *LibvirtVMManager) CreateVM(config VMConfig) error {
 	nvramDir := filepath.Dir(config.ImagePath)
 	nvramPath := filepath.Join(nvramDir, config.Name+"_VARS.fd")
 
+	maxMemoryMB, err := maxMemory(config)
+	if err != nil {
+		return err
+	}
+
 	data := domainTemplateData{
-		Name:          config.Name,
-		MemoryKB:      config.MemoryMB * 1024,
-		CPUCount:      config.CPUCount,
-		DiskPath:      config.ImagePath,
-		NetworkBridge:  config.NetworkBridge,
-		FirmwarePath:  firmwarePath,
-		NVRAMPath:     nvramPath,
+		Name:            config.Name,
+		MemoryKB:        maxMemoryMB * 1024,
+		CurrentMemoryKB: config.MemoryMB * 1024,
+		DynamicMemory:   config.DynamicMemory,
+		CPUCount:        config.CPUCount,
+		DiskPath:        config.ImagePath,
+		NetworkBridge:   config.NetworkBridge,
+		FirmwarePath:    firmwarePath,
+		NVRAMPath:       nvramPath,
 	}
 
 	// Render domain XML (user-customised template takes precedence over embedded default)
@@ -154,6 +178,388 @@ func (m *LibvirtVMManager) VMExists(name string) (bool, error) {
 	return strings.Contains(output, "Name:"), nil
 }
 
+// vmUpdate is a validated resource change of a VM.
+type vmUpdate struct {
+	domainXML  string
+	updatedXML string
+	// diskPath is empty if the disk keeps its size
+	diskPath string
+}
+
+func (m *LibvirtVMManager) ValidateUpdate(config VMConfig) error {
+	_, err := m.planUpdate(config)
+	return err
+}
+
+func (m *LibvirtVMManager) UpdateVM(config VMConfig) error {
+	slog.Info("[VMManager] Updating VM", "name", config.Name, "cpus", config.CPUCount, "memoryMB", config.MemoryMB, "maxMemoryMB", config.MaxMemoryMB, "diskGB", config.DiskSizeGB)
+
+	running, err := m.VMIsRunning(config.Name)
+	if err != nil {
+		return err
+	}
+	if running {
+		return fmt.Errorf("VM '%s' must be stopped before its resources can be changed", config.Name)
+	}
+
+	update, err := m.planUpdate(config)
+	if err != nil {
+		return err
+	}
+
+	redefined := false
+	if update.updatedXML != update.domainXML {
+		if err := defineDomain(update.updatedXML); err != nil {
+			return fmt.Errorf("failed to redefine VM '%s': %w", config.Name, err)
+		}
+		redefined = true
+	}
+	// the disk is resized last, since a grown disk cannot be shrunk again
+	if update.diskPath != "" {
+		if err := resizeDisk(update.diskPath, config.DiskSizeGB); err != nil {
+			err = fmt.Errorf("failed to resize disk of VM '%s': %w", config.Name, err)
+			if redefined {
+				slog.Warn("[VMManager] Restoring previous definition of VM", "name", config.Name)
+				if rollbackErr := defineDomain(update.domainXML); rollbackErr != nil {
+					err = errors.Join(err, fmt.Errorf("failed to restore previous definition of VM '%s': %w", config.Name, rollbackErr))
+				}
+			}
+			return err
+		}
+	}
+
+	slog.Info("[VMManager] VM updated", "name", config.Name)
+	return nil
+}
+
+// planUpdate validates the resource changes against the current definition and disk of the VM: the disk must not
+// shrink and the host must have free space for its growth.
+func (m *LibvirtVMManager) planUpdate(config VMConfig) (*vmUpdate, error) {
+	domainXML, err := runCommandOutput("virsh", "dumpxml", "--inactive", config.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read definition of VM '%s': %w", config.Name, err)
+	}
+	updatedXML, err := updateDomainXML(domainXML, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update definition of VM '%s': %w", config.Name, err)
+	}
+	update := &vmUpdate{domainXML: domainXML, updatedXML: updatedXML}
+
+	if config.DiskSizeGB <= 0 {
+		return update, nil
+	}
+	match := domainDiskSourcePattern.FindStringSubmatch(domainXML)
+	if match == nil {
+		return nil, fmt.Errorf("no disk image found in definition of VM '%s'", config.Name)
+	}
+	diskPath := match[1]
+
+	currentBytes, err := diskVirtualSize(diskPath)
+	if err != nil {
+		return nil, err
+	}
+	requestedBytes := int64(config.DiskSizeGB) << 30
+	switch {
+	case requestedBytes < currentBytes:
+		return nil, fmt.Errorf("disk of VM '%s' cannot shrink from %dGB to %dGB", config.Name, currentBytes>>30, config.DiskSizeGB)
+	case requestedBytes > currentBytes:
+		// the image grows on demand, but the Windows partition is extended to the full size
+		if err := checkFreeSpace(filepath.Dir(diskPath), requestedBytes-currentBytes); err != nil {
+			return nil, fmt.Errorf("disk of VM '%s' cannot grow to %dGB: %w", config.Name, config.DiskSizeGB, err)
+		}
+		update.diskPath = diskPath
+	}
+	return update, nil
+}
+
+// updateDomainXML applies the CPU and memory settings of the config to a domain XML; zero values keep the current
+// setting. With dynamic memory, the balloon driver may return unused memory up to the startup memory to the host.
+func updateDomainXML(domainXML string, config VMConfig) (string, error) {
+	if config.CPUCount > 0 {
+		if !domainVCPUPattern.MatchString(domainXML) {
+			return "", fmt.Errorf("vcpu element not found")
+		}
+		domainXML = domainVCPUPattern.ReplaceAllString(domainXML, fmt.Sprintf("<vcpu${1}>%d</vcpu>", config.CPUCount))
+		// the CPU topology must match the number of vCPUs
+		domainXML = domainCoresPattern.ReplaceAllString(domainXML, fmt.Sprintf("${1}%d${2}", config.CPUCount))
+	}
+
+	if config.MemoryMB > 0 {
+		maxMemoryMB, err := maxMemory(config)
+		if err != nil {
+			return "", err
+		}
+		if !domainMemoryPattern.MatchString(domainXML) {
+			return "", fmt.Errorf("memory element not found")
+		}
+		domainXML = domainMemoryPattern.ReplaceAllString(domainXML, fmt.Sprintf("<memory unit='KiB'>%d</memory>", maxMemoryMB*1024))
+
+		currentMemory := fmt.Sprintf("<currentMemory unit='KiB'>%d</currentMemory>", config.MemoryMB*1024)
+		if domainCurrentMemoryPattern.MatchString(domainXML) {
+			domainXML = domainCurrentMemoryPattern.ReplaceAllString(domainXML, currentMemory)
+		} else {
+			domainXML = domainMemoryPattern.ReplaceAllStringFunc(domainXML, func(memory string) string {
+				return memory + "\n  " + currentMemory
+			})
+		}
+
+		balloon := "<memballoon model='virtio'${1}>"
+		if config.DynamicMemory {
+			balloon = "<memballoon model='virtio' autodeflate='on' freePageReporting='on'${1}>"
+		}
+		domainXML = domainBalloonPattern.ReplaceAllString(domainXML, balloon)
+	}
+	return domainXML, nil
+}
+
+// maxMemory returns the upper memory limit of the VM, which is the startup memory without dynamic memory.
+func maxMemory(config VMConfig) (int, error) {
+	if !config.DynamicMemory || config.MaxMemoryMB == 0 {
+		return config.MemoryMB, nil
+	}
+	if config.MaxMemoryMB < config.MemoryMB {
+		return 0, fmt.Errorf("maximum memory (%dMB) must not be less than the startup memory (%dMB)", config.MaxMemoryMB, config.MemoryMB)
+	}
+	return config.MaxMemoryMB, nil
+}
+
+// diskVirtualSize returns the size of the disk image as seen by the VM in bytes.
+func diskVirtualSize(imagePath string) (int64, error) {
+	output, err := runCommandOutput("qemu-img", "info", "--output=json", imagePath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to inspect disk image '%s': %w", imagePath, err)
+	}
+	var info struct {
+		VirtualSize json.Number `json:"virtual-size"`
+	}
+	if err := json.Unmarshal([]byte(output), &info); err != nil {
+		return 0, fmt.Errorf("failed to parse disk image info of '%s': %w", imagePath, err)
+	}
+	size, err := strconv.ParseInt(info.VirtualSize.String(), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid virtual size of disk image '%s': %w", imagePath, err)
+	}
+	return size, nil
+}
+
+func defineDomain(domainXML string) error {
+	tmpFile, err := os.CreateTemp("", "k2s-vm-*.xml")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file for domain XML: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.WriteString(domainXML); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to write domain XML: %w", err)
+	}
+	tmpFile.Close()
+
+	return runCommand("virsh", "define", tmpFile.Name())
+}
+
+// The snapshots are internal QCOW2 snapshots of the VM disk. libvirt cannot snapshot VMs with UEFI pflash firmware
+// internally, so they are managed with qemu-img on the disk of the stopped VM.
+
+func (m *LibvirtVMManager) CreateSnapshot(vmName, snapshotName string) error {
+	slog.Info("[VMManager] Creating snapshot", "vm", vmName, "snapshot", snapshotName)
+
+	diskPath, err := m.stoppedVMDiskPath(vmName)
+	if err != nil {
+		return err
+	}
+	if err := runCommand("qemu-img", "snapshot", "-c", snapshotName, diskPath); err != nil {
+		return fmt.Errorf("failed to create snapshot '%s' of VM '%s': %w", snapshotName, vmName, err)
+	}
+	return nil
+}
+
+func (m *LibvirtVMManager) ListSnapshots(vmName string) ([]string, error) {
+	diskPath, err := vmDiskPath(vmName)
+	if err != nil {
+		return nil, err
+	}
+	// -U reads the snapshot table while the VM is running
+	output, err := runCommandOutput("qemu-img", "snapshot", "-l", "-U", diskPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots of VM '%s': %w", vmName, err)
+	}
+	return parseDiskSnapshots(output), nil
+}
+
+func (m *LibvirtVMManager) RevertSnapshot(vmName, snapshotName string) error {
+	slog.Info("[VMManager] Reverting to snapshot", "vm", vmName, "snapshot", snapshotName)
+
+	diskPath, err := m.stoppedVMDiskPath(vmName)
+	if err != nil {
+		return err
+	}
+	if err := runCommand("qemu-img", "snapshot", "-a", snapshotName, diskPath); err != nil {
+		return fmt.Errorf("failed to revert VM '%s' to snapshot '%s': %w", vmName, snapshotName, err)
+	}
+	return nil
+}
+
+func (m *LibvirtVMManager) DeleteSnapshot(vmName, snapshotName string) error {
+	slog.Info("[VMManager] Deleting snapshot", "vm", vmName, "snapshot", snapshotName)
+
+	running, err := m.VMIsRunning(vmName)
+	if err != nil {
+		return err
+	}
+	if running {
+		// the disk is locked by QEMU, so the running VM deletes the snapshot itself
+		output, err := runCommandOutput("virsh", "qemu-monitor-command", vmName, "--hmp", "delvm "+snapshotName)
+		if err == nil && strings.TrimSpace(output) != "" {
+			err = errors.New(strings.TrimSpace(output))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete snapshot '%s' of running VM '%s': %w", snapshotName, vmName, err)
+		}
+		return nil
+	}
+
+	diskPath, err := vmDiskPath(vmName)
+	if err != nil {
+		return err
+	}
+	if err := runCommand("qemu-img", "snapshot", "-d", snapshotName, diskPath); err != nil {
+		return fmt.Errorf("failed to delete snapshot '%s' of VM '%s': %w", snapshotName, vmName, err)
+	}
+	return nil
+}
+
+func (m *LibvirtVMManager) CompactDisk(vmName string) (*DiskCompaction, error) {
+	diskPath, err := m.stoppedVMDiskPath(vmName)
+	if err != nil {
+		return nil, err
+	}
+	// converting a disk drops its internal snapshots
+	snapshots, err := m.ListSnapshots(vmName)
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshots) > 0 {
+		return nil, fmt.Errorf("disk of VM '%s' has %d snapshot(s), which compaction would drop; delete them with 'k2s system snapshot delete' first", vmName, len(snapshots))
+	}
+
+	info, err := os.Stat(diskPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect disk image '%s': %w", diskPath, err)
+	}
+	compaction := &DiskCompaction{Path: diskPath, SizeBefore: allocatedSize(info)}
+
+	// the compacted image never exceeds the current one
+	if err := checkFreeSpace(filepath.Dir(diskPath), compaction.SizeBefore); err != nil {
+		return nil, err
+	}
+
+	slog.Info("[VMManager] Compacting disk", "vm", vmName, "path", diskPath, "allocatedBytes", compaction.SizeBefore)
+
+	compactPath := diskPath + ".compact"
+	_ = os.Remove(compactPath)
+	if err := runCommand("qemu-img", "convert", "-O", "qcow2", diskPath, compactPath); err != nil {
+		_ = os.Remove(compactPath)
+		return nil, fmt.Errorf("failed to compact disk of VM '%s': %w", vmName, err)
+	}
+	if err := replaceDiskImage(compactPath, diskPath, info); err != nil {
+		_ = os.Remove(compactPath)
+		return nil, fmt.Errorf("failed to replace disk of VM '%s': %w", vmName, err)
+	}
+
+	info, err = os.Stat(diskPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect compacted disk image '%s': %w", diskPath, err)
+	}
+	compaction.SizeAfter = allocatedSize(info)
+
+	slog.Info("[VMManager] Disk compacted", "vm", vmName, "allocatedBytes", compaction.SizeAfter)
+	return compaction, nil
+}
+
+// replaceDiskImage atomically swaps the new image in for the original one, taking over its mode and ownership.
+func replaceDiskImage(newPath, originalPath string, original os.FileInfo) error {
+	if err := os.Chmod(newPath, original.Mode().Perm()); err != nil {
+		return err
+	}
+	if stat, ok := original.Sys().(*syscall.Stat_t); ok {
+		if err := os.Chown(newPath, int(stat.Uid), int(stat.Gid)); err != nil {
+			return err
+		}
+	}
+	// the image must be on disk before it replaces the original
+	file, err := os.Open(newPath)
+	if err != nil {
+		return err
+	}
+	syncErr := file.Sync()
+	if err := errors.Join(syncErr, file.Close()); err != nil {
+		return err
+	}
+	return os.Rename(newPath, originalPath)
+}
+
+// allocatedSize returns the bytes a file occupies on disk, which is less than its size for sparse files.
+func allocatedSize(info os.FileInfo) int64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return stat.Blocks * 512
+	}
+	return info.Size()
+}
+
+func checkFreeSpace(dir string, required int64) error {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return fmt.Errorf("failed to determine free space in %s: %w", dir, err)
+	}
+	if available := int64(stat.Bavail) * stat.Bsize; available < required {
+		return fmt.Errorf("insufficient free space in %s: %dMB required, %dMB available", dir, required>>20, available>>20)
+	}
+	return nil
+}
+
+func (m *LibvirtVMManager) stoppedVMDiskPath(vmName string) (string, error) {
+	running, err := m.VMIsRunning(vmName)
+	if err != nil {
+		return "", err
+	}
+	if running {
+		return "", fmt.Errorf("VM '%s' must be stopped for disk snapshots", vmName)
+	}
+	return vmDiskPath(vmName)
+}
+
+// vmDiskPath returns the path of the disk image of a VM.
+func vmDiskPath(vmName string) (string, error) {
+	domainXML, err := runCommandOutput("virsh", "dumpxml", "--inactive", vmName)
+	if err != nil {
+		return "", fmt.Errorf("failed to read definition of VM '%s': %w", vmName, err)
+	}
+	match := domainDiskSourcePattern.FindStringSubmatch(domainXML)
+	if match == nil {
+		return "", fmt.Errorf("no disk image found in definition of VM '%s'", vmName)
+	}
+	return match[1], nil
+}
+
+// parseDiskSnapshots returns the snapshot tags of a 'qemu-img snapshot -l' output.
+func parseDiskSnapshots(output string) []string {
+	var tags []string
+	tableStarted := false
+	for line := range strings.Lines(output) {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		if !tableStarted {
+			tableStarted = fields[0] == "ID" && fields[1] == "TAG"
+			continue
+		}
+		tags = append(tags, fields[1])
+	}
+	return tags
+}
+
 func (m *LibvirtVMManager) VMIsRunning(name string) (bool, error) {
 	output, err := runCommandOutput("virsh", "domstate", name)
 	if err != nil {
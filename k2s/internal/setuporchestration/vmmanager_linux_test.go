@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const inactiveDomainXML = `<domain type='kvm'>
+  <name>k2s-win-worker</name>
+  <memory unit='KiB'>4194304</memory>
+  <currentMemory unit='KiB'>4194304</currentMemory>
+  <vcpu placement='static'>2</vcpu>
+  <cpu mode='host-passthrough' check='none' migratable='on'>
+    <topology sockets='1' dies='1' cores='2' threads='1'/>
+  </cpu>
+  <devices>
+    <disk type='file' device='disk'>
+      <driver name='qemu' type='qcow2'/>
+      <source file='/var/lib/k2s/vm/k2s-win-worker.qcow2'/>
+      <target dev='vda' bus='virtio'/>
+    </disk>
+    <memballoon model='virtio'>
+      <address type='pci' domain='0x0000' bus='0x05' slot='0x00' function='0x0'/>
+    </memballoon>
+  </devices>
+</domain>`
+
+var _ = Describe("updateDomainXML", func() {
+	It("keeps the definition if no resources are changed", func() {
+		updated, err := updateDomainXML(inactiveDomainXML, VMConfig{Name: "k2s-win-worker", DiskSizeGB: 100})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(Equal(inactiveDomainXML))
+	})
+
+	It("changes the vCPUs together with the CPU topology", func() {
+		updated, err := updateDomainXML(inactiveDomainXML, VMConfig{CPUCount: 6})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(ContainSubstring("<vcpu placement='static'>6</vcpu>"))
+		Expect(updated).To(ContainSubstring("<topology sockets='1' dies='1' cores='6' threads='1'/>"))
+		Expect(updated).To(ContainSubstring("<memory unit='KiB'>4194304</memory>"))
+	})
+
+	It("sets static memory and disables the balloon memory return", func() {
+		dynamic, err := updateDomainXML(inactiveDomainXML, VMConfig{MemoryMB: 4096, MaxMemoryMB: 8192, DynamicMemory: true})
+		Expect(err).ToNot(HaveOccurred())
+
+		updated, err := updateDomainXML(dynamic, VMConfig{MemoryMB: 6144})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(ContainSubstring("<memory unit='KiB'>6291456</memory>"))
+		Expect(updated).To(ContainSubstring("<currentMemory unit='KiB'>6291456</currentMemory>"))
+		Expect(updated).To(ContainSubstring("<memballoon model='virtio'>"))
+		Expect(updated).To(ContainSubstring("<vcpu placement='static'>2</vcpu>"))
+	})
+
+	It("sets dynamic memory between startup and maximum memory", func() {
+		updated, err := updateDomainXML(inactiveDomainXML, VMConfig{MemoryMB: 4096, MaxMemoryMB: 12288, DynamicMemory: true})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(ContainSubstring("<memory unit='KiB'>12582912</memory>"))
+		Expect(updated).To(ContainSubstring("<currentMemory unit='KiB'>4194304</currentMemory>"))
+		Expect(updated).To(ContainSubstring("<memballoon model='virtio' autodeflate='on' freePageReporting='on'>"))
+		Expect(updated).To(ContainSubstring("<address type='pci' domain='0x0000' bus='0x05' slot='0x00' function='0x0'/>"))
+	})
+
+	It("adds the current memory if the definition has none", func() {
+		withoutCurrentMemory := domainCurrentMemoryPattern.ReplaceAllString(inactiveDomainXML, "")
+
+		updated, err := updateDomainXML(withoutCurrentMemory, VMConfig{MemoryMB: 4096, MaxMemoryMB: 8192, DynamicMemory: true})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(ContainSubstring("<memory unit='KiB'>8388608</memory>\n  <currentMemory unit='KiB'>4194304</currentMemory>"))
+	})
+
+	It("fails if the definition has no vcpu element", func() {
+		_, err := updateDomainXML("<domain/>", VMConfig{CPUCount: 4})
+
+		Expect(err).To(MatchError("vcpu element not found"))
+	})
+
+	It("fails if the maximum memory is less than the startup memory", func() {
+		_, err := updateDomainXML(inactiveDomainXML, VMConfig{MemoryMB: 8192, MaxMemoryMB: 4096, DynamicMemory: true})
+
+		Expect(err).To(MatchError("maximum memory (4096MB) must not be less than the startup memory (8192MB)"))
+	})
+})
+
+var _ = Describe("maxMemory", func() {
+	DescribeTable("returns the upper memory limit",
+		func(config VMConfig, expected int) {
+			Expect(maxMemory(config)).To(Equal(expected))
+		},
+		Entry("static memory", VMConfig{MemoryMB: 4096, MaxMemoryMB: 8192}, 4096),
+		Entry("dynamic memory without maximum", VMConfig{MemoryMB: 4096, DynamicMemory: true}, 4096),
+		Entry("dynamic memory", VMConfig{MemoryMB: 4096, MaxMemoryMB: 8192, DynamicMemory: true}, 8192),
+	)
+})
@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	contracts "github.com/siemens-healthineers/k2s/internal/contracts/config"
+	"github.com/siemens-healthineers/k2s/internal/core/config"
+)
+
+const (
+	windowsNodeReadyTimeout = 180 * time.Second
+	windowsSSHTimeout       = 300 * time.Second
+)
+
+// windowsNodesQuery lists the nodes as tab-separated name, internal IP and Ready status lines.
+const windowsNodesQuery = `jsonpath={range .items[*]}{.metadata.name}{"\t"}{.status.addresses[?(@.type=="InternalIP")].address}{"\t"}{.status.conditions[?(@.type=="Ready")].status}{"\n"}{end}`
+
+// clusterNode is a node as reported by the API server.
+type clusterNode struct {
+	name    string
+	address string
+	ready   bool
+}
+
+// checkWindowsWorkerPrerequisites validates the host for running the Windows worker VM before anything is installed.
+func checkWindowsWorkerPrerequisites(installDir string) error {
+	for _, bin := range []string{"virsh", "qemu-img", "ssh", "scp"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			return fmt.Errorf("required host tool %q for the Windows worker VM was not found in PATH: %w", bin, err)
+		}
+	}
+	if _, err := os.Stat("/dev/kvm"); err != nil {
+		return fmt.Errorf("KVM is not available (/dev/kvm); enable hardware virtualization for the Windows worker VM: %w", err)
+	}
+	if findOVMFCode() == "" {
+		return fmt.Errorf("OVMF UEFI firmware not found; install the ovmf package (e.g. apt install ovmf or dnf install edk2-ovmf)")
+	}
+	if err := runCommand("virsh", "version"); err != nil {
+		return fmt.Errorf("libvirt is not usable; make sure the libvirtd service is running: %w", err)
+	}
+
+	for _, image := range []string{windowsWorkerQCOW2Name, windowsNodeVHDXName} {
+		if _, err := os.Stat(filepath.Join(installDir, "bin", image)); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("no Windows worker base image found in %s (expected %s or %s)", filepath.Join(installDir, "bin"), windowsWorkerQCOW2Name, windowsNodeVHDXName)
+}
+
+// InstalledWindowsWorker returns the Windows worker recorded in setup.json, nil if there is none.
+func InstalledWindowsWorker(configDir string) *contracts.K2sWindowsWorkerConfig {
+	runtimeConfig, err := config.ReadRuntimeConfig(configDir)
+	if runtimeConfig == nil {
+		slog.Debug("[WindowsWorker] Setup config not available", "error", err)
+		return nil
+	}
+	return runtimeConfig.ClusterConfig().WindowsWorker()
+}
+
+// recordWindowsWorker persists the Windows worker in setup.json so that the lifecycle commands, 'k2s status' and the
+// image commands treat it as a cluster node.
+func recordWindowsWorker(configDir string) error {
+	node, err := findClusterNode(winVMIP)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		return fmt.Errorf("Windows worker node with address %s is not registered in the cluster", winVMIP)
+	}
+	if err := config.SetWindowsWorker(configDir, node.name, winVMName, winVMIP); err != nil {
+		return fmt.Errorf("failed to record Windows worker in runtime config: %w", err)
+	}
+	slog.Info("[Install] Windows worker recorded", "node", node.name, "vm", winVMName)
+	return nil
+}
+
+// startWindowsWorker starts the Windows worker VM and waits for its node to become Ready.
+func startWindowsWorker(worker *contracts.K2sWindowsWorkerConfig) error {
+	slog.Info("[Start] Starting Windows worker VM", "vm", worker.VMName())
+
+	if err := CreateK2sNetwork(); err != nil {
+		return fmt.Errorf("failed to activate K2s network: %w", err)
+	}
+
+	vmManager := NewVMManager()
+	running, err := vmManager.VMIsRunning(worker.VMName())
+	if err != nil {
+		return fmt.Errorf("failed to determine state of Windows worker VM: %w", err)
+	}
+	if !running {
+		if err := vmManager.StartVM(worker.VMName()); err != nil {
+			return err
+		}
+	}
+
+	if err := waitForSSH(worker.IpAddress(), 22, windowsSSHTimeout); err != nil {
+		return fmt.Errorf("Windows worker VM not reachable via SSH within timeout: %w", err)
+	}
+	if err := SetupRoutes(); err != nil {
+		slog.Warn("[Start] Could not set up routes for Windows worker", "error", err)
+	}
+
+	if _, err := waitForWindowsNodeReady(worker.IpAddress(), windowsNodeReadyTimeout); err != nil {
+		return fmt.Errorf("Windows worker node '%s' is not Ready: %w", worker.NodeName(), err)
+	}
+	return nil
+}
+
+// stopWindowsWorker shuts down the Windows worker VM; the libvirt network stays defined for the next start.
+func stopWindowsWorker(worker *contracts.K2sWindowsWorkerConfig) error {
+	slog.Info("[Stop] Stopping Windows worker VM", "vm", worker.VMName())
+
+	vmManager := NewVMManager()
+	running, err := vmManager.VMIsRunning(worker.VMName())
+	if err != nil {
+		return fmt.Errorf("failed to determine state of Windows worker VM: %w", err)
+	}
+	if running {
+		if err := vmManager.StopVM(worker.VMName()); err != nil {
+			return err
+		}
+	}
+
+	_ = runCommand("ip", "route", "del", podNetworkWorker)
+	return nil
+}
+
+// removeWindowsWorker removes the Windows node from the cluster, the VM including its disk and the K2s network.
+// Missing parts are skipped so that interrupted installations can be cleaned up.
+func removeWindowsWorker(configDir string) error {
+	slog.Info("[Uninstall] Removing Windows worker VM", "vm", winVMName)
+
+	var errs []error
+	if node, err := findClusterNode(winVMIP); err != nil {
+		slog.Warn("[Uninstall] Could not look up Windows worker node", "error", err)
+	} else if node != nil {
+		if err := runCommand("kubectl", "--kubeconfig", kubeconfigSrc, "delete", "node", node.name, "--ignore-not-found"); err != nil {
+			errs = append(errs, fmt.Errorf("remove Windows worker node '%s': %w", node.name, err))
+		}
+	}
+
+	vmManager := NewVMManager()
+	exists, err := vmManager.VMExists(winVMName)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("determine whether Windows worker VM exists: %w", err))
+	} else if exists {
+		if err := vmManager.RemoveVM(winVMName); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := RemoveK2sNetwork(); err != nil {
+		errs = append(errs, err)
+	}
+
+	vmDataDir := filepath.Join(configDir, "vm")
+	if err := os.RemoveAll(vmDataDir); err != nil {
+		errs = append(errs, fmt.Errorf("remove VM data directory %s: %w", vmDataDir, err))
+	}
+	return errors.Join(errs...)
+}
+
+// waitForWindowsNodeReady waits until the node with the given address reports Ready and returns its name.
+func waitForWindowsNodeReady(address string, timeout time.Duration) (string, error) {
+	slog.Info("[WindowsWorker] Waiting for Windows node to be Ready", "address", address, "timeout", timeout)
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		node, err := findClusterNode(address)
+		if err == nil && node != nil && node.ready {
+			slog.Info("[WindowsWorker] Windows node is Ready", "name", node.name)
+			return node.name, nil
+		}
+		time.Sleep(5 * time.Second)
+	}
+	return "", fmt.Errorf("Windows node not ready after %s", timeout)
+}
+
+// findClusterNode returns the node with the given internal address, nil if no such node is registered.
+func findClusterNode(address string) (*clusterNode, error) {
+	output, err := runCommandOutput("kubectl", "--kubeconfig", kubeconfigSrc, "get", "nodes", "-o", windowsNodesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster nodes: %w", err)
+	}
+	for _, node := range parseClusterNodes(output) {
+		if node.address == address {
+			return &node, nil
+		}
+	}
+	return nil, nil
+}
+
+func parseClusterNodes(output string) []clusterNode {
+	var nodes []clusterNode
+	for line := range strings.Lines(output) {
+		fields := strings.Split(strings.TrimRight(line, "\r\n"), "\t")
+		if len(fields) != 3 || fields[0] == "" {
+			continue
+		}
+		nodes = append(nodes, clusterNode{
+			name:    fields[0],
+			address: fields[1],
+			ready:   fields[2] == "True",
+		})
+	}
+	return nodes
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText:  © 2026 Siemens Healthineers AG
+// SPDX-License-Identifier:   MIT
+
+//go:build linux
+
+package setuporchestration
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Windows worker", func() {
+	Describe("parseClusterNodes", func() {
+		It("parses name, internal address and readiness of the nodes", func() {
+			output := "my-host\t192.168.0.10\tTrue\nwin-worker\t172.19.1.101\tFalse\n"
+
+			nodes := parseClusterNodes(output)
+
+			Expect(nodes).To(Equal([]clusterNode{
+				{name: "my-host", address: "192.168.0.10", ready: true},
+				{name: "win-worker", address: "172.19.1.101", ready: false},
+			}))
+		})
+
+		It("skips incomplete lines", func() {
+			output := "\nmy-host\t192.168.0.10\n\t\t\nwin-worker\t172.19.1.101\tTrue"
+
+			nodes := parseClusterNodes(output)
+
+			Expect(nodes).To(Equal([]clusterNode{{name: "win-worker", address: "172.19.1.101", ready: true}}))
+		})
+	})
+
+	Describe("installSteps", func() {
+		stepNames := func(cfg InstallConfig) []string {
+			var names []string
+			for _, step := range (&LinuxOrchestrator{}).installSteps(cfg) {
+				names = append(names, step.name)
+			}
+			return names
+		}
+
+		It("provisions no Windows worker for Linux-only installations", func() {
+			Expect(stepNames(InstallConfig{LinuxOnly: true})).ToNot(ContainElement("windows-worker"))
+		})
+
+		It("provisions the Windows worker before persisting the runtime config", func() {
+			names := stepNames(InstallConfig{WindowsWorker: true})
+
+			Expect(names[len(names)-2:]).To(Equal([]string{"windows-worker", "runtime-config"}))
+		})
+
+		It("includes the Windows worker in a rollback", func() {
+			Expect(stepNames(InstallConfig{Rollback: true})).To(ContainElement("windows-worker"))
+		})
+	})
+})
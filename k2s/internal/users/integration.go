@@ -4,6 +4,8 @@
 package users
 
 import (
+	"time"
+
 	"github.com/siemens-healthineers/k2s/internal/contracts/config"
 	ssh_contracts "github.com/siemens-healthineers/k2s/internal/contracts/ssh"
 	users_contracts "github.com/siemens-healthineers/k2s/internal/contracts/users"
@@ -13,10 +15,12 @@ import (
 	"github.com/siemens-healthineers/k2s/internal/core/users/cluster/cert"
 	"github.com/siemens-healthineers/k2s/internal/core/users/cluster/decoding"
 	"github.com/siemens-healthineers/k2s/internal/core/users/cluster/kubeconfig"
+	"github.com/siemens-healthineers/k2s/internal/core/users/cluster/rbac"
 	"github.com/siemens-healthineers/k2s/internal/core/users/controlplane"
 	"github.com/siemens-healthineers/k2s/internal/core/users/controlplane/keyauth"
 	"github.com/siemens-healthineers/k2s/internal/core/users/controlplane/knownhosts"
 	"github.com/siemens-healthineers/k2s/internal/core/users/naming"
+	"github.com/siemens-healthineers/k2s/internal/core/users/registry"
 	"github.com/siemens-healthineers/k2s/internal/core/users/validation"
 	"github.com/siemens-healthineers/k2s/internal/definitions"
 	"github.com/siemens-healthineers/k2s/internal/providers/http"
@@ -42,9 +46,66 @@ type AddUserIntegration struct {
 	userAdmission *users.UserAdmission
 }
 
+type RemoveUserIntegration struct {
+	userRemoval *users.UserRemoval
+}
+
+type RenewUserIntegration struct {
+	userRenewal *users.UserRenewal
+}
+
+type admissions struct {
+	controlPlane       *controlplane.ControlPlaneAdmission
+	cluster            *cluster.ClusterAdmission
+	kubeconfigResolver *kubeconfig.KubeconfigResolver
+	kubeconfigReader   *kubeconfig.KubeconfigReader
+	credentialsDecoder *decoding.CredentialsDecoder
+}
+
 // NewAddUserIntegration creates a user admission integration using the given
 // platform-specific UsersProvider and ACLProvider.
 func NewAddUserIntegration(k2sConfig *config.K2sConfig, runtimeConfig *config.K2sRuntimeConfig, usersProvider UsersProvider, aclProvider ACLProvider) *AddUserIntegration {
+	admissions := newAdmissions(k2sConfig, runtimeConfig, aclProvider)
+	k2sUserNameProvider := naming.NewK2sUserNameProvider()
+	userValidator := validation.NewUserValidator(usersProvider)
+	userRegistry := registry.NewRegistry(k2sConfig.Host().K2sSetupConfigDir())
+	userRecorder := registry.NewRecorder(userRegistry, k2sConfig.Host().SshConfig(), runtimeConfig.ClusterConfig().Name(), admissions.kubeconfigResolver, admissions.kubeconfigReader, admissions.credentialsDecoder)
+
+	return &AddUserIntegration{
+		usersProvider: usersProvider,
+		userAdmission: users.NewUserAdmission(userValidator, k2sUserNameProvider, admissions.controlPlane, admissions.cluster, userRecorder),
+	}
+}
+
+// NewRemoveUserIntegration creates a user removal integration using the given
+// platform-specific UsersProvider and ACLProvider.
+func NewRemoveUserIntegration(k2sConfig *config.K2sConfig, runtimeConfig *config.K2sRuntimeConfig, usersProvider UsersProvider, aclProvider ACLProvider) *RemoveUserIntegration {
+	admissions := newAdmissions(k2sConfig, runtimeConfig, aclProvider)
+	userRegistry := registry.NewRegistry(k2sConfig.Host().K2sSetupConfigDir())
+	userValidator := validation.NewUserValidator(usersProvider)
+	legacyUserFinder := registry.NewLegacyUserFinder(usersProvider, userValidator, naming.NewK2sUserNameProvider(), k2sConfig.Host().SshConfig(), runtimeConfig.ClusterConfig().Name(), admissions.kubeconfigResolver, admissions.kubeconfigReader, admissions.credentialsDecoder)
+
+	return &RemoveUserIntegration{
+		userRemoval: users.NewUserRemoval(userRegistry, legacyUserFinder, admissions.controlPlane, admissions.cluster),
+	}
+}
+
+// NewRenewUserIntegration creates a user certificate renewal integration using the given platform-specific ACLProvider.
+func NewRenewUserIntegration(k2sConfig *config.K2sConfig, runtimeConfig *config.K2sRuntimeConfig, aclProvider ACLProvider) *RenewUserIntegration {
+	admissions := newAdmissions(k2sConfig, runtimeConfig, aclProvider)
+	userRegistry := registry.NewRegistry(k2sConfig.Host().K2sSetupConfigDir())
+
+	return &RenewUserIntegration{
+		userRenewal: users.NewUserRenewal(userRegistry, admissions.cluster),
+	}
+}
+
+// ListUsers returns the users granted access to K2s and the revoked certificates of removed users.
+func ListUsers(k2sConfig *config.K2sConfig) (*registry.Users, error) {
+	return registry.NewRegistry(k2sConfig.Host().K2sSetupConfigDir()).Load()
+}
+
+func newAdmissions(k2sConfig *config.K2sConfig, runtimeConfig *config.K2sRuntimeConfig, aclProvider ACLProvider) *admissions {
 	connectionOptions := ssh_contracts.ConnectionOptions{
 		RemoteUser:        definitions.SSHRemoteUser,
 		IpAddress:         k2sConfig.ControlPlane().IpAddress(),
@@ -63,36 +124,49 @@ func NewAddUserIntegration(k2sConfig *config.K2sConfig, runtimeConfig *config.K2
 	kubeconfigWriter := kubeconfig.NewKubeconfigWriter(k2sConfig.Host().KubeConfig(), kubectl)
 	kubeconfigCopier := kubeconfig.NewKubeconfigCopier(kubeconfigReaderAdapter, clusterFinder, kubeconfigWriter)
 	kubeconfigResolver := kubeconfig.NewKubeconfigResolver(k2sConfig.Host().KubeConfig())
-	remoteCertCreator := cert.NewRemoteCertCreator(sshProvider)
-	certGenerator := cert.NewCertGenerator(remoteCertCreator, sshProvider)
+	certIssuer := cert.NewCSRIssuer(kubectl)
+	certGenerator := cert.NewCertGenerator(certIssuer)
 	credentialsDecoder := decoding.NewCredentialsDecoder()
 	credentialsFinder := kubeconfig_adapter.NewCredentialsFinder()
 	restClient := http.NewRestClient()
 	apiAccessVerifier := api.NewApiAccessVerifier(restClient)
 	kubeconfigReader := kubeconfig.NewKubeconfigReader(k2sConfig.Host().KubeConfig(), kubeconfigReaderAdapter, credentialsFinder)
 	accessVerifier := cluster.NewClusterAccessVerifier(kubeconfigReader, credentialsDecoder, apiAccessVerifier)
-	clusterAdmission := cluster.NewClusterAdmission(runtimeConfig.ClusterConfig(), kubeconfigResolver, kubeconfigCopier, kubeconfigWriter, kubeconfigReader, certGenerator, accessVerifier)
-	k2sUserNameProvider := naming.NewK2sUserNameProvider()
-	userValidator := validation.NewUserValidator(usersProvider)
+	roleBinder := rbac.NewRoleBinder(kubectl)
+	bindingRemover := rbac.NewBindingRemover(kubectl)
+	clusterAdmission := cluster.NewClusterAdmission(runtimeConfig.ClusterConfig(), kubeconfigResolver, kubeconfigCopier, kubeconfigWriter, kubeconfigReader, certGenerator, accessVerifier, roleBinder, bindingRemover)
 
-	return &AddUserIntegration{
-		usersProvider: usersProvider,
-		userAdmission: users.NewUserAdmission(userValidator, k2sUserNameProvider, controlPlaneAdmission, clusterAdmission),
+	return &admissions{
+		controlPlane:       controlPlaneAdmission,
+		cluster:            clusterAdmission,
+		kubeconfigResolver: kubeconfigResolver,
+		kubeconfigReader:   kubeconfigReader,
+		credentialsDecoder: credentialsDecoder,
 	}
 }
 
-func (a *AddUserIntegration) AddById(userId string) error {
+func (a *AddUserIntegration) AddById(userId string, access users_contracts.Access, certValidity time.Duration) error {
 	user, err := a.usersProvider.FindById(userId)
 	if err != nil {
 		return err
 	}
-	return a.userAdmission.Add(user)
+	return a.userAdmission.Add(user, access, certValidity)
 }
 
-func (a *AddUserIntegration) AddByName(userName string) error {
+func (a *AddUserIntegration) AddByName(userName string, access users_contracts.Access, certValidity time.Duration) error {
 	user, err := a.usersProvider.FindByName(userName)
 	if err != nil {
 		return err
 	}
-	return a.userAdmission.Add(user)
+	return a.userAdmission.Add(user, access, certValidity)
+}
+
+// Remove revokes the access of the user with the given K2s or OS user name or OS user id.
+func (r *RemoveUserIntegration) Remove(nameOrId string) (*registry.UserEntry, error) {
+	return r.userRemoval.Remove(nameOrId)
+}
+
+// Renew re-issues the client certificates of the selected users.
+func (r *RenewUserIntegration) Renew(options users.RenewOptions) ([]registry.UserEntry, error) {
+	return r.userRenewal.Renew(options)
 }
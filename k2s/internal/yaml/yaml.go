@@ -22,3 +22,27 @@ func FromFile[T any](path string) (v *T, err error) {
 	}
 	return v, nil
 }
+
+func Marshal(data any) ([]byte, error) {
+	return y.Marshal(data)
+}
+
+// FromJson converts JSON to block-style YAML, keeping the order of the keys.
+func FromJson(jsonBytes []byte) ([]byte, error) {
+	var document y.Node
+	if err := y.Unmarshal(jsonBytes, &document); err != nil {
+		return nil, fmt.Errorf("could not unmarshal JSON: %w", err)
+	}
+
+	resetStyle(&document)
+
+	return y.Marshal(&document)
+}
+
+// resetStyle removes the flow and quoting style of JSON so that the YAML encoder chooses the style.
+func resetStyle(node *y.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
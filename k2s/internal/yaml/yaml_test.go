@@ -92,4 +92,30 @@ var _ = Describe("yaml pkg", func() {
 			})
 		})
 	})
+
+	Describe("FromJson", func() {
+		It("converts JSON to block-style YAML keeping key order and types", func() {
+			json := `{"apiVersion":"v1","nodes":[{"name":"n1","cpu":"4","isReady":true,"age":null}],"count":2,"flag":"true","empty":[]}`
+
+			actual, err := yaml.FromJson([]byte(json))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(actual)).To(Equal(`apiVersion: v1
+nodes:
+    - name: n1
+      cpu: "4"
+      isReady: true
+      age: null
+count: 2
+flag: "true"
+empty: []
+`))
+		})
+
+		It("returns error on invalid JSON", func() {
+			_, err := yaml.FromJson([]byte("{"))
+
+			Expect(err).To(MatchError(ContainSubstring("could not unmarshal JSON")))
+		})
+	})
 })
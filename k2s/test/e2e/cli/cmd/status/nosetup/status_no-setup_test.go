@@ -51,6 +51,12 @@ var _ = Describe("status", Ordered, func() {
 		})
 	})
 
+	Context("check mode", func() {
+		It("exits with not-installed exit code", func(ctx context.Context) {
+			suite.K2sCli().ExpectedExitCode(status.ExitCodeNotInstalled).Exec(ctx, "status", "--check")
+		})
+	})
+
 	Context("JSON output", func() {
 		var status status.PrintStatus
 
@@ -189,6 +189,12 @@ var _ = Describe("status", Ordered, func() {
 			Expect(status.Error).To(BeNil())
 		})
 
+		It("contains API version and health", func() {
+			Expect(status.ApiVersion).To(Equal("v1"))
+			Expect(status.Kind).To(Equal("SystemStatus"))
+			Expect(status.Health.State).To(BeEquivalentTo("healthy"))
+		})
+
 		It("contains setup info", func() {
 			Expect(status.SetupInfo.Name).To(Equal(suite.SetupInfo().RuntimeConfig.InstallConfig().SetupName()))
 			Expect(status.SetupInfo.Version).To(MatchRegexp(regex.VersionRegex))
@@ -90,7 +90,7 @@ var _ = Describe("system users add", Ordered, func() {
 
 				sut = integration.NewAddUserIntegration(&suite.SetupInfo().Config, &suite.SetupInfo().RuntimeConfig, userProvider, integration.PlatformACLProvider())
 
-				Expect(sut.AddByName(systemUserName)).To(Succeed())
+				Expect(sut.AddByName(systemUserName, contracts.Access{}, 0)).To(Succeed())
 			})
 
 			It("grants Windows SYSTEM user access to K2s control-plane", MustPassRepeatedly(2), func(ctx context.Context) {
@@ -150,7 +150,7 @@ users:
 
 				Expect(os.MkdirAll(kubeconfigDir, os.ModePerm)).To(Succeed())
 				Expect(os.WriteFile(kubeconfigPath, []byte(kubeconfig), os.ModePerm)).To(Succeed())
-				Expect(sut.AddByName(systemUserName)).To(Succeed())
+				Expect(sut.AddByName(systemUserName, contracts.Access{}, 0)).To(Succeed())
 			})
 
 			It("grants Windows SYSTEM user access to K2s control-plane", MustPassRepeatedly(2), func(ctx context.Context) {
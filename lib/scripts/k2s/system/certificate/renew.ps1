@@ -7,18 +7,25 @@
 .SYNOPSIS
 Renews the kubernetes certificates
 .DESCRIPTION
-Renews the kubernetes certificates. The renewal is performed only if the certificate is about to expire.
+Renews the kubernetes certificates. The renewal is performed only if a certificate is missing or expires within the threshold.
 However, the renewal can be enforced explicitly by the user.
 .PARAMETER Force
 If set to $true, then the certificate renewal is performed irrespective of expiration.
+.PARAMETER ThresholdDays
+Certificates expiring within this number of days are renewed.
 .EXAMPLE
 PS> .\renew.ps1 -Force
+.EXAMPLE
+PS> .\renew.ps1 -ThresholdDays 30
 #>
 
 param (
     [parameter(Mandatory = $false, HelpMessage = 'If set to $true, then the certificate renewal is performed irrespective of expiration.')]
     [switch] $Force,
 
+    [parameter(Mandatory = $false, HelpMessage = 'Certificates expiring within this number of days are renewed.')]
+    [int] $ThresholdDays = 0,
+
     [Parameter(Mandatory = $false, HelpMessage = 'If set to $true, then the logs are written into the console with more verbosity.')]
     [switch] $ShowLogs,
 
@@ -107,18 +114,19 @@ function Assert-CertificateExpiry() {
         Write-Log "Name: $($_.name), Expiration Date: $($_.expirationDate), Residual Time: $($_.residualTime), Missing: $($_.missing)"
     }
 
+    $thresholdDate = (Get-Date).ToUniversalTime().AddDays($ThresholdDays)
     $expiredCertificates = $certificatesInfo.certificates | Where-Object {
-        $_.residualTime -le 0 -or $_.missing -eq $true
+        $_.externallyManaged -ne $true -and ($_.residualTime -le 0 -or $_.missing -eq $true -or ([datetime]$_.expirationDate).ToUniversalTime() -lt $thresholdDate)
     }
 
     if ($expiredCertificates) {
-        Write-Log "[Warning] The following certificates have expired or are missing:" -Console
+        Write-Log "[Warning] The following certificates have expired, are missing or expire within $ThresholdDays days:" -Console
         $expiredCertificates | ForEach-Object {
             Write-Log "Certificate Name: $($_.name), Expiration Date: $($_.expirationDate), Missing: $($_.missing)" -Console
         }
         return $false
     }
-    Write-Log "All certificates are valid and not expired." -Console
+    Write-Log "All certificates are valid for more than $ThresholdDays days." -Console
     return $true
 }
 
@@ -288,8 +296,9 @@ try {
 
     $noErrorsOccurred = $true
     $certificatesValid = Assert-CertificateExpiry
+    $renewalRequired = ($certificatesValid -eq $false) -or ($Force -eq $true)
 
-    if (($certificatesValid -eq $false) -or ($Force -eq $true)) {
+    if ($renewalRequired) {
         if ($Force -eq $true) {
             Write-Log "Triggering forced certificate renewal." -Console
         }
@@ -300,7 +309,7 @@ try {
     }
 
     # Renew webhook certificate (restart deployment so init container generates fresh cert)
-    if ($noErrorsOccurred -eq $true) {
+    if ($renewalRequired -and $noErrorsOccurred -eq $true) {
         $webhookExists = (Invoke-CmdOnControlPlaneViaSSHKey 'kubectl get deployment clusterip-webhook -n k2s-webhook --no-headers 2>/dev/null' -IgnoreErrors).Output
         if (-not [string]::IsNullOrWhiteSpace($webhookExists)) {
             $webhookResult = Invoke-WebhookCertificateRenewal